@@ -8,3 +8,7 @@ var DefaultPreferredRanges = PreferredRanges{
 	"0.0.0.0/8",
 	"127.0.0.0/8",
 }
+
+// Conventional Nebula port for lighthouses and relays.
+// Roaming devices should use a random port instead.
+const DefaultLighthousePort = 4242
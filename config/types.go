@@ -182,6 +182,9 @@ type TunUnsafeRoute struct {
 	Via    string `yaml:"via,omitempty"`
 	Mtu    int    `yaml:"mtu,omitempty"`
 	Metric int    `yaml:"metric,omitempty"`
+	// Controls whether the route is installed in the system routing table. Defaults to true.
+	// Uninstalled routes are still used by Nebula to pick the `via` host for packets read off the tun.
+	Install *bool `yaml:"install,omitempty"`
 }
 
 // See: https://nebula.defined.net/docs/config/tun/
@@ -0,0 +1,185 @@
+package netstack
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"sync"
+
+	"github.com/slackhq/nebula/overlay"
+	"gvisor.dev/gvisor/pkg/buffer"
+	"gvisor.dev/gvisor/pkg/tcpip"
+	"gvisor.dev/gvisor/pkg/tcpip/adapters/gonet"
+	"gvisor.dev/gvisor/pkg/tcpip/header"
+	"gvisor.dev/gvisor/pkg/tcpip/link/channel"
+	"gvisor.dev/gvisor/pkg/tcpip/network/ipv4"
+	"gvisor.dev/gvisor/pkg/tcpip/stack"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/icmp"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/tcp"
+	"gvisor.dev/gvisor/pkg/tcpip/transport/udp"
+)
+
+const nicID = 1
+
+// Nebula's default tun mtu
+const mtu = 1300
+
+// A userspace TCP/IP stack attached to a Nebula user device. Lets a device
+// send overlay traffic without a tun or NET_ADMIN.
+//
+// Adapted from github.com/slackhq/nebula/service.
+type Stack struct {
+	ipstack *stack.Stack
+	linkEP  *channel.Endpoint
+	ip      netip.Addr
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// Builds the stack for dev, the device created by
+// overlay.NewUserDeviceFromConfig, and starts moving packets between them.
+func New(dev overlay.Device) (*Stack, error) {
+	userDev, ok := dev.(*overlay.UserDevice)
+	if !ok {
+		return nil, fmt.Errorf("netstack requires a user device, got %T", dev)
+	}
+
+	ipstack := stack.New(stack.Options{
+		NetworkProtocols:   []stack.NetworkProtocolFactory{ipv4.NewProtocol},
+		TransportProtocols: []stack.TransportProtocolFactory{tcp.NewProtocol, udp.NewProtocol, icmp.NewProtocol4},
+	})
+	// TCP SACK is disabled by default
+	sack := tcpip.TCPSACKEnabled(true)
+	tcpErr := ipstack.SetTransportProtocolOption(tcp.ProtocolNumber, &sack)
+	if tcpErr != nil {
+		return nil, fmt.Errorf("error enabling tcp sack: %v", tcpErr)
+	}
+
+	linkEP := channel.New(512, mtu, "")
+	tcpErr = ipstack.CreateNIC(nicID, linkEP)
+	if tcpErr != nil {
+		return nil, fmt.Errorf("error creating netstack nic: %v", tcpErr)
+	}
+	// Nebula routes everything it's handed, including unsafe routes
+	ipstack.SetRouteTable([]tcpip.Route{
+		{Destination: header.IPv4EmptySubnet, NIC: nicID},
+	})
+
+	ip := userDev.Cidr().Addr()
+	tcpErr = ipstack.AddProtocolAddress(nicID, tcpip.ProtocolAddress{
+		AddressWithPrefix: tcpip.AddrFrom4(ip.As4()).WithPrefix(),
+		Protocol:          ipv4.ProtocolNumber,
+	}, stack.AddressProperties{})
+	if tcpErr != nil {
+		return nil, fmt.Errorf("error adding netstack address: %v", tcpErr)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	s := &Stack{
+		ipstack: ipstack,
+		linkEP:  linkEP,
+		ip:      ip,
+		cancel:  cancel,
+	}
+
+	reader, writer := userDev.Pipe()
+	go func() {
+		<-ctx.Done()
+		reader.Close()
+		writer.Close()
+	}()
+
+	s.wg.Add(2)
+	// Nebula -> netstack
+	go func() {
+		defer s.wg.Done()
+		buf := make([]byte, header.IPv4MaximumHeaderSize+header.IPv4MaximumPayloadSize)
+		for {
+			// Each read is exactly one packet
+			n, err := reader.Read(buf)
+			if err != nil {
+				return
+			}
+			pkt := stack.NewPacketBuffer(stack.PacketBufferOptions{
+				Payload: buffer.MakeWithData(bytes.Clone(buf[:n])),
+			})
+			linkEP.InjectInbound(header.IPv4ProtocolNumber, pkt)
+			pkt.DecRef()
+		}
+	}()
+	// netstack -> Nebula
+	go func() {
+		defer s.wg.Done()
+		for {
+			pkt := linkEP.ReadContext(ctx)
+			if pkt == nil {
+				if ctx.Err() != nil {
+					return
+				}
+				continue
+			}
+			view := pkt.ToView()
+			pkt.DecRef()
+			_, err := view.WriteTo(writer)
+			view.Release()
+			if err != nil {
+				return
+			}
+		}
+	}()
+
+	return s, nil
+}
+
+// Overlay IP of the device
+func (s *Stack) IP() netip.Addr {
+	return s.ip
+}
+
+// Dials addr over the overlay. addr must be an ip:port, resolve names first.
+func (s *Stack) DialContext(ctx context.Context, network string, addr string) (net.Conn, error) {
+	addrPort, err := netip.ParseAddrPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if !addrPort.Addr().Unmap().Is4() {
+		return nil, fmt.Errorf("netstack only supports ipv4, got `%s`", addr)
+	}
+	full := tcpip.FullAddress{
+		NIC:  nicID,
+		Addr: tcpip.AddrFrom4(addrPort.Addr().Unmap().As4()),
+		Port: addrPort.Port(),
+	}
+
+	switch network {
+	case "tcp", "tcp4":
+		return gonet.DialContextTCP(ctx, s.ipstack, full, ipv4.ProtocolNumber)
+	case "udp", "udp4":
+		return gonet.DialUDP(s.ipstack, nil, &full, ipv4.ProtocolNumber)
+	default:
+		return nil, fmt.Errorf("unsupported network `%s`", network)
+	}
+}
+
+// Listens for TCP connections from other devices on port.
+func (s *Stack) ListenTCP(port uint16) (net.Listener, error) {
+	if port == 0 {
+		return nil, errors.New("port required")
+	}
+	return gonet.ListenTCP(s.ipstack, tcpip.FullAddress{
+		NIC:  nicID,
+		Addr: tcpip.AddrFrom4(s.ip.As4()),
+		Port: port,
+	}, ipv4.ProtocolNumber)
+}
+
+// Stops moving packets and closes the stack.
+func (s *Stack) Close() error {
+	s.cancel()
+	s.wg.Wait()
+	s.ipstack.Close()
+	return nil
+}
@@ -4,13 +4,23 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"net/netip"
+	"os"
+	"slices"
+	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/sprisa/west/config"
+	"github.com/sprisa/west/netstack"
+	"github.com/sprisa/west/util/metrics"
 	l "github.com/sprisa/x/log"
 
 	"github.com/sirupsen/logrus"
 	"github.com/slackhq/nebula"
+	"github.com/slackhq/nebula/cert"
 	nebulaCfg "github.com/slackhq/nebula/config"
 	"github.com/slackhq/nebula/overlay"
 	"github.com/slackhq/nebula/util"
@@ -25,12 +35,18 @@ import (
 var Build string = "west v0.0.1"
 
 type Control = nebula.Control
+type ControlHostInfo = nebula.ControlHostInfo
 type NebulaConfigCtrl = nebulaCfg.C
 
 type OnStartFunc = func(*Control)
 
-// TODO: Add add an `onReady` and wait for Nebula to be fully up.
-// e.g. after "handshake message received"
+// A tunnel to a peer coming up or going down.
+type PeerChange struct {
+	Host ControlHostInfo
+	// True when the tunnel was established, false when it closed
+	Up bool
+}
+
 type ServerOpts struct {
 	// Custom Logging
 	Log *logrus.Logger
@@ -38,15 +54,42 @@ type ServerOpts struct {
 	Config *config.Config
 	// Hook called after Nebula server starts
 	OnStart OnStartFunc
+	// Hook called after OnStart for startup that can fail. An error shuts the
+	// server down and is returned from Listen.
+	OnStartErr func(*Control) error
+	// Hook called once the overlay is usable, after the first lighthouse
+	// handshake completes. Called right after the start hooks for lighthouses and
+	// configs without lighthouses.
+	OnReady OnStartFunc
+	// Hook called when a tunnel to a peer is established or closed.
+	// Tunnels are polled so changes are seen within peerPollInterval.
+	OnPeerChange func(PeerChange)
 	// Hook called before Nebula shuts down.
 	// Will block until function returns.
 	OnShutdown    func()
 	DeviceFactory overlay.DeviceFactory
+	// Run a userspace TCP/IP stack instead of a tun device. Overlay traffic
+	// is then only reachable through Server.Dial. Needs no NET_ADMIN.
+	// Can't be combined with DeviceFactory.
+	Userspace bool
+	// Overlay IP of the DNS server Server.Dial resolves names with.
+	// Uses the system resolver when empty.
+	DNS string
 }
 
+// How often the hostmap is checked for readiness and peer changes
+const peerPollInterval = 250 * time.Millisecond
+
 type Server struct {
 	Ctrl *Control
 	opts *ServerOpts
+	c    *NebulaConfigCtrl
+	// Guards config, replaced by Reload
+	mu       sync.Mutex
+	config   *config.Config
+	ready    chan struct{}
+	stack    *netstack.Stack
+	resolver *net.Resolver
 }
 
 func NewServer(opts *ServerOpts) (*Server, error) {
@@ -77,7 +120,21 @@ func NewServerWithConfigCtrl(opts *ServerOpts, c *NebulaConfigCtrl) (*Server, er
 	}
 
 	port := nebulaConfig.Listen.Port
-	ctrl, err := nebula.Main(c, false, Build, opts.Log, opts.DeviceFactory, nil)
+	deviceFactory := opts.DeviceFactory
+	if deviceFactory == nil {
+		deviceFactory = overlay.NewDeviceFromConfig
+	}
+	if opts.Userspace {
+		if nebulaConfig.Tun.Disabled {
+			return nil, errors.New("userspace networking needs tun enabled")
+		}
+		if opts.DeviceFactory != nil {
+			return nil, errors.New("userspace networking can't be used with a custom device factory")
+		}
+		deviceFactory = overlay.NewUserDeviceFromConfig
+	}
+	deviceFactory = closeSafeFactory(deviceFactory)
+	ctrl, err := nebula.Main(c, false, Build, opts.Log, deviceFactory, nil)
 
 	if err != nil {
 		switch v := err.(type) {
@@ -90,7 +147,26 @@ func NewServerWithConfigCtrl(opts *ServerOpts, c *NebulaConfigCtrl) (*Server, er
 		}
 	}
 
-	return &Server{Ctrl: ctrl, opts: opts}, nil
+	srv := &Server{Ctrl: ctrl, opts: opts, c: c, config: nebulaConfig, ready: make(chan struct{}), resolver: net.DefaultResolver}
+	recordCertExpiry(nebulaConfig.Pki)
+	if opts.Userspace {
+		srv.stack, err = netstack.New(ctrl.Device().(*closeSafeDevice).Device)
+		if err != nil {
+			ctrl.Stop()
+			return nil, err
+		}
+		if opts.DNS != "" {
+			dnsAddr := net.JoinHostPort(opts.DNS, "53")
+			srv.resolver = &net.Resolver{
+				PreferGo: true,
+				Dial: func(ctx context.Context, network, _ string) (net.Conn, error) {
+					return srv.stack.DialContext(ctx, network, dnsAddr)
+				},
+			}
+		}
+	}
+
+	return srv, nil
 }
 
 func CreateNebulaConfigCtrl(cfg *config.Config, log *logrus.Logger) (*NebulaConfigCtrl, error) {
@@ -117,26 +193,195 @@ func CreateNebulaConfigCtrl(cfg *config.Config, log *logrus.Logger) (*NebulaConf
 	return c, nil
 }
 
+// Runs the server until ctx is done. Nebula doesn't interrupt its UDP read
+// loops on shutdown, they hold the listen port until a packet arrives. Apply
+// config changes with Reload instead of starting a new server on the same
+// port in the same process.
 func (s *Server) Listen(ctx context.Context) error {
-	log := s.opts.Log
-	port := s.opts.Config.Listen.Port
 	// Start Nebula Server
 	s.Ctrl.Start()
-	// Wait for OnStart hook
+	// Wait for OnStart hooks
 	if s.opts.OnStart != nil {
 		s.opts.OnStart(s.Ctrl)
 	}
+	if s.opts.OnStartErr != nil {
+		err := s.opts.OnStartErr(s.Ctrl)
+		if err != nil {
+			s.shutdown()
+			return err
+		}
+	}
+	watchCtx, stopWatch := context.WithCancel(ctx)
+	watchDone := make(chan struct{})
+	go func() {
+		defer close(watchDone)
+		s.watch(watchCtx)
+	}()
 	// Wait for server to be stopped (context cancelled)
 	<-ctx.Done()
+	stopWatch()
+	<-watchDone
+	s.shutdown()
+	return nil
+}
+
+func (s *Server) shutdown() {
 	// Wait for OnShutdown hook
 	if s.opts.OnShutdown != nil {
 		s.opts.OnShutdown()
 	}
-	log.Infof("Shutting down nebula server on port %d \n", port)
+	s.opts.Log.Infof("Shutting down nebula server on port %d \n", s.Config().Listen.Port)
 	s.Ctrl.Stop()
+	if s.stack != nil {
+		s.stack.Close()
+	}
+}
+
+// Returned by Reload for config changes nebula only applies on start
+var ErrRestartRequired = errors.New("config change requires a restart")
+
+// Applies cfg to the running server like a nebula config reload. Certs,
+// lighthouses, relays, routes and the firewall change in place without
+// dropping tunnels. Returns ErrRestartRequired when cfg differs from the
+// running config in its listener, cipher, lighthouse mode, tun or overlay IP.
+func (s *Server) Reload(cfg *config.Config) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	current := s.config
+	if current.Listen != cfg.Listen ||
+		current.Cipher != cfg.Cipher ||
+		current.Lighthouse.AmLighthouse != cfg.Lighthouse.AmLighthouse ||
+		current.Tun.Disabled != cfg.Tun.Disabled ||
+		!slices.Equal(certIPs(current.Pki), certIPs(cfg.Pki)) {
+		return ErrRestartRequired
+	}
+	nebulaYaml, err := configToYaml(cfg)
+	if err != nil {
+		return err
+	}
+	err = s.c.ReloadConfigString(string(nebulaYaml))
+	if err != nil {
+		return fmt.Errorf("failed to reload nebula config: %w", err)
+	}
+	// Callers reuse cfg to build their next Reload
+	applied := *cfg
+	s.config = &applied
+	recordCertExpiry(cfg.Pki)
 	return nil
 }
 
+// Dials address through the overlay, e.g. "api.net.mycompany.dev:22".
+// Names are resolved with ServerOpts.DNS. Only available with Userspace,
+// tun devices can use the regular net.Dial.
+func (s *Server) Dial(ctx context.Context, network string, address string) (net.Conn, error) {
+	if s.stack == nil {
+		return nil, errors.New("dial requires userspace networking")
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		ips, err := s.resolver.LookupNetIP(ctx, "ip4", host)
+		if err != nil {
+			return nil, err
+		}
+		if len(ips) == 0 {
+			return nil, fmt.Errorf("no ipv4 address for `%s`", host)
+		}
+		ip = ips[0]
+	}
+	return s.stack.DialContext(ctx, network, net.JoinHostPort(ip.Unmap().String(), port))
+}
+
+// Closed once the overlay is usable, after OnReady returns.
+func (s *Server) Ready() <-chan struct{} {
+	return s.ready
+}
+
+// Polls the hostmap for the lighthouse handshake and peer tunnel changes.
+func (s *Server) watch(ctx context.Context) {
+	isReady := false
+	checkReady := func() {
+		if isReady {
+			return
+		}
+		// Reload may have changed the lighthouses
+		lighthouses := lighthouseIPs(s.Config())
+		if len(lighthouses) > 0 && !slices.ContainsFunc(lighthouses, func(ip netip.Addr) bool {
+			return s.Ctrl.GetHostInfoByVpnIp(ip, false) != nil
+		}) {
+			return
+		}
+		isReady = true
+		s.opts.Log.Info("Nebula ready")
+		if s.opts.OnReady != nil {
+			s.opts.OnReady(s.Ctrl)
+		}
+		close(s.ready)
+	}
+
+	peers := map[netip.Addr]ControlHostInfo{}
+	checkPeers := func() {
+		current := map[netip.Addr]ControlHostInfo{}
+		for _, h := range s.Ctrl.ListHostmapHosts(false) {
+			current[h.VpnIp] = h
+		}
+		metrics.Tunnels.Set(float64(len(current)))
+		if s.opts.OnPeerChange != nil {
+			for ip, h := range current {
+				if _, ok := peers[ip]; !ok {
+					s.opts.OnPeerChange(PeerChange{Host: h, Up: true})
+				}
+			}
+			for ip, h := range peers {
+				if _, ok := current[ip]; !ok {
+					s.opts.OnPeerChange(PeerChange{Host: h, Up: false})
+				}
+			}
+		}
+		peers = current
+	}
+
+	checkReady()
+	checkPeers()
+	ticker := time.NewTicker(peerPollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			checkReady()
+			checkPeers()
+		}
+	}
+}
+
+// Config the server runs with, replaced by Reload. Copy it to build a
+// config for Reload.
+func (s *Server) Config() *config.Config {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.config
+}
+
+// Overlay IPs of cfg's lighthouses. Empty for lighthouses themselves.
+func lighthouseIPs(cfg *config.Config) []netip.Addr {
+	ips := []netip.Addr{}
+	if cfg.Lighthouse.AmLighthouse {
+		return ips
+	}
+	for _, host := range cfg.Lighthouse.Hosts {
+		ip, err := netip.ParseAddr(host)
+		if err == nil {
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
 func (s *Server) IFaceName() string {
 	return s.Ctrl.Device().Name()
 }
@@ -144,3 +389,60 @@ func (s *Server) IFaceName() string {
 func configToYaml(cfg *config.Config) ([]byte, error) {
 	return yaml.Marshal(cfg)
 }
+
+// Nebula exits the process when reading the device fails with anything but
+// os.ErrClosed. Disabled and user devices return io.EOF once closed, so a
+// stopped server would take the whole process down with it.
+type closeSafeDevice struct {
+	overlay.Device
+	closed atomic.Bool
+}
+
+func closeSafeFactory(factory overlay.DeviceFactory) overlay.DeviceFactory {
+	return func(c *nebulaCfg.C, l *logrus.Logger, tunCidr netip.Prefix, routines int) (overlay.Device, error) {
+		dev, err := factory(c, l, tunCidr, routines)
+		if err != nil {
+			return nil, err
+		}
+		return &closeSafeDevice{Device: dev}, nil
+	}
+}
+
+func (d *closeSafeDevice) Read(b []byte) (int, error) {
+	n, err := d.Device.Read(b)
+	if err != nil && d.closed.Load() {
+		return n, os.ErrClosed
+	}
+	return n, err
+}
+
+func (d *closeSafeDevice) Close() error {
+	d.closed.Store(true)
+	return d.Device.Close()
+}
+
+// Overlay IPs of the host cert. Nil when the cert doesn't parse.
+func certIPs(pki config.Pki) []string {
+	hostCert, _, err := cert.UnmarshalNebulaCertificateFromPEM([]byte(pki.Cert))
+	if err != nil {
+		return nil
+	}
+	ips := []string{}
+	for _, ip := range hostCert.Details.Ips {
+		ips = append(ips, ip.String())
+	}
+	return ips
+}
+
+// Exports when the host and first CA cert expire. Nebula already validated
+// both, so parse errors are ignored.
+func recordCertExpiry(pki config.Pki) {
+	hostCert, _, err := cert.UnmarshalNebulaCertificateFromPEM([]byte(pki.Cert))
+	if err == nil {
+		metrics.SetExpiry(metrics.CertExpiry.WithLabelValues("host"), hostCert.Details.NotAfter)
+	}
+	caCert, _, err := cert.UnmarshalNebulaCertificateFromPEM([]byte(pki.Ca))
+	if err == nil {
+		metrics.SetExpiry(metrics.CertExpiry.WithLabelValues("ca"), caCert.Details.NotAfter)
+	}
+}
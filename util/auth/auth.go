@@ -1,11 +1,49 @@
 package auth
 
-import "github.com/golang-jwt/jwt/v5"
+import (
+	"errors"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Default lifetime of device tokens
+const DeviceTokenExpiry = 8760 * time.Hour
 
 type TokenClaims struct {
 	Endpoint string `json:"endpoint"`
 	IP       string `json:"ip"`
 	Ca       string `json:"ca"`
 	PortIP   string `json:"port_ip"`
+	// Public nebula host:port of west port.
+	// Missing from older tokens, which assume the endpoint host on port 4242.
+	PortEndpoint string `json:"port_endpoint,omitempty"`
+	jwt.RegisteredClaims
+}
+
+// Scope of admin API tokens. Device tokens have none.
+const AdminScope = "admin"
+
+// Claims of admin API tokens. Subject names the admin.
+type AdminClaims struct {
+	Scope string `json:"scope"`
 	jwt.RegisteredClaims
 }
+
+// Verifies an admin token signed with key.
+func ParseAdminToken(token string, key []byte) (*AdminClaims, error) {
+	claims := &AdminClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		return key, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}),
+		jwt.WithExpirationRequired(),
+	)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Scope != AdminScope || claims.Subject == "" {
+		return nil, errors.New("not an admin token")
+	}
+	return claims, nil
+}
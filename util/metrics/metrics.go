@@ -0,0 +1,156 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	gometrics "github.com/rcrowley/go-metrics"
+)
+
+const namespace = "west"
+
+// Registry for all west metrics. Kept separate from the prometheus default
+// registry so embedding programs don't get them by accident.
+var Registry = prometheus.NewRegistry()
+
+var (
+	Tunnels = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "tunnels",
+		Help:      "Established Nebula tunnels to other devices.",
+	})
+	CertExpiry = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "cert_expiry_timestamp_seconds",
+		Help:      "Unix time the Nebula certificate expires. cert is `ca` or `host`.",
+	}, []string{"cert"})
+	DNSQueries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "dns_queries_total",
+		Help:      "DNS questions answered by record type and response code.",
+	}, []string{"type", "rcode"})
+	DNSQueryDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "dns_query_duration_seconds",
+		Help:      "Time to answer DNS queries.",
+		Buckets:   []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1},
+	})
+	ProvisionRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "provision_requests_total",
+		Help:      "Device provisioning requests by outcome.",
+	}, []string{"outcome"})
+	ACMECertExpiry = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Name:      "acme_cert_expiry_timestamp_seconds",
+		Help:      "Unix time the Let's Encrypt certificate expires.",
+	})
+	ACMERenewals = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "acme_renewals_total",
+		Help:      "Let's Encrypt certificate requests by result.",
+	}, []string{"result"})
+)
+
+// Provisioning outcomes
+const (
+	ProvisionSuccess      = "success"
+	ProvisionNotFound     = "not_found"
+	ProvisionInvalidToken = "invalid_token"
+	ProvisionRejected     = "rejected"
+	ProvisionError        = "error"
+)
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		Tunnels,
+		CertExpiry,
+		DNSQueries,
+		DNSQueryDuration,
+		ProvisionRequests,
+		ACMECertExpiry,
+		ACMERenewals,
+		&nebulaCollector{},
+	)
+}
+
+// Serves the registry in the prometheus text format.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{})
+}
+
+// Serves /metrics on addr until ctx is done.
+func Serve(ctx context.Context, addr string) error {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", Handler())
+	srv := &http.Server{Addr: addr, Handler: mux}
+	go func() {
+		<-ctx.Done()
+		srv.Close()
+	}()
+	err := srv.ListenAndServe()
+	if errors.Is(err, http.ErrServerClosed) {
+		return nil
+	}
+	return err
+}
+
+func SetExpiry(g prometheus.Gauge, t time.Time) {
+	g.Set(float64(t.Unix()))
+}
+
+// Reads Nebula's handshake counts at scrape time rather than mirroring
+// every update.
+type nebulaCollector struct{}
+
+var (
+	handshakesInitiated = prometheus.NewDesc(
+		namespace+"_handshakes_initiated_total",
+		"Nebula handshakes started by this device.",
+		nil, nil,
+	)
+	handshakesCompleted = prometheus.NewDesc(
+		namespace+"_handshakes_completed_total",
+		"Nebula handshakes completed by this device.",
+		nil, nil,
+	)
+	handshakesTimedOut = prometheus.NewDesc(
+		namespace+"_handshakes_timed_out_total",
+		"Nebula handshakes that timed out.",
+		nil, nil,
+	)
+)
+
+func (c *nebulaCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- handshakesInitiated
+	ch <- handshakesCompleted
+	ch <- handshakesTimedOut
+}
+
+func (c *nebulaCollector) Collect(ch chan<- prometheus.Metric) {
+	initiated, completed, timedOut := Handshakes()
+	ch <- prometheus.MustNewConstMetric(handshakesInitiated, prometheus.CounterValue, float64(initiated))
+	ch <- prometheus.MustNewConstMetric(handshakesCompleted, prometheus.CounterValue, float64(completed))
+	ch <- prometheus.MustNewConstMetric(handshakesTimedOut, prometheus.CounterValue, float64(timedOut))
+}
+
+// Handshake counts Nebula reported to the default go-metrics registry.
+func Handshakes() (initiated int64, completed int64, timedOut int64) {
+	if m, ok := gometrics.DefaultRegistry.Get("handshake_manager.initiated").(gometrics.Counter); ok {
+		initiated = m.Count()
+	}
+	if m, ok := gometrics.DefaultRegistry.Get("handshakes").(gometrics.Histogram); ok {
+		completed = m.Count()
+	}
+	if m, ok := gometrics.DefaultRegistry.Get("handshake_manager.timed_out").(gometrics.Counter); ok {
+		timedOut = m.Count()
+	}
+	return
+}
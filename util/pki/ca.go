@@ -0,0 +1,39 @@
+package pki
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"time"
+
+	"github.com/slackhq/nebula/cert"
+)
+
+// Generates a self signed Nebula CA, like `nebula-cert ca`. Returns the PEM
+// encoded cert and key.
+func NewCA(name string, duration time.Duration) (crt []byte, key []byte, err error) {
+	pub, priv, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now()
+	ca := cert.NebulaCertificate{
+		Details: cert.NebulaCertificateDetails{
+			Name: name,
+			// Allow for clock skew between devices
+			NotBefore: now.Add(-time.Minute),
+			NotAfter:  now.Add(duration),
+			PublicKey: pub,
+			IsCA:      true,
+			Curve:     Curve,
+		},
+	}
+	err = ca.Sign(Curve, priv)
+	if err != nil {
+		return nil, nil, err
+	}
+	crt, err = ca.MarshalToPEM()
+	if err != nil {
+		return nil, nil, err
+	}
+	return crt, cert.MarshalEd25519PrivateKey(priv), nil
+}
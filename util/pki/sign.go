@@ -21,6 +21,9 @@ type SignCertOptions struct {
 	Duration time.Duration
 	Subnets  []string
 	Groups   []string
+	// PEM encoded X25519 public key generated by the device.
+	// When set the cert is signed for it and no private key is returned.
+	PublicKey []byte
 }
 
 type SignCertData struct {
@@ -115,24 +118,18 @@ func SignCert(opts *SignCertOptions) (*SignCertData, error) {
 		}
 	}
 
-	pub, rawPriv := x25519Keypair()
-
-	// TODO: Look into using non ca.key to sign new certs
+	// Sign with the device's public key when given so the private key never
+	// leaves the device.
 	// https://nebula.defined.net/docs/guides/sign-certificates-with-public-keys/
-	//
-	// var pub, rawPriv []byte
-	// if *sf.inPubPath != "" {
-	// 	rawPub, err := ioutil.ReadFile(*sf.inPubPath)
-	// 	if err != nil {
-	// 		return fmt.Errorf("error while reading in-pub: %s", err)
-	// 	}
-	// 	pub, _, err = cert.UnmarshalX25519PublicKey(rawPub)
-	// 	if err != nil {
-	// 		return fmt.Errorf("error while parsing in-pub: %s", err)
-	// 	}
-	// } else {
-	// 	pub, rawPriv = x25519Keypair()
-	// }
+	var pub, rawPriv []byte
+	if len(opts.PublicKey) > 0 {
+		pub, _, err = cert.UnmarshalX25519PublicKey(opts.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("error while parsing public key: %s", err)
+		}
+	} else {
+		pub, rawPriv = x25519Keypair()
+	}
 
 	nebulaCert := cert.NebulaCertificate{
 		Details: cert.NebulaCertificateDetails{
@@ -159,7 +156,10 @@ func SignCert(opts *SignCertOptions) (*SignCertData, error) {
 		return nil, fmt.Errorf("error while signing: %s", err)
 	}
 
-	signedKey := cert.MarshalX25519PrivateKey(rawPriv)
+	var signedKey []byte
+	if rawPriv != nil {
+		signedKey = cert.MarshalX25519PrivateKey(rawPriv)
+	}
 	signedCert, err := nebulaCert.MarshalToPEM()
 	if err != nil {
 		return nil, fmt.Errorf("error while marshalling certificate: %s", err)
@@ -171,6 +171,25 @@ func SignCert(opts *SignCertOptions) (*SignCertData, error) {
 	}, nil
 }
 
+// Creates a new PEM encoded X25519 key pair for a device.
+func NewKeypair() (pub []byte, priv []byte) {
+	rawPub, rawPriv := x25519Keypair()
+	return cert.MarshalX25519PublicKey(rawPub), cert.MarshalX25519PrivateKey(rawPriv)
+}
+
+// Derives the PEM encoded public key of a PEM encoded X25519 private key.
+func PublicKeyFromPrivate(priv []byte) ([]byte, error) {
+	rawPriv, _, err := cert.UnmarshalX25519PrivateKey(priv)
+	if err != nil {
+		return nil, fmt.Errorf("error while parsing private key: %s", err)
+	}
+	rawPub, err := curve25519.X25519(rawPriv, curve25519.Basepoint)
+	if err != nil {
+		return nil, fmt.Errorf("error while deriving public key: %s", err)
+	}
+	return cert.MarshalX25519PublicKey(rawPub), nil
+}
+
 // Creates a new private and public key cert pair
 func x25519Keypair() ([]byte, []byte) {
 	privkey := make([]byte, 32)
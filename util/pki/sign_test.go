@@ -0,0 +1,95 @@
+package pki
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/slackhq/nebula/cert"
+)
+
+func newTestCA(t *testing.T) (crt []byte, key []byte) {
+	crt, key, err := NewCA("test ca", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return crt, key
+}
+
+func TestSignCertGeneratesKey(t *testing.T) {
+	caCrt, caKey := newTestCA(t)
+	data, err := SignCert(&SignCertOptions{
+		CaCrt: caCrt,
+		CaKey: caKey,
+		Name:  "home",
+		Ip:    "10.10.10.2/24",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Key) == 0 {
+		t.Fatal("expected generated private key")
+	}
+	pub, err := PublicKeyFromPrivate(data.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertCertKey(t, data.Cert, pub)
+}
+
+func TestSignCertWithPublicKey(t *testing.T) {
+	caCrt, caKey := newTestCA(t)
+	pub, priv := NewKeypair()
+	data, err := SignCert(&SignCertOptions{
+		CaCrt:     caCrt,
+		CaKey:     caKey,
+		Name:      "home",
+		Ip:        "10.10.10.2/24",
+		Subnets:   []string{"192.168.1.0/24"},
+		PublicKey: pub,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Key) != 0 {
+		t.Fatal("expected no private key when signing a public key")
+	}
+	assertCertKey(t, data.Cert, pub)
+
+	derived, err := PublicKeyFromPrivate(priv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(derived, pub) {
+		t.Fatal("derived public key does not match generated key pair")
+	}
+}
+
+func TestSignCertInvalidPublicKey(t *testing.T) {
+	caCrt, caKey := newTestCA(t)
+	_, err := SignCert(&SignCertOptions{
+		CaCrt:     caCrt,
+		CaKey:     caKey,
+		Name:      "home",
+		Ip:        "10.10.10.2/24",
+		PublicKey: []byte("not a key"),
+	})
+	if err == nil {
+		t.Fatal("expected error for invalid public key")
+	}
+}
+
+func assertCertKey(t *testing.T, crt []byte, pubPEM []byte) {
+	t.Helper()
+	nc, _, err := UnmarshalNebulaCertificateFromPEM(crt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, _, err := cert.UnmarshalX25519PublicKey(pubPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(nc.Details.PublicKey, pub) {
+		t.Fatal("cert public key does not match")
+	}
+}
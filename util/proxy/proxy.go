@@ -0,0 +1,207 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+
+	l "github.com/sprisa/x/log"
+)
+
+type DialFunc = func(ctx context.Context, network string, address string) (net.Conn, error)
+
+const socks5Version = 0x05
+
+// Serves SOCKS5 and HTTP CONNECT on ln, dialing targets with dial.
+// The protocol is picked per connection from its first byte.
+// Blocks until ctx is done or ln fails. Open connections are left to close
+// with their dialer.
+func Serve(ctx context.Context, ln net.Listener, dial DialFunc) error {
+	go func() {
+		<-ctx.Done()
+		ln.Close()
+	}()
+
+	for {
+		conn, err := ln.Accept()
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return err
+		}
+		go func() {
+			defer conn.Close()
+			err := handle(ctx, conn, dial)
+			if err != nil {
+				l.Log.Debug().Err(err).Str("client", conn.RemoteAddr().String()).Msg("proxy: connection failed")
+			}
+		}()
+	}
+}
+
+func handle(ctx context.Context, conn net.Conn, dial DialFunc) error {
+	r := bufio.NewReader(conn)
+	first, err := r.Peek(1)
+	if err != nil {
+		return err
+	}
+	if first[0] == socks5Version {
+		return handleSocks5(ctx, conn, r, dial)
+	}
+	return handleConnect(ctx, conn, r, dial)
+}
+
+// HTTP CONNECT proxy. Other methods are rejected.
+func handleConnect(ctx context.Context, conn net.Conn, r *bufio.Reader, dial DialFunc) error {
+	req, err := http.ReadRequest(r)
+	if err != nil {
+		return err
+	}
+	if req.Method != http.MethodConnect {
+		fmt.Fprint(conn, "HTTP/1.1 405 Method Not Allowed\r\nAllow: CONNECT\r\nContent-Length: 0\r\n\r\n")
+		return fmt.Errorf("unsupported method `%s`", req.Method)
+	}
+
+	target, err := dial(ctx, "tcp", req.Host)
+	if err != nil {
+		fmt.Fprint(conn, "HTTP/1.1 502 Bad Gateway\r\nContent-Length: 0\r\n\r\n")
+		return err
+	}
+	defer target.Close()
+	_, err = fmt.Fprint(conn, "HTTP/1.1 200 Connection Established\r\n\r\n")
+	if err != nil {
+		return err
+	}
+	return pipe(conn, r, target)
+}
+
+// SOCKS5 replies. See RFC 1928.
+const (
+	socksSucceeded           = 0x00
+	socksGeneralFailure      = 0x01
+	socksCommandNotSupported = 0x07
+	socksAddrNotSupported    = 0x08
+)
+
+// SOCKS5 proxy supporting CONNECT without authentication.
+func handleSocks5(ctx context.Context, conn net.Conn, r *bufio.Reader, dial DialFunc) error {
+	// Greeting: version, method count, methods
+	header := make([]byte, 2)
+	_, err := io.ReadFull(r, header)
+	if err != nil {
+		return err
+	}
+	methods := make([]byte, header[1])
+	_, err = io.ReadFull(r, methods)
+	if err != nil {
+		return err
+	}
+	// Only "no authentication". The proxy should only listen on loopback.
+	const noAuth, noAcceptable = 0x00, 0xff
+	method := byte(noAcceptable)
+	for _, m := range methods {
+		if m == noAuth {
+			method = noAuth
+		}
+	}
+	_, err = conn.Write([]byte{socks5Version, method})
+	if err != nil {
+		return err
+	}
+	if method == noAcceptable {
+		return errors.New("socks5 client requires authentication")
+	}
+
+	// Request: version, command, reserved, address type
+	req := make([]byte, 4)
+	_, err = io.ReadFull(r, req)
+	if err != nil {
+		return err
+	}
+	const connect = 0x01
+	if req[1] != connect {
+		socksReply(conn, socksCommandNotSupported)
+		return fmt.Errorf("unsupported socks5 command %d", req[1])
+	}
+
+	var host string
+	switch req[3] {
+	case 0x01: // IPv4
+		ip := make([]byte, 4)
+		_, err = io.ReadFull(r, ip)
+		host = netip.AddrFrom4([4]byte(ip)).String()
+	case 0x03: // Domain name
+		var n byte
+		n, err = r.ReadByte()
+		if err == nil {
+			name := make([]byte, n)
+			_, err = io.ReadFull(r, name)
+			host = string(name)
+		}
+	case 0x04: // IPv6
+		ip := make([]byte, 16)
+		_, err = io.ReadFull(r, ip)
+		host = netip.AddrFrom16([16]byte(ip)).String()
+	default:
+		socksReply(conn, socksAddrNotSupported)
+		return fmt.Errorf("unsupported socks5 address type %d", req[3])
+	}
+	if err != nil {
+		return err
+	}
+	portBytes := make([]byte, 2)
+	_, err = io.ReadFull(r, portBytes)
+	if err != nil {
+		return err
+	}
+	port := binary.BigEndian.Uint16(portBytes)
+
+	target, err := dial(ctx, "tcp", net.JoinHostPort(host, strconv.Itoa(int(port))))
+	if err != nil {
+		socksReply(conn, socksGeneralFailure)
+		return err
+	}
+	defer target.Close()
+	err = socksReply(conn, socksSucceeded)
+	if err != nil {
+		return err
+	}
+	return pipe(conn, r, target)
+}
+
+func socksReply(conn net.Conn, reply byte) error {
+	// Bound address is unused by clients, reply with 0.0.0.0:0
+	_, err := conn.Write([]byte{socks5Version, reply, 0x00, 0x01, 0, 0, 0, 0, 0, 0})
+	return err
+}
+
+// Copies between the client and target until either side closes.
+// r holds any client bytes already buffered.
+func pipe(client net.Conn, r io.Reader, target net.Conn) error {
+	errs := make(chan error, 2)
+	go func() {
+		_, err := io.Copy(target, r)
+		closeWrite(target)
+		errs <- err
+	}()
+	go func() {
+		_, err := io.Copy(client, target)
+		closeWrite(client)
+		errs <- err
+	}()
+	return errors.Join(<-errs, <-errs)
+}
+
+func closeWrite(conn net.Conn) {
+	if c, ok := conn.(interface{ CloseWrite() error }); ok {
+		c.CloseWrite()
+	}
+}
@@ -0,0 +1,120 @@
+package proxy
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"testing"
+)
+
+// Starts an echo server and a proxy dialing it over loopback.
+func startProxy(t *testing.T) (proxyAddr string, echoAddr string) {
+	echo, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { echo.Close() })
+	go func() {
+		for {
+			conn, err := echo.Accept()
+			if err != nil {
+				return
+			}
+			go func() {
+				defer conn.Close()
+				io.Copy(conn, conn)
+			}()
+		}
+	}()
+
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	var d net.Dialer
+	go Serve(ctx, ln, d.DialContext)
+	return ln.Addr().String(), echo.Addr().String()
+}
+
+func assertEcho(t *testing.T, conn io.ReadWriter) {
+	_, err := conn.Write([]byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 5)
+	_, err = io.ReadFull(conn, buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(buf) != "hello" {
+		t.Fatalf("expected echo, got %q", buf)
+	}
+}
+
+func TestSocks5(t *testing.T) {
+	proxyAddr, echoAddr := startProxy(t)
+	conn, err := net.Dial("tcp", proxyAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	echo := net.TCPAddrFromAddrPort(mustAddrPort(t, echoAddr))
+	req := []byte{socks5Version, 1, 0x00}
+	req = append(req, socks5Version, 0x01, 0x00, 0x01)
+	req = append(req, echo.IP.To4()...)
+	req = append(req, byte(echo.Port>>8), byte(echo.Port))
+	_, err = conn.Write(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res := make([]byte, 2+10)
+	_, err = io.ReadFull(conn, res)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res[1] != 0x00 || res[3] != socksSucceeded {
+		t.Fatalf("unexpected socks5 response %v", res)
+	}
+	assertEcho(t, conn)
+}
+
+func TestConnect(t *testing.T) {
+	proxyAddr, echoAddr := startProxy(t)
+	conn, err := net.Dial("tcp", proxyAddr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+
+	_, err = conn.Write([]byte("CONNECT " + echoAddr + " HTTP/1.1\r\nHost: " + echoAddr + "\r\n\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := bufio.NewReader(conn)
+	res, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200, got %s", res.Status)
+	}
+	assertEcho(t, struct {
+		io.Reader
+		io.Writer
+	}{r, conn})
+}
+
+func mustAddrPort(t *testing.T, addr string) netip.AddrPort {
+	ap, err := netip.ParseAddrPort(addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ap
+}
@@ -0,0 +1,16 @@
+package route
+
+// Firewall mark set on Nebula's underlay sockets (listen.so_mark) while
+// routing through an exit node. Marked packets skip the exit node table and
+// use the system routes, so tunnel traffic never loops back into the tun.
+const ExitNodeMark = 0x7765
+
+// Routing table holding the default route through the Nebula tun.
+const ExitNodeTable = 0x7765
+
+// Rule priority for the exit node policy rules.
+const exitNodePriority = 0x7765
+
+// Route covering the whole IPv4 internet. Exit node certs are signed with it
+// and devices using an exit node add it as an unsafe route.
+const DefaultRoute = "0.0.0.0/0"
@@ -0,0 +1,66 @@
+package route
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	l "github.com/sprisa/x/log"
+)
+
+// Routes all traffic not marked with ExitNodeMark out of dev.
+//
+// Mirrors wg-quick:
+//
+//	ip route add default dev <dev> table <table>
+//	ip rule add not fwmark <mark> table <table>
+//	ip rule add table main suppress_prefixlength 0
+//
+// The suppress rule keeps more specific routes in the main table (LANs,
+// unsafe routes) working. Returns a func that removes the routes again.
+func InstallExitNode(dev string) (func() error, error) {
+	table := strconv.Itoa(ExitNodeTable)
+	mark := strconv.Itoa(ExitNodeMark)
+	priority := exitNodePriority
+
+	cmds := [][]string{
+		{"route", "replace", "default", "dev", dev, "table", table},
+		{"rule", "add", "not", "fwmark", mark, "table", table, "priority", strconv.Itoa(priority + 1)},
+		{"rule", "add", "table", "main", "suppress_prefixlength", "0", "priority", strconv.Itoa(priority)},
+	}
+	undo := [][]string{
+		{"rule", "del", "table", "main", "suppress_prefixlength", "0", "priority", strconv.Itoa(priority)},
+		{"rule", "del", "not", "fwmark", mark, "table", table, "priority", strconv.Itoa(priority + 1)},
+		{"route", "flush", "table", table},
+	}
+
+	cleanup := func() error {
+		var errs []error
+		for _, args := range undo {
+			errs = append(errs, ip(args...))
+		}
+		return errors.Join(errs...)
+	}
+
+	for _, args := range cmds {
+		err := ip(args...)
+		if err != nil {
+			// Roll back anything partially applied
+			cleanup()
+			return nil, err
+		}
+	}
+
+	return cleanup, nil
+}
+
+func ip(args ...string) error {
+	l.Log.Debug().Strs("args", args).Msg("ip")
+	out, err := exec.Command("ip", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("ip %s: %s: %w", strings.Join(args, " "), strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
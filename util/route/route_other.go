@@ -0,0 +1,9 @@
+//go:build !linux
+
+package route
+
+import "errors"
+
+func InstallExitNode(dev string) (func() error, error) {
+	return nil, errors.New("exit nodes are only supported on linux")
+}
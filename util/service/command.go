@@ -0,0 +1,47 @@
+package service
+
+import (
+	"context"
+
+	"github.com/urfave/cli/v3"
+)
+
+// Uninstall, status and logs subcommands for the named unit.
+func Commands(name string) []*cli.Command {
+	return []*cli.Command{
+		{
+			Name:  "uninstall",
+			Usage: "Stop and remove the systemd service",
+			Action: func(ctx context.Context, c *cli.Command) error {
+				return Uninstall(name)
+			},
+		},
+		{
+			Name:  "status",
+			Usage: "Show the systemd service status",
+			Action: func(ctx context.Context, c *cli.Command) error {
+				return Status(name)
+			},
+		},
+		{
+			Name:  "logs",
+			Usage: "Show the service logs from journald",
+			Flags: []cli.Flag{
+				&cli.BoolFlag{
+					Name:    "follow",
+					Aliases: []string{"f"},
+					Usage:   "Keep printing new logs",
+				},
+				&cli.IntFlag{
+					Name:    "lines",
+					Aliases: []string{"n"},
+					Value:   100,
+					Usage:   "Number of past lines to show",
+				},
+			},
+			Action: func(ctx context.Context, c *cli.Command) error {
+				return Logs(name, c.Int("lines"), c.Bool("follow"))
+			},
+		},
+	}
+}
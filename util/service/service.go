@@ -0,0 +1,151 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// A systemd service unit for a west command.
+type Unit struct {
+	// Unit name without the .service suffix
+	Name        string
+	Description string
+	// Arguments passed to the west binary
+	Args []string
+	// Working directory of the service. Made writable.
+	WorkingDirectory string
+	// Directory created under /var/lib, e.g. west -> /var/lib/west
+	StateDirectory string
+	// Capabilities granted to the service. Everything else is dropped.
+	Capabilities []string
+}
+
+// A secret passed to the service with LoadCredential instead of stdin or
+// the environment. Read it back with ReadCredential.
+type Credential struct {
+	Name   string
+	Secret []byte
+}
+
+// Renders the unit file. exe is the absolute path of the west binary and
+// creds the LoadCredential directives.
+func (u *Unit) Render(exe string, creds []string) string {
+	var b strings.Builder
+	line := func(format string, a ...any) {
+		fmt.Fprintf(&b, format+"\n", a...)
+	}
+
+	line("[Unit]")
+	line("Description=%s", u.Description)
+	line("Documentation=https://github.com/sprisa/west")
+	line("Wants=network-online.target")
+	line("After=network-online.target")
+	line("")
+	line("[Service]")
+	// West signals readiness with sd_notify once the overlay is usable
+	line("Type=notify")
+	line("NotifyAccess=main")
+	line("ExecStart=%s", quoteArgs(append([]string{exe}, u.Args...)))
+	line("Restart=always")
+	line("RestartSec=5")
+	if u.WorkingDirectory != "" {
+		line("WorkingDirectory=%s", u.WorkingDirectory)
+		line("ReadWritePaths=%s", u.WorkingDirectory)
+	}
+	if u.StateDirectory != "" {
+		line("StateDirectory=%s", u.StateDirectory)
+		line("StateDirectoryMode=0700")
+	}
+	for _, cred := range creds {
+		line("%s", cred)
+	}
+	line("")
+	line("# Hardening")
+	line("AmbientCapabilities=%s", strings.Join(u.Capabilities, " "))
+	line("CapabilityBoundingSet=%s", strings.Join(u.Capabilities, " "))
+	line("DeviceAllow=/dev/net/tun rw")
+	line("DevicePolicy=closed")
+	line("NoNewPrivileges=yes")
+	line("ProtectSystem=strict")
+	line("ProtectHome=read-only")
+	line("PrivateTmp=yes")
+	line("ProtectKernelModules=yes")
+	line("ProtectControlGroups=yes")
+	line("ProtectClock=yes")
+	line("ProtectHostname=yes")
+	line("RestrictAddressFamilies=AF_UNIX AF_INET AF_INET6 AF_NETLINK")
+	line("RestrictNamespaces=yes")
+	line("RestrictRealtime=yes")
+	line("RestrictSUIDSGID=yes")
+	line("LockPersonality=yes")
+	line("MemoryDenyWriteExecute=yes")
+	line("SystemCallArchitectures=native")
+	line("")
+	line("[Install]")
+	line("WantedBy=multi-user.target")
+	return b.String()
+}
+
+// Quotes arguments for ExecStart.
+func quoteArgs(args []string) string {
+	quoted := make([]string, len(args))
+	for i, arg := range args {
+		if arg != "" && !strings.ContainsAny(arg, " \t\"'\\$%;") {
+			quoted[i] = arg
+			continue
+		}
+		arg = strings.ReplaceAll(arg, `\`, `\\`)
+		arg = strings.ReplaceAll(arg, `"`, `\"`)
+		// $ and % are expanded by systemd
+		arg = strings.ReplaceAll(arg, `$`, `$$`)
+		arg = strings.ReplaceAll(arg, `%`, `%%`)
+		quoted[i] = `"` + arg + `"`
+	}
+	return strings.Join(quoted, " ")
+}
+
+// Reads a credential passed by systemd with LoadCredential. Returns nil when
+// not running under systemd or the credential isn't set.
+func ReadCredential(name string) ([]byte, error) {
+	dir := os.Getenv("CREDENTIALS_DIRECTORY")
+	if dir == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(filepath.Join(dir, name))
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return bytes.TrimSpace(b), nil
+}
+
+// Tells systemd the service is up. A no-op when not started by systemd.
+func NotifyReady() error {
+	return notify("READY=1")
+}
+
+// Sends a sd_notify message over NOTIFY_SOCKET.
+func notify(state string) error {
+	path := os.Getenv("NOTIFY_SOCKET")
+	if path == "" {
+		return nil
+	}
+	// Abstract socket
+	if strings.HasPrefix(path, "@") {
+		path = "\x00" + path[1:]
+	}
+	conn, err := net.DialUnix("unixgram", nil, &net.UnixAddr{Name: path, Net: "unixgram"})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = conn.Write([]byte(state))
+	return err
+}
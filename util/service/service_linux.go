@@ -0,0 +1,171 @@
+package service
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+)
+
+const (
+	unitDir = "/etc/systemd/system"
+	// Credential stores systemd searches by default
+	credStoreDir          = "/etc/credstore"
+	encryptedCredStoreDir = "/etc/credstore.encrypted"
+)
+
+// Writes the unit and its credentials, then enables and starts it.
+func Install(u *Unit, creds []Credential) error {
+	err := requireRoot()
+	if err != nil {
+		return err
+	}
+	exe, err := os.Executable()
+	if err != nil {
+		return errutil.WrapErr(err, "error finding west binary")
+	}
+	exe, err = filepath.EvalSymlinks(exe)
+	if err != nil {
+		return errutil.WrapErr(err, "error finding west binary")
+	}
+
+	directives := []string{}
+	for _, cred := range creds {
+		directive, err := storeCredential(u.Name, cred)
+		if err != nil {
+			return errutil.WrapErr(err, "error storing credential `%s`", cred.Name)
+		}
+		directives = append(directives, directive)
+	}
+
+	path := unitPath(u.Name)
+	err = os.WriteFile(path, []byte(u.Render(exe, directives)), 0644)
+	if err != nil {
+		return errutil.WrapErr(err, "error writing unit file")
+	}
+	l.Log.Info().Str("path", path).Msg("Wrote unit file")
+
+	err = systemctl("daemon-reload")
+	if err != nil {
+		return err
+	}
+	return systemctl("enable", "--now", u.Name)
+}
+
+// Stops and disables the unit, then removes it with its credentials.
+func Uninstall(name string) error {
+	err := requireRoot()
+	if err != nil {
+		return err
+	}
+	path := unitPath(name)
+	if _, err := os.Stat(path); os.IsNotExist(err) {
+		return fmt.Errorf("service `%s` is not installed", name)
+	}
+
+	err = systemctl("disable", "--now", name)
+	if err != nil {
+		l.Log.Err(err).Msg("error stopping service")
+	}
+	err = os.Remove(path)
+	if err != nil {
+		return errutil.WrapErr(err, "error removing unit file")
+	}
+	for _, dir := range []string{credStoreDir, encryptedCredStoreDir} {
+		creds, _ := filepath.Glob(filepath.Join(dir, name+".*"))
+		for _, cred := range creds {
+			err = os.Remove(cred)
+			if err != nil {
+				l.Log.Err(err).Str("path", cred).Msg("error removing credential")
+			}
+		}
+	}
+	l.Log.Info().Str("path", path).Msg("Removed unit file")
+	return systemctl("daemon-reload")
+}
+
+// Prints systemctl status for the unit.
+func Status(name string) error {
+	cmd := exec.Command("systemctl", "status", "--no-pager", name)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err := cmd.Run()
+	// systemctl status exits non-zero for stopped units
+	var exitErr *exec.ExitError
+	if errors.As(err, &exitErr) {
+		return nil
+	}
+	return err
+}
+
+// Prints the journal for the unit.
+func Logs(name string, lines int, follow bool) error {
+	args := []string{"--unit", name, "--lines", fmt.Sprint(lines), "--no-pager"}
+	if follow {
+		args = append(args, "--follow")
+	}
+	cmd := exec.Command("journalctl", args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	return cmd.Run()
+}
+
+// Stores cred for unit, encrypted with systemd-creds when available.
+// Returns the directive loading it.
+func storeCredential(unit string, cred Credential) (string, error) {
+	file := unit + "." + cred.Name
+	if _, err := exec.LookPath("systemd-creds"); err == nil {
+		err = os.MkdirAll(encryptedCredStoreDir, 0700)
+		if err != nil {
+			return "", err
+		}
+		path := filepath.Join(encryptedCredStoreDir, file)
+		cmd := exec.Command("systemd-creds", "encrypt", "--name="+cred.Name, "-", path)
+		cmd.Stdin = bytes.NewReader(cred.Secret)
+		out, err := cmd.CombinedOutput()
+		if err == nil {
+			return fmt.Sprintf("LoadCredentialEncrypted=%s:%s", cred.Name, path), nil
+		}
+		// Older systemd or no usable host key
+		l.Log.Warn().
+			Str("output", strings.TrimSpace(string(out))).
+			Msg("systemd-creds encrypt failed. Storing credential unencrypted")
+	}
+
+	err := os.MkdirAll(credStoreDir, 0700)
+	if err != nil {
+		return "", err
+	}
+	path := filepath.Join(credStoreDir, file)
+	err = os.WriteFile(path, cred.Secret, 0600)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("LoadCredential=%s:%s", cred.Name, path), nil
+}
+
+func unitPath(name string) string {
+	return filepath.Join(unitDir, name+".service")
+}
+
+func requireRoot() error {
+	if os.Geteuid() != 0 {
+		return errors.New("installing services requires root. Try again with sudo.")
+	}
+	return nil
+}
+
+func systemctl(args ...string) error {
+	l.Log.Debug().Strs("args", args).Msg("systemctl")
+	out, err := exec.Command("systemctl", args...).CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("systemctl %s: %s: %w", strings.Join(args, " "), strings.TrimSpace(string(out)), err)
+	}
+	return nil
+}
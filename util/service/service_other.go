@@ -0,0 +1,23 @@
+//go:build !linux
+
+package service
+
+import "errors"
+
+var errUnsupported = errors.New("services are only supported on linux with systemd")
+
+func Install(u *Unit, creds []Credential) error {
+	return errUnsupported
+}
+
+func Uninstall(name string) error {
+	return errUnsupported
+}
+
+func Status(name string) error {
+	return errUnsupported
+}
+
+func Logs(name string, lines int, follow bool) error {
+	return errUnsupported
+}
@@ -0,0 +1,99 @@
+package west
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/sprisa/west/west/state"
+	l "github.com/sprisa/x/log"
+)
+
+// Periodically re-provisions with west port so a rotated CA, a renewed
+// cert or network changes reach the device without a manual restart.
+// Returns the fresh enrollment once it changed, or nil when ctx is done.
+func checkIn(ctx context.Context, interval time.Duration, current *state.Enrollment, save func(*state.Enrollment) error) *state.Enrollment {
+	wait := interval
+	if current.NeedsRefresh() {
+		// Running on a saved cert after failing to refresh at startup
+		wait = min(interval, retryMax)
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(wait):
+		}
+		wait = interval
+
+		fresh, err := reprovision(ctx, current)
+		if err != nil {
+			if ctx.Err() == nil {
+				l.Log.Err(err).Msg("Check in failed")
+			}
+			continue
+		}
+
+		reason := changeReason(current, fresh)
+		if reason == "" {
+			l.Log.Debug().Msg("Checked in. Enrollment unchanged")
+			continue
+		}
+		if save != nil {
+			err = save(fresh)
+			if err != nil {
+				l.Log.Err(err).Msg("error saving enrollment")
+			}
+		}
+		l.Log.Info().Str("reason", reason).Msg("Enrollment changed")
+		return fresh
+	}
+}
+
+// Re-provisions with the token and key of e, retrying while west port is
+// unreachable.
+func reprovision(ctx context.Context, e *state.Enrollment) (*state.Enrollment, error) {
+	var fresh *state.Enrollment
+	err := retry(ctx, "Provisioning", func() error {
+		var err error
+		// Keep the device key so peers see a stable identity
+		fresh, err = provision(ctx, e.Token, []byte(e.Device.Key))
+		return err
+	})
+	return fresh, err
+}
+
+// Reports why nebula should switch from current to fresh. Every
+// provisioning signs a new cert, so certs are only swapped when the CA,
+// the cert details or the network config changed, or current nears expiry.
+func changeReason(current *state.Enrollment, fresh *state.Enrollment) string {
+	if current.Device.Ca != fresh.Device.Ca {
+		return "ca rotated"
+	}
+	if current.NeedsRefresh() {
+		return "cert expiring"
+	}
+
+	currentCert, err := current.Cert()
+	if err != nil {
+		return "invalid cert"
+	}
+	freshCert, err := fresh.Cert()
+	if err != nil {
+		// Keep running on the working cert
+		l.Log.Err(err).Msg("west port returned an invalid cert")
+		return ""
+	}
+	if !reflect.DeepEqual(currentCert.Details.Ips, freshCert.Details.Ips) ||
+		!reflect.DeepEqual(currentCert.Details.Subnets, freshCert.Details.Subnets) ||
+		!reflect.DeepEqual(currentCert.Details.Groups, freshCert.Details.Groups) {
+		return "cert changed"
+	}
+
+	a, b := current.Device, fresh.Device
+	a.Cert, b.Cert = "", ""
+	if !reflect.DeepEqual(a, b) || current.PortEndpoint != fresh.PortEndpoint {
+		return "network changed"
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package control
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+
+	"github.com/sprisa/x/errutil"
+)
+
+// Talks to a running `west start` over its control socket.
+type Client struct {
+	http *http.Client
+	path string
+}
+
+func NewClient(path string) *Client {
+	return &Client{
+		path: path,
+		http: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var d net.Dialer
+					return d.DialContext(ctx, "unix", path)
+				},
+			},
+		},
+	}
+}
+
+func (c *Client) Status(ctx context.Context) (*Status, error) {
+	status := &Status{}
+	err := c.do(ctx, http.MethodGet, "/status", nil, status)
+	return status, err
+}
+
+func (c *Client) Peers(ctx context.Context) ([]Peer, error) {
+	peers := []Peer{}
+	err := c.do(ctx, http.MethodGet, "/peers", nil, &peers)
+	return peers, err
+}
+
+func (c *Client) Ping(ctx context.Context, req PingRequest) (*PingResult, error) {
+	res := &PingResult{}
+	err := c.do(ctx, http.MethodPost, "/ping", req, res)
+	return res, err
+}
+
+func (c *Client) do(ctx context.Context, method string, path string, body any, out any) error {
+	var reqBody bytes.Buffer
+	if body != nil {
+		err := json.NewEncoder(&reqBody).Encode(body)
+		if err != nil {
+			return err
+		}
+	}
+	// Host is ignored when dialing the unix socket
+	req, err := http.NewRequestWithContext(ctx, method, "http://west"+path, &reqBody)
+	if err != nil {
+		return err
+	}
+	res, err := c.http.Do(req)
+	if err != nil {
+		if _, statErr := os.Stat(c.path); os.IsNotExist(statErr) {
+			return fmt.Errorf("west is not running. No control socket at `%s`", c.path)
+		}
+		if errors.Is(err, os.ErrPermission) {
+			return fmt.Errorf("no permission to use the control socket `%s`. Try again with sudo.", c.path)
+		}
+		return errutil.WrapErr(err, "error connecting to control socket `%s`", c.path)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		e := errorResponse{}
+		json.NewDecoder(res.Body).Decode(&e)
+		if e.Error == "" {
+			e.Error = res.Status
+		}
+		return fmt.Errorf("%s", e.Error)
+	}
+	return json.NewDecoder(res.Body).Decode(out)
+}
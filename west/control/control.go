@@ -0,0 +1,77 @@
+package control
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sprisa/west/west/state"
+)
+
+// Path of the `west start` control socket. Lives in the state dir so only
+// the user running the device can reach it. Can be overridden with
+// WEST_CONTROL_SOCKET.
+func SocketPath() string {
+	if path := os.Getenv("WEST_CONTROL_SOCKET"); path != "" {
+		return path
+	}
+	return filepath.Join(state.Dir, "west.sock")
+}
+
+// Device info reported by `west status`.
+type Status struct {
+	Name       string    `json:"name"`
+	IP         string    `json:"ip"`
+	Version    string    `json:"version"`
+	Interface  string    `json:"interface"`
+	StartedAt  time.Time `json:"started_at"`
+	CertExpiry time.Time `json:"cert_expiry"`
+	// Overlay IPs of the lighthouses this device reports to
+	Lighthouses []string `json:"lighthouses"`
+	// Overlay IPs of relays peers can use to reach this device
+	Relays  []string `json:"relays"`
+	Tunnels int      `json:"tunnels"`
+	// Tunnels still handshaking
+	Pending    int            `json:"pending"`
+	Handshakes HandshakeStats `json:"handshakes"`
+}
+
+// Process wide handshake counters from Nebula.
+type HandshakeStats struct {
+	Initiated int64 `json:"initiated"`
+	Completed int64 `json:"completed"`
+	TimedOut  int64 `json:"timed_out"`
+}
+
+// A tunnel to another device.
+type Peer struct {
+	Name string `json:"name"`
+	IP   string `json:"ip"`
+	// Underlay address currently used for the tunnel. Empty when relayed.
+	Remote string `json:"remote,omitempty"`
+	// Every known underlay address of the peer
+	RemoteAddrs []string `json:"remote_addrs"`
+	// Overlay IPs of relays used to reach the peer
+	Relays     []string  `json:"relays,omitempty"`
+	Groups     []string  `json:"groups,omitempty"`
+	CertExpiry time.Time `json:"cert_expiry"`
+	Messages   uint64    `json:"messages"`
+}
+
+type PingRequest struct {
+	// Device name or overlay IP
+	Target string `json:"target"`
+	// Give up after this long. Defaults to 5s.
+	Timeout time.Duration `json:"timeout,omitempty"`
+}
+
+// Result of establishing a tunnel to a peer.
+type PingResult struct {
+	Peer Peer `json:"peer"`
+	// Time taken to establish the tunnel. Zero when it already existed.
+	Handshake time.Duration `json:"handshake"`
+}
+
+type errorResponse struct {
+	Error string `json:"error"`
+}
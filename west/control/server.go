@@ -0,0 +1,220 @@
+package control
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/sprisa/west"
+	"github.com/sprisa/west/util/metrics"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+)
+
+// Serves the control API for a running device over a unix socket.
+type Server struct {
+	ctrl     *west.Control
+	statusMu sync.Mutex
+	status   Status
+	srv      *http.Server
+	path     string
+}
+
+// Starts the control API on the unix socket at path. status holds the static
+// device info, live tunnel info is read from ctrl on each request.
+func Listen(path string, ctrl *west.Control, status Status) (*Server, error) {
+	err := os.MkdirAll(filepath.Dir(path), 0700)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error creating control socket dir")
+	}
+	// Clean up a socket left behind by a crash
+	err = os.Remove(path)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, errutil.WrapErr(err, "error removing stale control socket")
+	}
+	ln, err := net.Listen("unix", path)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error listening on control socket")
+	}
+	err = os.Chmod(path, 0600)
+	if err != nil {
+		ln.Close()
+		return nil, errutil.WrapErr(err, "error restricting control socket")
+	}
+
+	s := &Server{ctrl: ctrl, status: status, path: path}
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /status", s.handleStatus)
+	mux.HandleFunc("GET /peers", s.handlePeers)
+	mux.HandleFunc("POST /ping", s.handlePing)
+	s.srv = &http.Server{Handler: mux}
+
+	go func() {
+		err := s.srv.Serve(ln)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			l.Log.Err(err).Msg("control server error")
+		}
+	}()
+	l.Log.Info().Str("path", path).Msg("Started control socket")
+	return s, nil
+}
+
+func (s *Server) Close() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second*5)
+	defer cancel()
+	err := s.srv.Shutdown(ctx)
+	os.Remove(s.path)
+	return err
+}
+
+// Replaces the static device info, e.g. after a config reload.
+func (s *Server) SetStatus(status Status) {
+	s.statusMu.Lock()
+	defer s.statusMu.Unlock()
+	s.status = status
+}
+
+func (s *Server) Status() Status {
+	s.statusMu.Lock()
+	status := s.status
+	s.statusMu.Unlock()
+	status.Tunnels = len(s.ctrl.ListHostmapHosts(false))
+	status.Pending = len(s.ctrl.ListHostmapHosts(true))
+	status.Handshakes = handshakeStats()
+	return status
+}
+
+func (s *Server) Peers() []Peer {
+	hosts := s.ctrl.ListHostmapHosts(false)
+	peers := make([]Peer, 0, len(hosts))
+	for _, h := range hosts {
+		peers = append(peers, toPeer(h))
+	}
+	return peers
+}
+
+// Establishes a tunnel to target, waiting for the handshake to complete.
+func (s *Server) Ping(ctx context.Context, req PingRequest) (*PingResult, error) {
+	ip, err := s.resolve(req.Target)
+	if err != nil {
+		return nil, err
+	}
+	if h := s.ctrl.GetHostInfoByVpnIp(ip, false); h != nil {
+		return &PingResult{Peer: toPeer(*h)}, nil
+	}
+
+	timeout := req.Timeout
+	if timeout <= 0 {
+		timeout = time.Second * 5
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	start := time.Now()
+	s.ctrl.CreateTunnel(ip)
+	ticker := time.NewTicker(time.Millisecond * 20)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("no handshake with `%s` after %s", req.Target, timeout)
+		case <-ticker.C:
+			h := s.ctrl.GetHostInfoByVpnIp(ip, false)
+			if h != nil {
+				return &PingResult{Peer: toPeer(*h), Handshake: time.Since(start)}, nil
+			}
+		}
+	}
+}
+
+// Resolves a device name or overlay IP. Names are matched against the certs
+// of existing tunnels, then looked up through the system resolver.
+func (s *Server) resolve(target string) (netip.Addr, error) {
+	if ip, err := netip.ParseAddr(target); err == nil {
+		return ip, nil
+	}
+	for _, h := range s.ctrl.ListHostmapHosts(false) {
+		if h.Cert != nil && h.Cert.Details.Name == target {
+			return h.VpnIp, nil
+		}
+	}
+	addrs, err := net.LookupHost(target)
+	if err != nil {
+		return netip.Addr{}, fmt.Errorf("unknown device `%s`. Use an overlay ip or full dns name.", target)
+	}
+	for _, addr := range addrs {
+		if ip, err := netip.ParseAddr(addr); err == nil && ip.Is4() {
+			return ip, nil
+		}
+	}
+	return netip.Addr{}, fmt.Errorf("no ipv4 address for `%s`", target)
+}
+
+func (s *Server) handleStatus(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.Status())
+}
+
+func (s *Server) handlePeers(w http.ResponseWriter, r *http.Request) {
+	writeJSON(w, http.StatusOK, s.Peers())
+}
+
+func (s *Server) handlePing(w http.ResponseWriter, r *http.Request) {
+	req := PingRequest{}
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		writeJSON(w, http.StatusBadRequest, errorResponse{Error: "invalid ping request"})
+		return
+	}
+	res, err := s.Ping(r.Context(), req)
+	if err != nil {
+		writeJSON(w, http.StatusBadGateway, errorResponse{Error: err.Error()})
+		return
+	}
+	writeJSON(w, http.StatusOK, res)
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	err := json.NewEncoder(w).Encode(v)
+	if err != nil {
+		l.Log.Err(err).Msg("control: error writing response")
+	}
+}
+
+func toPeer(h west.ControlHostInfo) Peer {
+	peer := Peer{
+		IP:          h.VpnIp.String(),
+		RemoteAddrs: []string{},
+		Messages:    h.MessageCounter,
+	}
+	if h.CurrentRemote.IsValid() {
+		peer.Remote = h.CurrentRemote.String()
+	}
+	for _, addr := range h.RemoteAddrs {
+		peer.RemoteAddrs = append(peer.RemoteAddrs, addr.String())
+	}
+	for _, relay := range h.CurrentRelaysToMe {
+		peer.Relays = append(peer.Relays, relay.String())
+	}
+	if h.Cert != nil {
+		peer.Name = h.Cert.Details.Name
+		peer.Groups = h.Cert.Details.Groups
+		peer.CertExpiry = h.Cert.Details.NotAfter
+	}
+	return peer
+}
+
+func handshakeStats() HandshakeStats {
+	stats := HandshakeStats{}
+	stats.Initiated, stats.Completed, stats.TimedOut = metrics.Handshakes()
+	return stats
+}
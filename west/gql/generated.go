@@ -4,88 +4,423 @@ package gql
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/Khan/genqlient/graphql"
 )
 
-type ProvisionDeviceInput struct {
+// The west build provisioning a device. Sent by `west start`.
+type ClientInfoInput struct {
+	Version string `json:"version"`
+	// GOOS, e.g. linux
+	Os string `json:"os"`
+	// GOARCH, e.g. arm64
+	Arch string `json:"arch"`
+	// Names devices registered with an enrollment key
+	Hostname string `json:"hostname"`
+}
+
+// GetVersion returns ClientInfoInput.Version, and is useful for accessing the field via an interface.
+func (v *ClientInfoInput) GetVersion() string { return v.Version }
+
+// GetOs returns ClientInfoInput.Os, and is useful for accessing the field via an interface.
+func (v *ClientInfoInput) GetOs() string { return v.Os }
+
+// GetArch returns ClientInfoInput.Arch, and is useful for accessing the field via an interface.
+func (v *ClientInfoInput) GetArch() string { return v.Arch }
+
+// GetHostname returns ClientInfoInput.Hostname, and is useful for accessing the field via an interface.
+func (v *ClientInfoInput) GetHostname() string { return v.Hostname }
+
+// DeviceProvision includes the GraphQL fields of ProvisionDeviceResponse requested by the fragment DeviceProvision.
+type DeviceProvision struct {
+	Name          string                                    `json:"name"`
+	Ca            string                                    `json:"ca"`
+	Cert          string                                    `json:"cert"`
+	Key           string                                    `json:"key"`
+	NetworkCipher string                                    `json:"networkCipher"`
+	Unsafe_routes []DeviceProvisionUnsafe_routesUnsafeRoute `json:"unsafe_routes"`
+	Exit_nodes    []DeviceProvisionExit_nodesExitNode       `json:"exit_nodes"`
+	// Overlay IPs of relays peers can use to reach this device
+	Relays []string `json:"relays"`
+	// Device should relay traffic for other devices
+	Am_relay bool `json:"am_relay"`
+	// Lighthouses in addition to west port
+	Lighthouses []DeviceProvisionLighthousesLighthouse `json:"lighthouses"`
+	// Device should act as a lighthouse
+	Am_lighthouse bool `json:"am_lighthouse"`
+	// Public nebula host:port of west port. Empty when not configured
+	Port_endpoint string `json:"port_endpoint"`
+	// Inbound firewall rules for the device. Null when west port doesn't manage
+	// the firewall, in which case all inbound traffic is allowed.
+	Firewall_inbound []DeviceProvisionFirewall_inboundFirewallRule `json:"firewall_inbound"`
+}
+
+// GetName returns DeviceProvision.Name, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetName() string { return v.Name }
+
+// GetCa returns DeviceProvision.Ca, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetCa() string { return v.Ca }
+
+// GetCert returns DeviceProvision.Cert, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetCert() string { return v.Cert }
+
+// GetKey returns DeviceProvision.Key, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetKey() string { return v.Key }
+
+// GetNetworkCipher returns DeviceProvision.NetworkCipher, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetNetworkCipher() string { return v.NetworkCipher }
+
+// GetUnsafe_routes returns DeviceProvision.Unsafe_routes, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetUnsafe_routes() []DeviceProvisionUnsafe_routesUnsafeRoute {
+	return v.Unsafe_routes
+}
+
+// GetExit_nodes returns DeviceProvision.Exit_nodes, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetExit_nodes() []DeviceProvisionExit_nodesExitNode { return v.Exit_nodes }
+
+// GetRelays returns DeviceProvision.Relays, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetRelays() []string { return v.Relays }
+
+// GetAm_relay returns DeviceProvision.Am_relay, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetAm_relay() bool { return v.Am_relay }
+
+// GetLighthouses returns DeviceProvision.Lighthouses, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetLighthouses() []DeviceProvisionLighthousesLighthouse {
+	return v.Lighthouses
+}
+
+// GetAm_lighthouse returns DeviceProvision.Am_lighthouse, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetAm_lighthouse() bool { return v.Am_lighthouse }
+
+// GetPort_endpoint returns DeviceProvision.Port_endpoint, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetPort_endpoint() string { return v.Port_endpoint }
+
+// GetFirewall_inbound returns DeviceProvision.Firewall_inbound, and is useful for accessing the field via an interface.
+func (v *DeviceProvision) GetFirewall_inbound() []DeviceProvisionFirewall_inboundFirewallRule {
+	return v.Firewall_inbound
+}
+
+// DeviceProvisionExit_nodesExitNode includes the requested fields of the GraphQL type ExitNode.
+type DeviceProvisionExit_nodesExitNode struct {
+	Name string `json:"name"`
+	// Overlay IP of the exit node
+	Ip string `json:"ip"`
+}
+
+// GetName returns DeviceProvisionExit_nodesExitNode.Name, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionExit_nodesExitNode) GetName() string { return v.Name }
+
+// GetIp returns DeviceProvisionExit_nodesExitNode.Ip, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionExit_nodesExitNode) GetIp() string { return v.Ip }
+
+// DeviceProvisionFirewall_inboundFirewallRule includes the requested fields of the GraphQL type FirewallRule.
+type DeviceProvisionFirewall_inboundFirewallRule struct {
+	// 0 is any
+	Port int `json:"port"`
+	// any, tcp, udp or icmp
+	Proto string `json:"proto"`
+	// Device name or any
+	Host string `json:"host"`
+	// Peer cert must have all groups
+	Groups []string `json:"groups"`
+	// Peer overlay IP within cidr
+	Cidr string `json:"cidr"`
+}
+
+// GetPort returns DeviceProvisionFirewall_inboundFirewallRule.Port, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionFirewall_inboundFirewallRule) GetPort() int { return v.Port }
+
+// GetProto returns DeviceProvisionFirewall_inboundFirewallRule.Proto, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionFirewall_inboundFirewallRule) GetProto() string { return v.Proto }
+
+// GetHost returns DeviceProvisionFirewall_inboundFirewallRule.Host, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionFirewall_inboundFirewallRule) GetHost() string { return v.Host }
+
+// GetGroups returns DeviceProvisionFirewall_inboundFirewallRule.Groups, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionFirewall_inboundFirewallRule) GetGroups() []string { return v.Groups }
+
+// GetCidr returns DeviceProvisionFirewall_inboundFirewallRule.Cidr, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionFirewall_inboundFirewallRule) GetCidr() string { return v.Cidr }
+
+// DeviceProvisionLighthousesLighthouse includes the requested fields of the GraphQL type Lighthouse.
+type DeviceProvisionLighthousesLighthouse struct {
+	// Overlay IP of the lighthouse
+	Ip string `json:"ip"`
+	// Public underlay host:port addresses of the lighthouse
+	Endpoints []string `json:"endpoints"`
+}
+
+// GetIp returns DeviceProvisionLighthousesLighthouse.Ip, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionLighthousesLighthouse) GetIp() string { return v.Ip }
+
+// GetEndpoints returns DeviceProvisionLighthousesLighthouse.Endpoints, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionLighthousesLighthouse) GetEndpoints() []string { return v.Endpoints }
+
+// DeviceProvisionUnsafe_routesUnsafeRoute includes the requested fields of the GraphQL type UnsafeRoute.
+type DeviceProvisionUnsafe_routesUnsafeRoute struct {
+	// LAN cidr reachable through the device at `via`
+	Route string `json:"route"`
+	// Overlay IP of the subnet router advertising `route`
+	Via string `json:"via"`
+}
+
+// GetRoute returns DeviceProvisionUnsafe_routesUnsafeRoute.Route, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionUnsafe_routesUnsafeRoute) GetRoute() string { return v.Route }
+
+// GetVia returns DeviceProvisionUnsafe_routesUnsafeRoute.Via, and is useful for accessing the field via an interface.
+func (v *DeviceProvisionUnsafe_routesUnsafeRoute) GetVia() string { return v.Via }
+
+type ProvisionDevicePublicKeyInput struct {
 	Token string `json:"token"`
+	// PEM encoded X25519 public key generated by the device
+	Public_key string          `json:"public_key"`
+	Client     ClientInfoInput `json:"client"`
 }
 
-// GetToken returns ProvisionDeviceInput.Token, and is useful for accessing the field via an interface.
-func (v *ProvisionDeviceInput) GetToken() string { return v.Token }
+// GetToken returns ProvisionDevicePublicKeyInput.Token, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyInput) GetToken() string { return v.Token }
 
-// ProvisionDeviceProvision_deviceProvisionDeviceResponse includes the requested fields of the GraphQL type ProvisionDeviceResponse.
-type ProvisionDeviceProvision_deviceProvisionDeviceResponse struct {
-	Name          string `json:"name"`
-	Ca            string `json:"ca"`
-	Cert          string `json:"cert"`
-	Key           string `json:"key"`
-	NetworkCipher string `json:"networkCipher"`
+// GetPublic_key returns ProvisionDevicePublicKeyInput.Public_key, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyInput) GetPublic_key() string { return v.Public_key }
+
+// GetClient returns ProvisionDevicePublicKeyInput.Client, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyInput) GetClient() ClientInfoInput { return v.Client }
+
+// ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse includes the requested fields of the GraphQL type ProvisionDeviceResponse.
+type ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse struct {
+	DeviceProvision `json:"-"`
+	// Token the device provisions with from now on. Differs from the given
+	// token after one-time tokens and enrollment keys.
+	Access_token string `json:"access_token"`
 }
 
-// GetName returns ProvisionDeviceProvision_deviceProvisionDeviceResponse.Name, and is useful for accessing the field via an interface.
-func (v *ProvisionDeviceProvision_deviceProvisionDeviceResponse) GetName() string { return v.Name }
+// GetAccess_token returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Access_token, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetAccess_token() string {
+	return v.Access_token
+}
 
-// GetCa returns ProvisionDeviceProvision_deviceProvisionDeviceResponse.Ca, and is useful for accessing the field via an interface.
-func (v *ProvisionDeviceProvision_deviceProvisionDeviceResponse) GetCa() string { return v.Ca }
+// GetName returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Name, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetName() string {
+	return v.DeviceProvision.Name
+}
+
+// GetCa returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Ca, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetCa() string {
+	return v.DeviceProvision.Ca
+}
 
-// GetCert returns ProvisionDeviceProvision_deviceProvisionDeviceResponse.Cert, and is useful for accessing the field via an interface.
-func (v *ProvisionDeviceProvision_deviceProvisionDeviceResponse) GetCert() string { return v.Cert }
+// GetCert returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Cert, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetCert() string {
+	return v.DeviceProvision.Cert
+}
 
-// GetKey returns ProvisionDeviceProvision_deviceProvisionDeviceResponse.Key, and is useful for accessing the field via an interface.
-func (v *ProvisionDeviceProvision_deviceProvisionDeviceResponse) GetKey() string { return v.Key }
+// GetKey returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Key, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetKey() string {
+	return v.DeviceProvision.Key
+}
 
-// GetNetworkCipher returns ProvisionDeviceProvision_deviceProvisionDeviceResponse.NetworkCipher, and is useful for accessing the field via an interface.
-func (v *ProvisionDeviceProvision_deviceProvisionDeviceResponse) GetNetworkCipher() string {
-	return v.NetworkCipher
+// GetNetworkCipher returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.NetworkCipher, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetNetworkCipher() string {
+	return v.DeviceProvision.NetworkCipher
 }
 
-// ProvisionDeviceResponse is returned by ProvisionDevice on success.
-type ProvisionDeviceResponse struct {
-	Provision_device ProvisionDeviceProvision_deviceProvisionDeviceResponse `json:"provision_device"`
+// GetUnsafe_routes returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Unsafe_routes, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetUnsafe_routes() []DeviceProvisionUnsafe_routesUnsafeRoute {
+	return v.DeviceProvision.Unsafe_routes
 }
 
-// GetProvision_device returns ProvisionDeviceResponse.Provision_device, and is useful for accessing the field via an interface.
-func (v *ProvisionDeviceResponse) GetProvision_device() ProvisionDeviceProvision_deviceProvisionDeviceResponse {
-	return v.Provision_device
+// GetExit_nodes returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Exit_nodes, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetExit_nodes() []DeviceProvisionExit_nodesExitNode {
+	return v.DeviceProvision.Exit_nodes
 }
 
-// __ProvisionDeviceInput is used internally by genqlient
-type __ProvisionDeviceInput struct {
-	Input ProvisionDeviceInput `json:"input"`
+// GetRelays returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Relays, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetRelays() []string {
+	return v.DeviceProvision.Relays
 }
 
-// GetInput returns __ProvisionDeviceInput.Input, and is useful for accessing the field via an interface.
-func (v *__ProvisionDeviceInput) GetInput() ProvisionDeviceInput { return v.Input }
+// GetAm_relay returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Am_relay, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetAm_relay() bool {
+	return v.DeviceProvision.Am_relay
+}
 
-// The mutation executed by ProvisionDevice.
-const ProvisionDevice_Operation = `
-mutation ProvisionDevice ($input: ProvisionDeviceInput!) {
-	provision_device(input: $input) {
+// GetLighthouses returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Lighthouses, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetLighthouses() []DeviceProvisionLighthousesLighthouse {
+	return v.DeviceProvision.Lighthouses
+}
+
+// GetAm_lighthouse returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Am_lighthouse, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetAm_lighthouse() bool {
+	return v.DeviceProvision.Am_lighthouse
+}
+
+// GetPort_endpoint returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Port_endpoint, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetPort_endpoint() string {
+	return v.DeviceProvision.Port_endpoint
+}
+
+// GetFirewall_inbound returns ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse.Firewall_inbound, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) GetFirewall_inbound() []DeviceProvisionFirewall_inboundFirewallRule {
+	return v.DeviceProvision.Firewall_inbound
+}
+
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(
+		b, &v.DeviceProvision)
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+type __premarshalProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse struct {
+	Access_token string `json:"access_token"`
+
+	Name string `json:"name"`
+
+	Ca string `json:"ca"`
+
+	Cert string `json:"cert"`
+
+	Key string `json:"key"`
+
+	NetworkCipher string `json:"networkCipher"`
+
+	Unsafe_routes []DeviceProvisionUnsafe_routesUnsafeRoute `json:"unsafe_routes"`
+
+	Exit_nodes []DeviceProvisionExit_nodesExitNode `json:"exit_nodes"`
+
+	Relays []string `json:"relays"`
+
+	Am_relay bool `json:"am_relay"`
+
+	Lighthouses []DeviceProvisionLighthousesLighthouse `json:"lighthouses"`
+
+	Am_lighthouse bool `json:"am_lighthouse"`
+
+	Port_endpoint string `json:"port_endpoint"`
+
+	Firewall_inbound []DeviceProvisionFirewall_inboundFirewallRule `json:"firewall_inbound"`
+}
+
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse) __premarshalJSON() (*__premarshalProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse, error) {
+	var retval __premarshalProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse
+
+	retval.Access_token = v.Access_token
+	retval.Name = v.DeviceProvision.Name
+	retval.Ca = v.DeviceProvision.Ca
+	retval.Cert = v.DeviceProvision.Cert
+	retval.Key = v.DeviceProvision.Key
+	retval.NetworkCipher = v.DeviceProvision.NetworkCipher
+	retval.Unsafe_routes = v.DeviceProvision.Unsafe_routes
+	retval.Exit_nodes = v.DeviceProvision.Exit_nodes
+	retval.Relays = v.DeviceProvision.Relays
+	retval.Am_relay = v.DeviceProvision.Am_relay
+	retval.Lighthouses = v.DeviceProvision.Lighthouses
+	retval.Am_lighthouse = v.DeviceProvision.Am_lighthouse
+	retval.Port_endpoint = v.DeviceProvision.Port_endpoint
+	retval.Firewall_inbound = v.DeviceProvision.Firewall_inbound
+	return &retval, nil
+}
+
+// ProvisionDevicePublicKeyResponse is returned by ProvisionDevicePublicKey on success.
+type ProvisionDevicePublicKeyResponse struct {
+	// Signs a cert for a device generated public key. `key` is empty in the response.
+	Provision_device_public_key ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse `json:"provision_device_public_key"`
+}
+
+// GetProvision_device_public_key returns ProvisionDevicePublicKeyResponse.Provision_device_public_key, and is useful for accessing the field via an interface.
+func (v *ProvisionDevicePublicKeyResponse) GetProvision_device_public_key() ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse {
+	return v.Provision_device_public_key
+}
+
+// __ProvisionDevicePublicKeyInput is used internally by genqlient
+type __ProvisionDevicePublicKeyInput struct {
+	Input ProvisionDevicePublicKeyInput `json:"input"`
+}
+
+// GetInput returns __ProvisionDevicePublicKeyInput.Input, and is useful for accessing the field via an interface.
+func (v *__ProvisionDevicePublicKeyInput) GetInput() ProvisionDevicePublicKeyInput { return v.Input }
+
+// The mutation executed by ProvisionDevicePublicKey.
+const ProvisionDevicePublicKey_Operation = `
+mutation ProvisionDevicePublicKey ($input: ProvisionDevicePublicKeyInput!) {
+	provision_device_public_key(input: $input) {
+		... DeviceProvision
+		access_token
+	}
+}
+fragment DeviceProvision on ProvisionDeviceResponse {
+	name
+	ca
+	cert
+	key
+	networkCipher
+	unsafe_routes {
+		route
+		via
+	}
+	exit_nodes {
 		name
-		ca
-		cert
-		key
-		networkCipher
+		ip
+	}
+	relays
+	am_relay
+	lighthouses {
+		ip
+		endpoints
+	}
+	am_lighthouse
+	port_endpoint
+	firewall_inbound {
+		port
+		proto
+		host
+		groups
+		cidr
 	}
 }
 `
 
-func ProvisionDevice(
+func ProvisionDevicePublicKey(
 	ctx_ context.Context,
 	client_ graphql.Client,
-	input ProvisionDeviceInput,
-) (data_ *ProvisionDeviceResponse, err_ error) {
+	input ProvisionDevicePublicKeyInput,
+) (data_ *ProvisionDevicePublicKeyResponse, err_ error) {
 	req_ := &graphql.Request{
-		OpName: "ProvisionDevice",
-		Query:  ProvisionDevice_Operation,
-		Variables: &__ProvisionDeviceInput{
+		OpName: "ProvisionDevicePublicKey",
+		Query:  ProvisionDevicePublicKey_Operation,
+		Variables: &__ProvisionDevicePublicKeyInput{
 			Input: input,
 		},
 	}
 
-	data_ = &ProvisionDeviceResponse{}
+	data_ = &ProvisionDevicePublicKeyResponse{}
 	resp_ := &graphql.Response{Data: data_}
 
 	err_ = client_.MakeRequest(
@@ -0,0 +1,183 @@
+package west
+
+import (
+	"context"
+	"errors"
+	"os"
+	"time"
+
+	"github.com/cqroot/prompt"
+	"github.com/cqroot/prompt/input"
+	"github.com/sprisa/west/util/service"
+	"github.com/sprisa/west/west/state"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+var stateDirFlag = &cli.StringFlag{
+	Name:  "state-dir",
+	Usage: "Directory to store the device enrollment. Defaults to the user config dir or WEST_STATE_DIR.",
+	Action: func(ctx context.Context, c *cli.Command, dir string) error {
+		state.Dir = dir
+		return nil
+	},
+}
+
+var LoginCommand = &cli.Command{
+	Name:      "login",
+	Aliases:   []string{"enroll"},
+	Usage:     "Provision this device and save the enrollment for `west start`",
+	UsageText: "west login [jwt_token]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "token",
+			Aliases: []string{"t"},
+			Usage:   "API token. Can be passed via flag or stdin.",
+		},
+		&cli.BoolFlag{
+			Name:  "encrypt",
+			Usage: "Encrypt the enrollment with a password. Read from WEST_STATE_PASSWORD or prompted.",
+		},
+		stateDirFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		token, err := readToken(c.String("token"))
+		if err != nil {
+			return err
+		}
+		if token == "" {
+			return errors.New("No token supplied. Pass via flag or stdin.")
+		}
+
+		var password []byte
+		if c.Bool("encrypt") {
+			password, err = readStatePassword()
+			if err != nil {
+				return err
+			}
+		}
+
+		enrollment, err := provision(ctx, token, nil)
+		if err != nil {
+			return err
+		}
+
+		err = state.Save(enrollment, password)
+		if err != nil {
+			return errutil.WrapErr(err, "error saving enrollment")
+		}
+
+		l.Log.Info().
+			Str("dir", state.Dir).
+			Bool("encrypted", len(password) > 0).
+			Msg("Device enrolled. Use `west start` to run")
+		return nil
+	},
+}
+
+var LogoutCommand = &cli.Command{
+	Name:      "logout",
+	Usage:     "Remove the saved device enrollment",
+	UsageText: "west logout",
+	Flags: []cli.Flag{
+		stateDirFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		err := state.Remove()
+		if err != nil {
+			return errutil.WrapErr(err, "error removing enrollment")
+		}
+		l.Log.Info().Str("dir", state.Dir).Msg("Device enrollment removed")
+		return nil
+	},
+}
+
+// Loads the device enrollment for `west start`. An explicit token always
+// provisions from scratch and isn't saved. Otherwise the saved enrollment is
+// used, re-provisioning when the cert nears expiry or refresh is requested.
+// Provisioning is retried while west port is unreachable. save persists later
+// refreshes and is nil when the enrollment isn't saved.
+func loadEnrollment(ctx context.Context, token string, refresh bool) (enrollment *state.Enrollment, save func(*state.Enrollment) error, err error) {
+	if token != "" {
+		err = retry(ctx, "Provisioning", func() error {
+			enrollment, err = provision(ctx, token, nil)
+			return err
+		})
+		return enrollment, nil, err
+	}
+	if !state.Exists() {
+		return nil, nil, errors.New("No token supplied and device not enrolled. Pass a token via flag or stdin, or run `west login`.")
+	}
+
+	var password []byte
+	if state.Encrypted() {
+		password, err = readStatePassword()
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	save = func(e *state.Enrollment) error {
+		return state.Save(e, password)
+	}
+	enrollment, err = state.Load(password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !refresh && !enrollment.NeedsRefresh() {
+		l.Log.Info().
+			Str("name", enrollment.Device.Name).
+			Time("provisioned", enrollment.ProvisionedAt).
+			Msg("Using saved enrollment")
+		return enrollment, save, nil
+	}
+
+	l.Log.Info().Msg("Re-provisioning device")
+	fresh, err := provision(ctx, enrollment.Token, []byte(enrollment.Device.Key))
+	if err != nil {
+		// Keep running on the saved cert while it's still valid.
+		// Check-ins retry in the background.
+		expiry, certErr := enrollment.CertExpiry()
+		if certErr == nil && time.Now().Before(expiry) {
+			l.Log.Err(err).
+				Time("expires", expiry).
+				Msg("Re-provisioning failed. Using saved enrollment")
+			return enrollment, save, nil
+		}
+		fresh, err = reprovision(ctx, enrollment)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	err = save(fresh)
+	if err != nil {
+		return nil, nil, errutil.WrapErr(err, "error saving enrollment")
+	}
+	return fresh, save, nil
+}
+
+// Reads the enrollment password from the systemd credential,
+// WEST_STATE_PASSWORD or prompts for it.
+func readStatePassword() ([]byte, error) {
+	cred, err := service.ReadCredential(statePasswordCredential)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error reading credential")
+	}
+	if len(cred) > 0 {
+		return cred, nil
+	}
+	if pswd := os.Getenv("WEST_STATE_PASSWORD"); pswd != "" {
+		return []byte(pswd), nil
+	}
+	pswd, err := prompt.New().Ask("enrollment password:").
+		Input("", input.WithEchoMode(input.EchoPassword), input.WithHelp(true))
+	if err != nil {
+		return nil, err
+	}
+	if pswd == "" {
+		return nil, errors.New("enrollment password is required")
+	}
+	return []byte(pswd), nil
+}
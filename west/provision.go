@@ -0,0 +1,112 @@
+package west
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"runtime"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sprisa/west"
+	"github.com/sprisa/west/util/auth"
+	"github.com/sprisa/west/util/ioutil"
+	"github.com/sprisa/west/util/pki"
+	"github.com/sprisa/west/west/gql"
+	"github.com/sprisa/west/west/state"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+)
+
+// Reads the provisioning token from the flag or stdin. Returns empty when
+// neither is supplied.
+func readToken(token string) (string, error) {
+	// Read via stdin if available
+	if token == "" && ioutil.StdinAvailable() {
+		tokenBytes, err := io.ReadAll(os.Stdin)
+		if err != nil {
+			return "", err
+		}
+		token = string(bytes.TrimSpace(tokenBytes))
+	}
+	return token, nil
+}
+
+// Provisions the device with west port, returning a new enrollment.
+// Only the public key of key is sent to the port. A new key pair is
+// generated when key is nil.
+func provision(ctx context.Context, token string, key []byte) (*state.Enrollment, error) {
+	parser := jwt.NewParser()
+	claims := &auth.TokenClaims{}
+	_, _, err := parser.ParseUnverified(token, claims)
+	if err != nil {
+		return nil, permanent(fmt.Errorf("error parsing token: %w", err))
+	}
+	if claims.ExpiresAt.Before(time.Now()) {
+		return nil, permanent(errors.New("token expired"))
+	}
+
+	endpoint := os.Getenv("WEST_ENDPOINT")
+	if endpoint == "" {
+		endpoint = claims.Endpoint
+	}
+
+	l.Log.Debug().Msgf("claims: %+v", claims)
+
+	var pub []byte
+	if key == nil {
+		pub, key = pki.NewKeypair()
+	} else {
+		pub, err = pki.PublicKeyFromPrivate(key)
+		if err != nil {
+			return nil, permanent(err)
+		}
+	}
+
+	hostname, _ := os.Hostname()
+	client := graphql.NewClient(endpoint, http.DefaultClient)
+	data, err := gql.ProvisionDevicePublicKey(ctx, client, gql.ProvisionDevicePublicKeyInput{
+		Token:      token,
+		Public_key: string(pub),
+		Client: gql.ClientInfoInput{
+			Version:  west.Build,
+			Os:       runtime.GOOS,
+			Arch:     runtime.GOARCH,
+			Hostname: hostname,
+		},
+	})
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error provisioning device")
+	}
+	res := data.GetProvision_device_public_key()
+	dvc := res.DeviceProvision
+	dvc.Key = string(key)
+
+	// One-time tokens and enrollment keys are exchanged for a device token.
+	// Older ports return a placeholder.
+	if res.Access_token != token {
+		fresh := &auth.TokenClaims{}
+		_, _, err := parser.ParseUnverified(res.Access_token, fresh)
+		if err == nil {
+			token, claims = res.Access_token, fresh
+		}
+	}
+	l.Log.Info().
+		Str("name", dvc.Name).
+		Str("ip", claims.IP).
+		Msg("Received provisioning")
+
+	return &state.Enrollment{
+		Token:         token,
+		Endpoint:      endpoint,
+		PortIP:        claims.PortIP,
+		PortEndpoint:  claims.PortEndpoint,
+		Device:        dvc,
+		ProvisionedAt: time.Now(),
+	}, nil
+}
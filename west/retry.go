@@ -0,0 +1,75 @@
+package west
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	l "github.com/sprisa/x/log"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+)
+
+// Backoff between attempts to reach west port
+var (
+	retryMin = time.Second
+	retryMax = 5 * time.Minute
+)
+
+// An error retrying won't fix, e.g. an expired token
+type permanentError struct {
+	error
+}
+
+func (e permanentError) Unwrap() error {
+	return e.error
+}
+
+func permanent(err error) error {
+	return permanentError{err}
+}
+
+// Reports if err can't be fixed by retrying. West port answering with a
+// GraphQL error has rejected the request, while HTTP errors are usually a
+// proxy in front of a port that is still starting.
+func isPermanent(err error) bool {
+	if errors.As(err, &permanentError{}) {
+		return true
+	}
+	var httpErr *graphql.HTTPError
+	if errors.As(err, &httpErr) {
+		return false
+	}
+	var gqlErrs gqlerror.List
+	return errors.As(err, &gqlErrs)
+}
+
+// Calls fn until it succeeds, backing off exponentially between failures.
+// Gives up on a permanent error or when ctx is done.
+func retry(ctx context.Context, what string, fn func() error) error {
+	wait := retryMin
+	for attempt := 1; ; attempt++ {
+		err := fn()
+		if err == nil {
+			return nil
+		}
+		if isPermanent(err) {
+			return err
+		}
+
+		// Jitter so a fleet booting together doesn't hit the port in lockstep
+		delay := wait/2 + rand.N(wait/2+1)
+		l.Log.Warn().
+			Err(err).
+			Int("attempt", attempt).
+			Dur("retry_in", delay).
+			Msgf("%s failed", what)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		wait = min(wait*2, retryMax)
+	}
+}
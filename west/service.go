@@ -0,0 +1,102 @@
+package west
+
+import (
+	"context"
+	"errors"
+
+	"github.com/sprisa/west/util/service"
+	"github.com/sprisa/west/west/state"
+	"github.com/sprisa/x/errutil"
+	"github.com/urfave/cli/v3"
+)
+
+const serviceName = "west"
+
+// State dir of the service. Created by systemd with StateDirectory.
+const serviceStateDir = "/var/lib/west"
+
+// Credential holding the enrollment password
+const statePasswordCredential = "state-password"
+
+var ServiceCommand = &cli.Command{
+	Name:      "service",
+	Usage:     "Run west as a systemd service",
+	UsageText: "west service install",
+	Commands: append([]*cli.Command{
+		ServiceInstallCommand,
+	}, service.Commands(serviceName)...),
+	Action: func(ctx context.Context, cmd *cli.Command) error {
+		return cli.ShowSubcommandHelp(cmd)
+	},
+}
+
+var ServiceInstallCommand = &cli.Command{
+	Name:      "install",
+	Usage:     "Install and start the west systemd service. Linux only.",
+	UsageText: "west service install [--token jwt_token] [-- west start flags]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "token",
+			Aliases: []string{"t"},
+			Usage:   "API token to enroll the service with. Can be passed via flag or stdin. Not needed if already enrolled in " + serviceStateDir + ".",
+		},
+		&cli.BoolFlag{
+			Name:  "encrypt",
+			Usage: "Encrypt the enrollment with a password. Read from WEST_STATE_PASSWORD or prompted.",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		state.Dir = serviceStateDir
+		token, err := readToken(c.String("token"))
+		if err != nil {
+			return err
+		}
+
+		var password []byte
+		switch {
+		case token != "":
+			if c.Bool("encrypt") {
+				password, err = readStatePassword()
+				if err != nil {
+					return err
+				}
+			}
+			enrollment, err := provision(ctx, token, nil)
+			if err != nil {
+				return err
+			}
+			err = state.Save(enrollment, password)
+			if err != nil {
+				return errutil.WrapErr(err, "error saving enrollment")
+			}
+		case !state.Exists():
+			return errors.New("No token supplied and service not enrolled. Pass a token via flag or stdin.")
+		case state.Encrypted():
+			password, err = readStatePassword()
+			if err != nil {
+				return err
+			}
+			// Catch a wrong password now rather than in a restart loop
+			_, err = state.Load(password)
+			if err != nil {
+				return err
+			}
+		}
+
+		creds := []service.Credential{}
+		if len(password) > 0 {
+			creds = append(creds, service.Credential{
+				Name:   statePasswordCredential,
+				Secret: password,
+			})
+		}
+		return service.Install(&service.Unit{
+			Name:           serviceName,
+			Description:    "West mesh network device",
+			Args:           append([]string{"start", "--state-dir", serviceStateDir}, c.Args().Slice()...),
+			StateDirectory: "west",
+			// Tun device, routes and lighthouse ports below 1024
+			Capabilities: []string{"CAP_NET_ADMIN", "CAP_NET_BIND_SERVICE"},
+		}, creds)
+	},
+}
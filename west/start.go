@@ -1,24 +1,25 @@
 package west
 
 import (
-	"bytes"
 	"context"
 	"errors"
 	"fmt"
-	"io"
 	"net"
-	"net/http"
 	"net/url"
-	"os"
+	"strconv"
+	"sync"
 	"time"
 
-	"github.com/Khan/genqlient/graphql"
-	"github.com/golang-jwt/jwt/v5"
+	"github.com/samber/lo"
 	"github.com/sprisa/west"
 	"github.com/sprisa/west/config"
-	"github.com/sprisa/west/util/auth"
-	"github.com/sprisa/west/util/ioutil"
+	"github.com/sprisa/west/util/metrics"
+	"github.com/sprisa/west/util/proxy"
+	"github.com/sprisa/west/util/route"
+	"github.com/sprisa/west/util/service"
+	"github.com/sprisa/west/west/control"
 	"github.com/sprisa/west/west/gql"
+	"github.com/sprisa/west/west/state"
 	"github.com/sprisa/x/errutil"
 	l "github.com/sprisa/x/log"
 	"github.com/sprisa/x/netutil"
@@ -31,12 +32,15 @@ var StartCommand = &cli.Command{
 	UsageText: "west start [jwt_token]",
 	Flags: []cli.Flag{
 		&cli.StringFlag{
-			// TODO: Would be better if this was set in a different command
-			// then stored in a secure enclave for start.
 			Name:    "token",
 			Aliases: []string{"t"},
-			Usage:   "API token. Can be passed via flag or stdin.",
+			Usage:   "API token. Can be passed via flag or stdin. Not needed after `west login`.",
 		},
+		&cli.BoolFlag{
+			Name:  "refresh",
+			Usage: "Re-provision the saved enrollment before starting",
+		},
+		stateDirFlag,
 		&cli.BoolFlag{
 			Name:  "disable-tun",
 			Usage: "Disabled TUN network binding. Useful for rootless testing",
@@ -45,112 +49,432 @@ var StartCommand = &cli.Command{
 			Name:  "port",
 			Usage: "Port to use for Nebula. Defaults to a random free port.",
 		},
+		&cli.StringFlag{
+			Name:  "exit-node",
+			Usage: "Name of an exit node device to route all internet traffic through. Linux only.",
+		},
+		&cli.BoolFlag{
+			Name:  "userspace",
+			Usage: "Use a userspace network stack instead of a TUN device. Overlay traffic goes through the local proxy. Needs no root.",
+		},
+		&cli.StringFlag{
+			Name:  "proxy",
+			Value: "127.0.0.1:1080",
+			Usage: "Address of the SOCKS5 and HTTP CONNECT proxy into the mesh. Only used with --userspace.",
+		},
+		controlSocketFlag,
+		&cli.StringFlag{
+			Name:  "metrics",
+			Usage: "Address to serve Prometheus metrics on, e.g. 127.0.0.1:9101. Disabled by default.",
+		},
+		&cli.DurationFlag{
+			Name:  "check-in",
+			Value: time.Hour,
+			Usage: "How often to check west port for a renewed cert or network changes. 0 disables.",
+		},
 	},
 	Action: func(ctx context.Context, c *cli.Command) error {
-		port := c.Int("port")
-		disableTun := c.Bool("disable-tun")
-		token := c.String("token")
-		// Read via stdin if available
-		if token == "" && ioutil.StdinAvailable() {
-			tokenBytes, err := io.ReadAll(os.Stdin)
-			if err != nil {
-				return err
-			}
-			token = string(bytes.TrimSpace(tokenBytes))
+		opts := &startOpts{
+			port:          c.Int("port"),
+			disableTun:    c.Bool("disable-tun"),
+			exitNode:      c.String("exit-node"),
+			userspace:     c.Bool("userspace"),
+			proxyAddr:     c.String("proxy"),
+			controlSocket: controlSocketPath(c),
+			startedAt:     time.Now(),
 		}
-		if token == "" {
-			return errors.New("No token supplied. Pass via flag or stdin.")
+		if opts.exitNode != "" && opts.disableTun {
+			return errors.New("exit node cannot be used with tun disabled")
 		}
-
-		parser := jwt.NewParser()
-		claims := &auth.TokenClaims{}
-		_, _, err := parser.ParseUnverified(token, claims)
+		if opts.userspace && (opts.disableTun || opts.exitNode != "") {
+			return errors.New("userspace networking cannot be used with tun disabled or an exit node")
+		}
+		token, err := readToken(c.String("token"))
 		if err != nil {
-			return fmt.Errorf("error parsing token: %w", err)
+			return err
 		}
-		if claims.ExpiresAt.Before(time.Now()) {
-			return errors.New("token expired")
+		enrollment, save, err := loadEnrollment(ctx, token, c.Bool("refresh"))
+		if err != nil {
+			return err
 		}
 
-		endpoint := os.Getenv("WEST_ENDPOINT")
-		if endpoint == "" {
-			endpoint = claims.Endpoint
+		if addr := c.String("metrics"); addr != "" {
+			l.Log.Info().Str("addr", addr).Msg("Serving metrics")
+			go func() {
+				err := metrics.Serve(ctx, addr)
+				if err != nil {
+					l.Log.Err(err).Msg("metrics server error")
+				}
+			}()
 		}
-		url, err := url.Parse(endpoint)
+
+		srv, err := newDeviceServer(enrollment, opts)
 		if err != nil {
-			return errutil.WrapErr(err, "error parsing endpoint")
+			return err
 		}
 
-		l.Log.Debug().Msgf("claims: %+v", claims)
+		// Check-ins reload nebula in place. Changes it can only apply on
+		// start stop the run so the service manager restarts it.
+		runCtx, stop := context.WithCancelCause(ctx)
+		defer stop(nil)
+		if checkInInterval := c.Duration("check-in"); checkInInterval > 0 {
+			go func() {
+				current := enrollment
+				for {
+					fresh := checkIn(runCtx, checkInInterval, current, save)
+					if fresh == nil {
+						return
+					}
+					err := srv.reload(fresh)
+					if errors.Is(err, west.ErrRestartRequired) {
+						stop(errors.New("enrollment changed the overlay ip, cipher or lighthouse mode. Restart west start to apply it"))
+						return
+					}
+					if err != nil {
+						l.Log.Err(err).Msg("error applying new enrollment")
+						continue
+					}
+					l.Log.Info().Msg("Reloaded nebula with new enrollment")
+					current = fresh
+				}
+			}()
+		}
 
-		client := graphql.NewClient(endpoint, http.DefaultClient)
-		data, err := gql.ProvisionDevice(ctx, client, gql.ProvisionDeviceInput{
-			Token: token,
+		err = srv.Listen(runCtx)
+		if err != nil {
+			return err
+		}
+		if ctx.Err() == nil {
+			return context.Cause(runCtx)
+		}
+		return nil
+	},
+}
+
+type startOpts struct {
+	port int
+	// Picked on first start when no port is set so reloads keep it
+	freePort      int
+	disableTun    bool
+	exitNode      string
+	userspace     bool
+	proxyAddr     string
+	controlSocket string
+	startedAt     time.Time
+}
+
+// A running device, reloaded in place when its enrollment changes
+type deviceServer struct {
+	*west.Server
+	opts *startOpts
+	// Set once nebula has started
+	controlMu sync.Mutex
+	control   *control.Server
+}
+
+// Builds the nebula server for enrollment along with the exit node routes
+// and control socket hooks.
+func newDeviceServer(enrollment *state.Enrollment, opts *startOpts) (*deviceServer, error) {
+	cfg, status, err := deviceConfig(enrollment, opts)
+	if err != nil {
+		return nil, err
+	}
+	d := &deviceServer{opts: opts}
+
+	// A failing hook stops the server
+	var startHooks []func(*west.Control) error
+	var shutdownHooks []func()
+	if opts.exitNode != "" {
+		var cleanup func() error
+		// Traffic would silently leave locally without the routes
+		startHooks = append(startHooks, func(ctrl *west.Control) error {
+			c, err := route.InstallExitNode(ctrl.Device().Name())
+			if err != nil {
+				return errutil.WrapErr(err, "error installing exit node routes")
+			}
+			cleanup = c
+			return nil
 		})
+		shutdownHooks = append(shutdownHooks, func() {
+			if cleanup == nil {
+				return
+			}
+			err := cleanup()
+			if err != nil {
+				l.Log.Err(err).Msg("error removing exit node routes")
+			}
+		})
+	}
+
+	// Local control API for `west status`, `west peers` and `west ping`
+	startHooks = append(startHooks, func(ctrl *west.Control) error {
+		status.Interface = ctrl.Device().Name()
+		server, err := control.Listen(opts.controlSocket, ctrl, status)
 		if err != nil {
-			return errutil.WrapErr(err, "error provisioning device")
+			return err
+		}
+		d.controlMu.Lock()
+		d.control = server
+		d.controlMu.Unlock()
+		return nil
+	})
+	shutdownHooks = append(shutdownHooks, func() {
+		d.controlMu.Lock()
+		defer d.controlMu.Unlock()
+		if d.control != nil {
+			d.control.Close()
 		}
-		dvc := data.GetProvision_device()
+	})
+	// Proxy into the mesh for userspace networking
+	if opts.userspace {
+		proxyCtx, stopProxy := context.WithCancel(context.Background())
+		startHooks = append(startHooks, func(ctrl *west.Control) error {
+			// The proxy is the only way into the mesh
+			ln, err := net.Listen("tcp", opts.proxyAddr)
+			if err != nil {
+				return errutil.WrapErr(err, "error starting proxy on %s", opts.proxyAddr)
+			}
+			l.Log.Info().Str("addr", ln.Addr().String()).Msg("Started SOCKS5 and HTTP CONNECT proxy")
+			go func() {
+				err := proxy.Serve(proxyCtx, ln, d.Dial)
+				if err != nil {
+					l.Log.Err(err).Msg("proxy error")
+				}
+			}()
+			return nil
+		})
+		shutdownHooks = append(shutdownHooks, stopProxy)
+	}
+
+	d.Server, err = west.NewServer(&west.ServerOpts{
+		Userspace: opts.userspace,
+		// Resolve names through port's magic dns
+		DNS: enrollment.PortIP,
+		OnStartErr: func(ctrl *west.Control) error {
+			for _, hook := range startHooks {
+				err := hook(ctrl)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		},
+		// Type=notify services are up once the lighthouse handshake completes
+		OnReady: func(ctrl *west.Control) {
+			err := service.NotifyReady()
+			if err != nil {
+				l.Log.Err(err).Msg("error notifying systemd")
+			}
+		},
+		OnPeerChange: func(change west.PeerChange) {
+			name := ""
+			if change.Host.Cert != nil {
+				name = change.Host.Cert.Details.Name
+			}
+			msg := "Tunnel closed"
+			if change.Up {
+				msg = "Tunnel established"
+			}
+			l.Log.Info().
+				Str("name", name).
+				Str("ip", change.Host.VpnIp.String()).
+				Msg(msg)
+		},
+		OnShutdown: func() {
+			for i := len(shutdownHooks) - 1; i >= 0; i-- {
+				shutdownHooks[i]()
+			}
+		},
+		Config: cfg,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return d, nil
+}
+
+// Applies a fresh enrollment to the running server. Returns
+// west.ErrRestartRequired when nebula can't apply it in place.
+func (d *deviceServer) reload(enrollment *state.Enrollment) error {
+	cfg, status, err := deviceConfig(enrollment, d.opts)
+	if err != nil {
+		return err
+	}
+	err = d.Reload(cfg)
+	if err != nil {
+		return err
+	}
+	d.controlMu.Lock()
+	defer d.controlMu.Unlock()
+	if d.control != nil {
+		status.Interface = d.IFaceName()
+		d.control.SetStatus(status)
+	}
+	return nil
+}
+
+// Nebula config for enrollment and the device info served on the control
+// socket.
+func deviceConfig(enrollment *state.Enrollment, opts *startOpts) (*config.Config, control.Status, error) {
+	dvc := enrollment.Device
+	url, err := url.Parse(enrollment.Endpoint)
+	if err != nil {
+		return nil, control.Status{}, errutil.WrapErr(err, "error parsing endpoint")
+	}
+
+	unsafeRoutes := []config.TunUnsafeRoute{}
+	for _, r := range dvc.Unsafe_routes {
 		l.Log.Info().
-			Str("name", dvc.Name).
-			Str("ip", claims.IP).
-			Msg("Received provisioning")
+			Str("route", r.Route).
+			Str("via", r.Via).
+			Msg("Adding subnet route")
+		unsafeRoutes = append(unsafeRoutes, config.TunUnsafeRoute{
+			Route: r.Route,
+			Via:   r.Via,
+		})
+	}
+
+	if opts.userspace && len(unsafeRoutes) > 0 {
+		l.Log.Warn().Msg("Subnet routes are not reachable with userspace networking")
+	}
+
+	soMark := 0
+	if opts.exitNode != "" {
+		exitNode, found := lo.Find(dvc.Exit_nodes, func(n gql.DeviceProvisionExit_nodesExitNode) bool {
+			return n.Name == opts.exitNode
+		})
+		if !found {
+			return nil, control.Status{}, fmt.Errorf("exit node `%s` not found", opts.exitNode)
+		}
+		l.Log.Info().
+			Str("name", exitNode.Name).
+			Str("via", exitNode.Ip).
+			Msg("Routing internet traffic through exit node")
+
+		// Nebula only uses the route to pick the exit node as the next hop.
+		// The system route is installed with policy routing once the tun is up.
+		unsafeRoutes = append(unsafeRoutes, config.TunUnsafeRoute{
+			Route:   route.DefaultRoute,
+			Via:     exitNode.Ip,
+			Install: lo.ToPtr(false),
+		})
+		soMark = route.ExitNodeMark
+	}
 
+	// Prefer the latest endpoint from the port, then the token.
+	// Older tokens assume the API host on the default port.
+	portEndpoint := dvc.Port_endpoint
+	if portEndpoint == "" {
+		portEndpoint = enrollment.PortEndpoint
+	}
+	if portEndpoint == "" {
+		portEndpoint = net.JoinHostPort(url.Hostname(), strconv.Itoa(config.DefaultLighthousePort))
+	}
+	staticHostMap := config.StaticHostMap{
+		enrollment.PortIP: []string{portEndpoint},
+	}
+	lighthouseHosts := []string{enrollment.PortIP}
+	for _, lh := range dvc.Lighthouses {
+		staticHostMap[lh.Ip] = lh.Endpoints
+		lighthouseHosts = append(lighthouseHosts, lh.Ip)
+	}
+	port := opts.port
+	if dvc.Am_lighthouse {
+		// Lighthouses don't report to other lighthouses
+		lighthouseHosts = nil
+		// Lighthouses need a fixed port matching their public endpoint
 		if port == 0 {
-			port, err = netutil.GetFreePort()
+			port = config.DefaultLighthousePort
+		}
+		l.Log.Info().Int("port", port).Msg("Running as lighthouse")
+	}
+
+	if port == 0 {
+		if opts.freePort == 0 {
+			opts.freePort, err = netutil.GetFreePort()
 			if err != nil {
-				return errutil.WrapErr(err, "erroring find free port")
+				return nil, control.Status{}, errutil.WrapErr(err, "erroring find free port")
 			}
 		}
+		port = opts.freePort
+	}
 
-		srv, err := west.NewServer(&west.ServerOpts{
-			Config: &config.Config{
-				Pki: config.Pki{
-					Ca:   dvc.Ca,
-					Cert: dvc.Cert,
-					Key:  dvc.Key,
-				},
-				StaticHostMap: config.StaticHostMap{
-					claims.PortIP: []string{
-						net.JoinHostPort(url.Hostname(), "4242"),
-					},
-				},
-				Lighthouse: config.Lighthouse{
-					Hosts: []string{
-						claims.PortIP,
-					},
-				},
-				Tun: config.Tun{
-					Disabled: disableTun,
-				},
-				Listen: config.Listen{
-					Host: "::",
-					Port: port,
-				},
-				PreferredRanges: config.DefaultPreferredRanges,
-				Cipher:          config.Cipher(dvc.NetworkCipher),
-				Firewall: config.Firewall{
-					Inbound: []config.FirewallRule{
-						{
-							Port:  config.PortAny,
-							Proto: config.ProtoAny,
-							Host:  config.HostAny,
-						},
-					},
-					Outbound: []config.FirewallRule{
-						{
-							Port:  config.PortAny,
-							Proto: config.ProtoAny,
-							Host:  config.HostAny,
-						},
-					},
+	cert, err := enrollment.Cert()
+	if err != nil {
+		return nil, control.Status{}, err
+	}
+	status := control.Status{
+		Name:        dvc.Name,
+		IP:          cert.Details.Ips[0].IP.String(),
+		Version:     west.Build,
+		StartedAt:   opts.startedAt,
+		CertExpiry:  cert.Details.NotAfter,
+		Lighthouses: append([]string{}, lighthouseHosts...),
+		Relays:      dvc.Relays,
+	}
+
+	cfg := &config.Config{
+		Pki: config.Pki{
+			Ca:   dvc.Ca,
+			Cert: dvc.Cert,
+			Key:  dvc.Key,
+		},
+		StaticHostMap: staticHostMap,
+		Lighthouse: config.Lighthouse{
+			AmLighthouse: dvc.Am_lighthouse,
+			Hosts:        lighthouseHosts,
+		},
+		Relay: config.Relay{
+			Relays:  dvc.Relays,
+			AmRelay: dvc.Am_relay,
+		},
+		Tun: config.Tun{
+			Disabled:     opts.disableTun,
+			UnsafeRoutes: unsafeRoutes,
+		},
+		Listen: config.Listen{
+			Host:   "::",
+			Port:   port,
+			SoMark: soMark,
+		},
+		PreferredRanges: config.DefaultPreferredRanges,
+		Cipher:          config.Cipher(dvc.NetworkCipher),
+		Firewall: config.Firewall{
+			Inbound: inboundRules(dvc),
+			Outbound: []config.FirewallRule{
+				{
+					Port:  config.PortAny,
+					Proto: config.ProtoAny,
+					Host:  config.HostAny,
 				},
 			},
-		})
-		if err != nil {
-			return err
-		}
+		},
+	}
+	return cfg, status, nil
+}
 
-		return srv.Listen(ctx)
-	},
+// Inbound firewall rules pushed by west port. Allows all inbound traffic
+// when the port doesn't manage the firewall.
+func inboundRules(dvc gql.DeviceProvision) []config.FirewallRule {
+	if dvc.Firewall_inbound == nil {
+		return []config.FirewallRule{
+			{
+				Port:  config.PortAny,
+				Proto: config.ProtoAny,
+				Host:  config.HostAny,
+			},
+		}
+	}
+	rules := []config.FirewallRule{}
+	for _, r := range dvc.Firewall_inbound {
+		rules = append(rules, config.FirewallRule{
+			Port:   r.Port,
+			Proto:  config.Proto(r.Proto),
+			Host:   r.Host,
+			Groups: r.Groups,
+			Cidr:   r.Cidr,
+		})
+	}
+	return rules
 }
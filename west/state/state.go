@@ -0,0 +1,247 @@
+package state
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/sprisa/west/util/pki"
+	"github.com/sprisa/west/west/gql"
+	"github.com/sprisa/x/errutil"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/chacha20poly1305"
+)
+
+// Directory holding the device enrollment. Defaults to the user config dir,
+// e.g. ~/.config/west on Linux, or /var/lib/west when there is no home dir.
+// Can be overridden with WEST_STATE_DIR.
+var Dir string = defaultDir()
+
+// Re-provision when the device cert expires within this window.
+var RefreshBefore = 7 * 24 * time.Hour
+
+const (
+	plainFile     = "enrollment.json"
+	encryptedFile = "enrollment.enc"
+)
+
+var ErrNotEnrolled = errors.New("device not enrolled. Run `west login` first.")
+
+type Device = gql.DeviceProvision
+
+// Everything a device needs to start without re-provisioning.
+type Enrollment struct {
+	// Provisioning token. Kept to re-provision when the cert nears expiry.
+	Token string `json:"token"`
+	// West port API endpoint
+	Endpoint string `json:"endpoint"`
+	// Overlay IP of west port
+	PortIP string `json:"port_ip"`
+	// Public nebula host:port of west port from the token. May be empty.
+	PortEndpoint string `json:"port_endpoint,omitempty"`
+	// Provisioning response with the cert, CA and network config.
+	// Key holds the private key generated on this device.
+	Device        Device    `json:"device"`
+	ProvisionedAt time.Time `json:"provisioned_at"`
+}
+
+// Parses the device cert.
+func (e *Enrollment) Cert() (*pki.NebulaCertificate, error) {
+	cert, _, err := pki.UnmarshalNebulaCertificateFromPEM([]byte(e.Device.Cert))
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error parsing device cert")
+	}
+	return cert, nil
+}
+
+// Expiry time of the device cert.
+func (e *Enrollment) CertExpiry() (time.Time, error) {
+	cert, err := e.Cert()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return cert.Details.NotAfter, nil
+}
+
+// Reports if the device should re-provision before starting.
+func (e *Enrollment) NeedsRefresh() bool {
+	expiry, err := e.CertExpiry()
+	if err != nil {
+		return true
+	}
+	return time.Until(expiry) < RefreshBefore
+}
+
+// Reports if an enrollment has been saved to the state dir.
+func Exists() bool {
+	for _, name := range []string{plainFile, encryptedFile} {
+		if _, err := os.Stat(filepath.Join(Dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Reports if the saved enrollment is encrypted and requires a password.
+func Encrypted() bool {
+	_, err := os.Stat(filepath.Join(Dir, encryptedFile))
+	return err == nil
+}
+
+// Saves the enrollment to the state dir. Encrypted when password is not empty.
+func Save(e *Enrollment, password []byte) error {
+	err := os.MkdirAll(Dir, 0700)
+	if err != nil {
+		return errutil.WrapErr(err, "error creating state dir `%s`", Dir)
+	}
+	// MkdirAll doesn't tighten permissions on an existing dir
+	err = os.Chmod(Dir, 0700)
+	if err != nil {
+		return errutil.WrapErr(err, "error restricting state dir `%s`", Dir)
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return errutil.WrapErr(err, "error serializing enrollment")
+	}
+
+	name, stale := plainFile, encryptedFile
+	if len(password) > 0 {
+		name, stale = encryptedFile, plainFile
+		data, err = encrypt(data, password)
+		if err != nil {
+			return errutil.WrapErr(err, "error encrypting enrollment")
+		}
+	}
+
+	err = writeFileAtomic(filepath.Join(Dir, name), data)
+	if err != nil {
+		return err
+	}
+	// Only one enrollment should exist at a time
+	err = os.Remove(filepath.Join(Dir, stale))
+	if err != nil && !os.IsNotExist(err) {
+		return errutil.WrapErr(err, "error removing old enrollment")
+	}
+	return nil
+}
+
+// Loads the enrollment from the state dir. The password is only used when
+// the enrollment is encrypted.
+func Load(password []byte) (*Enrollment, error) {
+	var data []byte
+	var err error
+	if Encrypted() {
+		if len(password) == 0 {
+			return nil, errors.New("enrollment is encrypted. Password required.")
+		}
+		data, err = os.ReadFile(filepath.Join(Dir, encryptedFile))
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error reading enrollment")
+		}
+		data, err = decrypt(data, password)
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error decrypting enrollment. Wrong password?")
+		}
+	} else {
+		data, err = os.ReadFile(filepath.Join(Dir, plainFile))
+		if os.IsNotExist(err) {
+			return nil, ErrNotEnrolled
+		}
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error reading enrollment")
+		}
+	}
+
+	e := &Enrollment{}
+	err = json.Unmarshal(data, e)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error parsing enrollment")
+	}
+	return e, nil
+}
+
+// Removes any saved enrollment.
+func Remove() error {
+	var errs []error
+	for _, name := range []string{plainFile, encryptedFile} {
+		err := os.Remove(filepath.Join(Dir, name))
+		if err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return errutil.WrapErr(err, "error creating temp file")
+	}
+	defer os.Remove(tmp.Name())
+
+	err = tmp.Chmod(0600)
+	if err == nil {
+		_, err = tmp.Write(data)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	closeErr := tmp.Close()
+	if err != nil || closeErr != nil {
+		return errutil.WrapErr(errors.Join(err, closeErr), "error writing `%s`", path)
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+const saltSize = 16
+
+// Layout: salt | nonce | ciphertext
+func encrypt(plaintext []byte, password []byte) ([]byte, error) {
+	salt := make([]byte, saltSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	aead, err := chacha20poly1305.NewX(deriveKey(password, salt))
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	out := append(salt, nonce...)
+	return aead.Seal(out, nonce, plaintext, nil), nil
+}
+
+func decrypt(data []byte, password []byte) ([]byte, error) {
+	if len(data) < saltSize+chacha20poly1305.NonceSizeX {
+		return nil, fmt.Errorf("ciphertext too short")
+	}
+	salt, data := data[:saltSize], data[saltSize:]
+	aead, err := chacha20poly1305.NewX(deriveKey(password, salt))
+	if err != nil {
+		return nil, err
+	}
+	nonce, ciphertext := data[:aead.NonceSize()], data[aead.NonceSize():]
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
+
+func deriveKey(password []byte, salt []byte) []byte {
+	return argon2.IDKey(password, salt, 1, 64*1024, 4, chacha20poly1305.KeySize)
+}
+
+func defaultDir() string {
+	if dir := os.Getenv("WEST_STATE_DIR"); dir != "" {
+		return dir
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "/var/lib/west"
+	}
+	return filepath.Join(dir, "west")
+}
@@ -0,0 +1,183 @@
+package west
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sprisa/west/west/control"
+	"github.com/urfave/cli/v3"
+)
+
+var controlSocketFlag = &cli.StringFlag{
+	Name:  "control-socket",
+	Usage: "Path of the west start control socket. Defaults to west.sock in the state dir or WEST_CONTROL_SOCKET.",
+}
+
+var jsonFlag = &cli.BoolFlag{
+	Name:  "json",
+	Usage: "Print as json",
+}
+
+func controlSocketPath(c *cli.Command) string {
+	if path := c.String("control-socket"); path != "" {
+		return path
+	}
+	return control.SocketPath()
+}
+
+// Socket of the running device for status, peers and ping. Falls back to
+// the socket of `west service install` when no socket or state dir was given
+// and the user's state dir has none.
+func runningSocketPath(c *cli.Command) string {
+	path := controlSocketPath(c)
+	if c.IsSet("control-socket") || c.IsSet("state-dir") ||
+		os.Getenv("WEST_CONTROL_SOCKET") != "" || os.Getenv("WEST_STATE_DIR") != "" {
+		return path
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		return path
+	}
+	// Only root may be able to stat it, that still beats "not running"
+	servicePath := filepath.Join(serviceStateDir, "west.sock")
+	if _, err := os.Stat(servicePath); !os.IsNotExist(err) {
+		return servicePath
+	}
+	return path
+}
+
+var StatusCommand = &cli.Command{
+	Name:      "status",
+	Usage:     "Show the status of the running west device",
+	UsageText: "west status",
+	Flags:     []cli.Flag{controlSocketFlag, stateDirFlag, jsonFlag},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		status, err := control.NewClient(runningSocketPath(c)).Status(ctx)
+		if err != nil {
+			return err
+		}
+		if c.Bool("json") {
+			return printJSON(status)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintf(w, "name:\t%s\n", status.Name)
+		fmt.Fprintf(w, "ip:\t%s\n", status.IP)
+		fmt.Fprintf(w, "interface:\t%s\n", status.Interface)
+		fmt.Fprintf(w, "version:\t%s\n", status.Version)
+		fmt.Fprintf(w, "uptime:\t%s\n", time.Since(status.StartedAt).Round(time.Second))
+		fmt.Fprintf(w, "cert expiry:\t%s (%s)\n", status.CertExpiry.Format(time.RFC3339), untilString(status.CertExpiry))
+		fmt.Fprintf(w, "lighthouses:\t%s\n", listString(status.Lighthouses))
+		fmt.Fprintf(w, "relays:\t%s\n", listString(status.Relays))
+		fmt.Fprintf(w, "tunnels:\t%d (%d pending)\n", status.Tunnels, status.Pending)
+		fmt.Fprintf(w, "handshakes:\t%d initiated, %d completed, %d timed out\n",
+			status.Handshakes.Initiated, status.Handshakes.Completed, status.Handshakes.TimedOut)
+		return w.Flush()
+	},
+}
+
+var PeersCommand = &cli.Command{
+	Name:      "peers",
+	Usage:     "List tunnels to other devices",
+	UsageText: "west peers",
+	Flags:     []cli.Flag{controlSocketFlag, stateDirFlag, jsonFlag},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		peers, err := control.NewClient(runningSocketPath(c)).Peers(ctx)
+		if err != nil {
+			return err
+		}
+		if c.Bool("json") {
+			return printJSON(peers)
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tIP\tREMOTE\tRELAY\tCERT EXPIRY")
+		for _, p := range peers {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", p.Name, p.IP, valueOr(p.Remote, "-"), listString(p.Relays), untilString(p.CertExpiry))
+		}
+		return w.Flush()
+	},
+}
+
+var PingCommand = &cli.Command{
+	Name:      "ping",
+	Usage:     "Establish a tunnel to a device and report how it connected",
+	UsageText: "west ping <name or ip>",
+	Flags: []cli.Flag{
+		controlSocketFlag,
+		stateDirFlag,
+		jsonFlag,
+		&cli.DurationFlag{
+			Name:  "timeout",
+			Value: time.Second * 5,
+			Usage: "Time to wait for the handshake",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		target := c.Args().First()
+		if target == "" {
+			return errors.New("device name or ip required")
+		}
+		res, err := control.NewClient(runningSocketPath(c)).Ping(ctx, control.PingRequest{
+			Target:  target,
+			Timeout: c.Duration("timeout"),
+		})
+		if err != nil {
+			return err
+		}
+		if c.Bool("json") {
+			return printJSON(res)
+		}
+
+		via := "direct " + valueOr(res.Peer.Remote, "-")
+		if len(res.Peer.Relays) > 0 {
+			via = "relay " + listString(res.Peer.Relays)
+		}
+		handshake := "existing tunnel"
+		if res.Handshake > 0 {
+			handshake = "handshake " + res.Handshake.Round(time.Millisecond).String()
+		}
+		fmt.Printf("%s (%s) via %s, %s\n", valueOr(res.Peer.Name, target), res.Peer.IP, via, handshake)
+		return nil
+	},
+}
+
+func printJSON(v any) error {
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetIndent("", "  ")
+	return enc.Encode(v)
+}
+
+func listString(l []string) string {
+	if len(l) == 0 {
+		return "-"
+	}
+	return strings.Join(l, ", ")
+}
+
+func valueOr(s string, fallback string) string {
+	if s == "" {
+		return fallback
+	}
+	return s
+}
+
+func untilString(t time.Time) string {
+	if t.IsZero() {
+		return "-"
+	}
+	d := time.Until(t)
+	if d < 0 {
+		return "expired"
+	}
+	if d > 48*time.Hour {
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
+	}
+	return d.Round(time.Minute).String()
+}
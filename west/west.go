@@ -25,6 +25,12 @@ var WestCommand = &cli.Command{
 	UsageText: "west start [jwt_token]",
 	Commands: []*cli.Command{
 		StartCommand,
+		LoginCommand,
+		LogoutCommand,
+		StatusCommand,
+		PeersCommand,
+		PingCommand,
+		ServiceCommand,
 		westport.WestPortCommand,
 	},
 	Action: func(ctx context.Context, cmd *cli.Command) error {
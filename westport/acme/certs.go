@@ -9,6 +9,7 @@ import (
 	"github.com/go-acme/lego/v4/certificate"
 	"github.com/go-acme/lego/v4/challenge/dns01"
 	"github.com/go-acme/lego/v4/lego"
+	"github.com/sprisa/west/util/metrics"
 	"github.com/sprisa/west/westport/db/ent"
 	"github.com/sprisa/x/errutil"
 	l "github.com/sprisa/x/log"
@@ -41,6 +42,7 @@ func GetCertificate(
 			l.Log.Warn().Msg("Certificate expiring in < 30 days. Renewing now.")
 		} else {
 			l.Log.Info().Msg("Using cached TLS certificate")
+			metrics.SetExpiry(metrics.ACMECertExpiry, x509Cert.NotAfter)
 			return &cert, nil
 		}
 	}
@@ -85,13 +87,19 @@ func GetCertificate(
 
 	certs, err := client.Certificate.Obtain(request)
 	if err != nil {
+		metrics.ACMERenewals.WithLabelValues("failure").Inc()
 		return nil, errutil.WrapErr(err, "failed to obtain certificate")
 	}
 
 	cert, err := tls.X509KeyPair(certs.Certificate, certs.PrivateKey)
 	if err != nil {
+		metrics.ACMERenewals.WithLabelValues("failure").Inc()
 		return nil, errutil.WrapErr(err, "error parsing cert")
 	}
+	metrics.ACMERenewals.WithLabelValues("success").Inc()
+	if cert.Leaf != nil {
+		metrics.SetExpiry(metrics.ACMECertExpiry, cert.Leaf.NotAfter)
+	}
 
 	err = settings.Update().
 		SetTLSCert(certs.Certificate).
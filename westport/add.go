@@ -2,10 +2,13 @@ package westport
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
 	"fmt"
+	"net"
 	"net/netip"
 	"net/url"
+	"strconv"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
@@ -16,6 +19,7 @@ import (
 	"github.com/sprisa/west/westport/db/ent"
 	"github.com/sprisa/west/westport/db/helpers"
 	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/west/westport/gql"
 	"github.com/sprisa/x/errutil"
 	"github.com/urfave/cli/v3"
 )
@@ -39,6 +43,53 @@ var AddCommand = &cli.Command{
 				return err
 			},
 		},
+		&cli.StringSliceFlag{
+			Name:  "advertise-routes",
+			Usage: "LAN cidrs this device routes for, e.g. 192.168.1.0/24. Makes the device a subnet router for all other devices.",
+			Validator: func(routes []string) error {
+				for _, route := range routes {
+					_, err := parseRoute(route)
+					if err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		},
+		&cli.BoolFlag{
+			Name:  "exit-node",
+			Usage: "Allow other devices to route internet traffic through this device with `west start --exit-node`",
+		},
+		&cli.BoolFlag{
+			Name:  "relay",
+			Usage: "Relay traffic for devices that cannot connect directly. Should have a public IP.",
+		},
+		&cli.BoolFlag{
+			Name:  "lighthouse",
+			Usage: "Run the device as an additional lighthouse. Requires --public-endpoint.",
+		},
+		&cli.StringFlag{
+			Name:  "public-endpoint",
+			Usage: "Public host:port other devices use to reach this device, e.g. lh1.mycompany.dev:4242",
+			Validator: func(s string) error {
+				_, err := parseEndpoint(s)
+				return err
+			},
+		},
+		&cli.StringSliceFlag{
+			Name:  "group",
+			Usage: "Nebula group signed into the device cert, for firewall rules. Repeatable.",
+		},
+		&cli.DurationFlag{
+			Name:      "expires",
+			Value:     auth.DeviceTokenExpiry,
+			Usage:     "Time until the token expires",
+			Validator: validateExpires,
+		},
+		&cli.BoolFlag{
+			Name:  "once",
+			Usage: "Token can only be used once. The device is given a new token on first provision.",
+		},
 	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		name := c.String("name")
@@ -47,6 +98,31 @@ var AddCommand = &cli.Command{
 		if err != nil {
 			return errutil.WrapErr(err, "error parsing ip `%s`", ipStr)
 		}
+		opts := &AddDeviceOpts{
+			Name:            name,
+			IP:              ip,
+			AdvertiseRoutes: c.StringSlice("advertise-routes"),
+			ExitNode:        c.Bool("exit-node"),
+			Relay:           c.Bool("relay"),
+			Lighthouse:      c.Bool("lighthouse"),
+			PublicEndpoint:  c.String("public-endpoint"),
+			Groups:          c.StringSlice("group"),
+			Expires:         c.Duration("expires"),
+			SingleUse:       c.Bool("once"),
+		}
+
+		remote, err := remoteFor(c)
+		if err != nil {
+			return err
+		}
+		if remote != nil {
+			token, err := remote.AddDevice(ctx, opts)
+			if err != nil {
+				return err
+			}
+			println(token)
+			return nil
+		}
 
 		client, err := db.OpenDB()
 		if err != nil {
@@ -71,65 +147,198 @@ var AddCommand = &cli.Command{
 			return errutil.WrapErr(err, "error initializing settings")
 		}
 
-		if settings.Cidr.Contains(ip) == false {
-			return fmt.Errorf("ip `%s` must be within network cidr `%s`", ip, settings.Cidr)
+		token, err := AddDevice(ctx, client, settings, opts)
+		if err != nil {
+			return err
 		}
 
-		nebulaIp := netip.PrefixFrom(ip, settings.Cidr.Bits())
+		println(token)
+		return nil
+	},
+}
 
-		var endpoint url.URL
-		if settings.DomainZone != "" {
-			endpoint = url.URL{
-				Scheme: "https",
-				Host:   settings.DomainZone,
-				Path:   "api",
-			}
-		} else {
-			publicIp, err := info.GetPublicIP()
-			if err != nil {
-				return errutil.WrapErr(err, "error getting public ip")
-			}
-			endpoint = url.URL{
-				Scheme: "http",
-				Host:   publicIp.String(),
-				Path:   "api",
-			}
-		}
+type AddDeviceOpts struct {
+	Name string
+	IP   netip.Addr
+	// LAN cidrs the device routes for
+	AdvertiseRoutes []string
+	ExitNode        bool
+	Relay           bool
+	Lighthouse      bool
+	// Public host:port of the device. Required for lighthouses.
+	PublicEndpoint string
+	// Nebula groups signed into the device cert
+	Groups []string
+	// Token lifetime. Defaults to auth.DeviceTokenExpiry.
+	Expires time.Duration
+	// Token is exchanged for a new one on first provision
+	SingleUse bool
+}
 
-		claims := &auth.TokenClaims{
-			Endpoint: endpoint.String(),
-			IP:       nebulaIp.String(),
-			Ca:       string(settings.CaCrt),
-			PortIP:   settings.PortOverlayIP.ToIPV4().String(),
-			RegisteredClaims: jwt.RegisteredClaims{
-				ExpiresAt: jwt.NewNumericDate(
-					// 1 year
-					time.Now().Add(time.Hour * 8760),
-				),
-			},
-		}
+// AddDeviceOpts that can't be saved, as opposed to failing to save them
+type invalidDeviceError struct{ error }
 
-		token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
-			SignedString(helpers.EncryptionKey[:])
-		if err != nil {
-			return errutil.WrapErr(err, "error creating token")
-		}
+func (e *invalidDeviceError) Unwrap() error { return e.error }
 
-		ipInt, err := ipconv.FromIPAddr(nebulaIp.Addr())
+// Registers a new device and returns its provisioning token.
+// Requires helpers.EncryptionKey to be set.
+func AddDevice(ctx context.Context, client *ent.Client, settings *ent.Settings, opts *AddDeviceOpts) (string, error) {
+	if settings.Cidr.Contains(opts.IP) == false {
+		return "", &invalidDeviceError{fmt.Errorf("ip `%s` must be within network cidr `%s`", opts.IP, settings.Cidr)}
+	}
+
+	nebulaIp := netip.PrefixFrom(opts.IP, settings.Cidr.Bits())
+
+	if opts.Lighthouse && opts.PublicEndpoint == "" {
+		return "", &invalidDeviceError{errors.New("lighthouse requires a public endpoint (--public-endpoint)")}
+	}
+
+	routes := []string{}
+	for _, r := range opts.AdvertiseRoutes {
+		route, err := parseRoute(r)
 		if err != nil {
-			return errutil.WrapErr(err, "error converting ip")
+			return "", &invalidDeviceError{err}
+		}
+		if route.Overlaps(settings.Cidr.Prefix) {
+			return "", &invalidDeviceError{fmt.Errorf("route `%s` must not overlap network cidr `%s`", route, settings.Cidr)}
 		}
+		routes = append(routes, route.String())
+	}
 
-		_, err = client.Device.Create().
-			SetName(name).
-			SetIP(ipInt).
-			SetToken(helpers.EncryptedBytes(token)).
-			Save(ctx)
-		if err != nil {
-			return errutil.WrapErr(err, "error saving device")
+	token, _, err := signDeviceToken(settings, nebulaIp, opts.Expires)
+	if err != nil {
+		return "", err
+	}
+
+	ipInt, err := ipconv.FromIPAddr(nebulaIp.Addr())
+	if err != nil {
+		return "", errutil.WrapErr(err, "error converting ip")
+	}
+
+	_, err = client.Device.Create().
+		SetName(opts.Name).
+		SetIP(ipInt).
+		SetToken(helpers.EncryptedBytes(token)).
+		SetAdvertiseRoutes(routes).
+		SetExitNode(opts.ExitNode).
+		SetRelay(opts.Relay).
+		SetLighthouse(opts.Lighthouse).
+		SetPublicEndpoint(opts.PublicEndpoint).
+		SetGroups(opts.Groups).
+		SetSingleUseToken(opts.SingleUse).
+		Save(ctx)
+	if err != nil {
+		return "", errutil.WrapErr(err, "error saving device")
+	}
+
+	return token, nil
+}
+
+// Validates --expires of device tokens. A token that is already expired
+// could never provision.
+func validateExpires(d time.Duration) error {
+	if d <= 0 {
+		return fmt.Errorf("expires `%s` must be positive", d)
+	}
+	return nil
+}
+
+// Signs a new device token for ip with a fresh JWT ID. expires defaults to
+// auth.DeviceTokenExpiry.
+func signDeviceToken(settings *ent.Settings, ip netip.Prefix, expires time.Duration) (string, *auth.TokenClaims, error) {
+	claims, err := tokenClaims(settings)
+	if err != nil {
+		return "", nil, err
+	}
+	claims.IP = ip.String()
+	claims.ID = rand.Text()
+	if expires == 0 {
+		expires = auth.DeviceTokenExpiry
+	}
+	if expires < 0 {
+		return "", nil, fmt.Errorf("token expiry `%s` must be positive", expires)
+	}
+	claims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(expires))
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+		SignedString(helpers.EncryptionKey[:])
+	if err != nil {
+		return "", nil, errutil.WrapErr(err, "error creating token")
+	}
+	return token, claims, nil
+}
+
+// Claims shared by device and enrollment key tokens, pointing devices at
+// this port. Discovers the public IP when no domain or public host is set.
+func tokenClaims(settings *ent.Settings) (*auth.TokenClaims, error) {
+	var endpoint url.URL
+	publicHost := settings.PublicHost
+	if settings.DomainZone != "" {
+		endpoint = url.URL{
+			Scheme: "https",
+			Host:   apiHost(settings.DomainZone, settings.PublicAPIPort, settings.HTTPSPort, 443),
+			Path:   "api",
+		}
+	} else {
+		if publicHost == "" {
+			publicIp, err := info.GetPublicIP()
+			if err != nil {
+				return nil, errutil.WrapErr(err, "error getting public ip. Set one with `west port settings --public-host`")
+			}
+			publicHost = publicIp.String()
+		}
+		endpoint = url.URL{
+			Scheme: "http",
+			Host:   apiHost(publicHost, settings.PublicAPIPort, settings.HTTPPort, 80),
+			Path:   "api",
 		}
+	}
+	return &auth.TokenClaims{
+		Endpoint:     endpoint.String(),
+		Ca:           string(settings.CaCrt),
+		PortIP:       settings.PortOverlayIP.ToIPV4().String(),
+		PortEndpoint: gql.PortEndpoint(settings, publicHost),
+	}, nil
+}
 
-		println(token)
-		return nil
-	},
+// Parses an advertised LAN route. Must be a canonical IPv4 cidr.
+func parseRoute(route string) (netip.Prefix, error) {
+	prefix, err := netip.ParsePrefix(route)
+	if err != nil {
+		return prefix, errutil.WrapErr(err, "error parsing route `%s`", route)
+	}
+	if prefix.Addr().Is4() == false {
+		return prefix, fmt.Errorf("route `%s` must be ipv4", route)
+	}
+	if prefix.Masked() != prefix {
+		return prefix, fmt.Errorf("route `%s` has host bits set. Did you mean `%s`?", route, prefix.Masked())
+	}
+	return prefix, nil
+}
+
+// Parses a public underlay host:port. The port is required since lighthouses
+// must listen on a fixed port.
+func parseEndpoint(endpoint string) (port int, err error) {
+	_, portStr, err := net.SplitHostPort(endpoint)
+	if err != nil {
+		return 0, errutil.WrapErr(err, "error parsing endpoint `%s`", endpoint)
+	}
+	port, err = strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return 0, fmt.Errorf("invalid port in endpoint `%s`", endpoint)
+	}
+	return port, nil
+}
+
+// Host for the API endpoint url. The port is left off when it's the
+// scheme default.
+func apiHost(host string, publicPort int, listenPort int, defaultPort int) string {
+	port := publicPort
+	if port == 0 {
+		port = listenPort
+	}
+	if port == defaultPort {
+		return host
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
 }
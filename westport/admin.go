@@ -0,0 +1,217 @@
+package westport
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sprisa/west/util/auth"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/auditevent"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+var AdminTokenCommand = &cli.Command{
+	Name:      "admin-token",
+	Usage:     "Issue a token for admin API calls, e.g. reading the audit log",
+	UsageText: "west port admin-token --name alice [--expires 720h]",
+	Before:    localOnly,
+	Commands: []*cli.Command{
+		AdminTokenRevokeCommand,
+	},
+	Flags: []cli.Flag{
+		// Checked in Action, required flags would also be required by revoke
+		&cli.StringFlag{
+			Name:  "name",
+			Usage: "Admin the token is for. Recorded as the actor of their API calls.",
+		},
+		&cli.DurationFlag{
+			Name:  "expires",
+			Value: 30 * 24 * time.Hour,
+			Usage: "Time until the token expires",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		name := c.String("name")
+		if name == "" {
+			return errors.New("--name required")
+		}
+		err := readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		// Tokens signed with the wrong password would never verify
+		_, err = checkSettings(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		token, claims, err := IssueAdminToken(name, c.Duration("expires"))
+		if err != nil {
+			return err
+		}
+		err = audit.Record(ctx, client, &audit.Event{
+			Action: audit.AdminTokenIssue,
+			Details: map[string]string{
+				"name":    name,
+				"jti":     claims.ID,
+				"expires": claims.ExpiresAt.UTC().Format(time.RFC3339),
+			},
+		})
+		if err != nil {
+			return err
+		}
+
+		println(token)
+		return nil
+	},
+}
+
+var AdminTokenRevokeCommand = &cli.Command{
+	Name:      "revoke",
+	Usage:     "Stop an admin token from calling the API",
+	UsageText: "west port admin-token revoke <token or jti>",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		tokenOrID := c.Args().First()
+		if tokenOrID == "" {
+			return errors.New("admin token or its jti required. Find jtis with `west port audit --action admin_token`")
+		}
+		err := readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		return RevokeAdminToken(ctx, client, tokenOrID)
+	},
+}
+
+// Signs an admin token for name. Requires helpers.EncryptionKey to be set.
+func IssueAdminToken(name string, expires time.Duration) (string, *auth.AdminClaims, error) {
+	now := time.Now()
+	claims := &auth.AdminClaims{
+		Scope: auth.AdminScope,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ID:        rand.Text(),
+			Subject:   name,
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(expires)),
+		},
+	}
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+		SignedString(helpers.EncryptionKey[:])
+	if err != nil {
+		return "", nil, errutil.WrapErr(err, "error creating token")
+	}
+	return token, claims, nil
+}
+
+// Revokes an admin token, given the token or its JWT ID. Requires
+// helpers.EncryptionKey to be set.
+func RevokeAdminToken(ctx context.Context, client *ent.Client, tokenOrID string) error {
+	jti := tokenOrID
+	var expires time.Time
+	if claims, err := auth.ParseAdminToken(tokenOrID, helpers.EncryptionKey[:]); err == nil {
+		jti = claims.ID
+		expires = claims.ExpiresAt.Time
+	}
+	// The issue event has the name and expiry
+	issued, err := client.AuditEvent.Query().
+		Where(
+			auditevent.Action(audit.AdminTokenIssue),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueEQ(auditevent.FieldDetails, jti, sqljson.Path("jti")))
+			},
+		).
+		First(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return errutil.WrapErr(err, "error finding admin token")
+	}
+	name := ""
+	if issued != nil {
+		name = issued.Details["name"]
+		if expires.IsZero() {
+			expires, err = time.Parse(time.RFC3339, issued.Details["expires"])
+			if err != nil {
+				return errutil.WrapErr(err, "error parsing expiry of admin token `%s`", jti)
+			}
+		}
+	}
+	if expires.IsZero() {
+		return fmt.Errorf("admin token `%s` not found. Pass the token itself if its audit events were pruned.", jti)
+	}
+
+	err = saveRevocation(ctx, client, client.RevokedToken.Create().
+		SetJti(jti).
+		SetExpiresTime(expires))
+	if err != nil {
+		if errors.Is(err, errAlreadyRevoked) {
+			return fmt.Errorf("admin token `%s` is already revoked", jti)
+		}
+		return err
+	}
+	return audit.Record(ctx, client, &audit.Event{
+		Action:  audit.AdminTokenRevoke,
+		Details: map[string]string{"name": name, "jti": jti},
+	})
+}
+
+// Sets the audit actor of API requests. Requests with a bearer admin token
+// act as that admin. Revoked admin tokens are refused.
+func withAPIActor(client *ent.Client, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		actor := &audit.Actor{Name: "api", RemoteAddr: r.RemoteAddr, API: true}
+		if token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer "); ok {
+			claims, err := auth.ParseAdminToken(token, helpers.EncryptionKey[:])
+			if err != nil {
+				http.Error(w, "invalid admin token", http.StatusUnauthorized)
+				return
+			}
+			revoked, err := client.RevokedToken.Query().
+				Where(revokedtoken.Jti(claims.ID)).
+				Exist(r.Context())
+			if err != nil {
+				l.Log.Err(err).Msg("error checking revoked admin tokens")
+				http.Error(w, "error checking admin token", http.StatusInternalServerError)
+				return
+			}
+			if revoked {
+				http.Error(w, "admin token revoked", http.StatusUnauthorized)
+				return
+			}
+			actor.Name = "admin:" + claims.Subject
+			actor.Admin = true
+		}
+		next.ServeHTTP(w, r.WithContext(audit.WithActor(r.Context(), actor)))
+	})
+}
@@ -0,0 +1,163 @@
+package westport
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+var specFlag = &cli.StringFlag{
+	Name:     "file",
+	Aliases:  []string{"f"},
+	Required: true,
+	Usage:    "Network spec YAML file, or - for stdin",
+}
+
+var ApplyCommand = &cli.Command{
+	Name:      "apply",
+	Usage:     "Change the network to match a spec file. Prints the plan, then applies it in one transaction.",
+	UsageText: "west port apply -f network.yaml",
+	Before:    localOnly,
+	Flags: []cli.Flag{
+		specFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		spec, err := LoadSpec(c.String("file"))
+		if err != nil {
+			return err
+		}
+		err = readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		// New device tokens signed with the wrong password would never verify
+		_, err = checkSettings(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		plan, err := PlanSpec(ctx, client, spec)
+		if err != nil {
+			return err
+		}
+		fmt.Println(plan)
+		if len(plan.Changes) == 0 {
+			return nil
+		}
+		res, err := ApplyPlan(ctx, client, plan)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println()
+		for _, t := range res.Tokens {
+			fmt.Printf("%s: %s\n", t.Name, t.Token)
+		}
+		if res.RestartPort {
+			l.Log.Info().Msg("Settings updated. Restart west port to apply.")
+		}
+		if res.ReprovisionDevices {
+			l.Log.Info().Msg("Device changes apply when devices next run `west start`.")
+		}
+		return nil
+	},
+}
+
+var DiffCommand = &cli.Command{
+	Name:      "diff",
+	Usage:     "Show the changes `west port apply` would make for a spec file",
+	UsageText: "west port diff -f network.yaml",
+	Before:    localOnly,
+	Flags: []cli.Flag{
+		specFlag,
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		spec, err := LoadSpec(c.String("file"))
+		if err != nil {
+			return err
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		plan, err := PlanSpec(ctx, client, spec)
+		if err != nil {
+			return err
+		}
+		fmt.Println(plan)
+		return nil
+	},
+}
+
+type DeviceToken struct {
+	Name  string
+	Token string
+}
+
+type ApplyResult struct {
+	// Tokens of created devices
+	Tokens []DeviceToken
+	// Settings changed that west port reads on start
+	RestartPort bool
+	// Device or firewall changes that devices pick up when provisioning
+	ReprovisionDevices bool
+}
+
+// Applies every change in plan in one transaction. Nothing is changed when
+// any change fails. Requires helpers.EncryptionKey to be set when devices are
+// created.
+func ApplyPlan(ctx context.Context, client *ent.Client, plan *Plan) (*ApplyResult, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer tx.Rollback()
+
+	res := &ApplyResult{}
+	counts := map[string]int{}
+	for _, change := range plan.Changes {
+		err = change.apply(ctx, tx.Client(), res)
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error applying %s %s", change.Kind, change.Name)
+		}
+		counts[change.Op]++
+	}
+	err = audit.Record(ctx, tx.Client(), &audit.Event{
+		Action: audit.NetworkApply,
+		Details: map[string]string{
+			"added":   strconv.Itoa(counts["+"]),
+			"changed": strconv.Itoa(counts["~"]),
+			"deleted": strconv.Itoa(counts["-"]),
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error applying plan")
+	}
+	return res, nil
+}
@@ -0,0 +1,149 @@
+package westport
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+var AuditCommand = &cli.Command{
+	Name:      "audit",
+	Usage:     "Show the audit log of administrative and provisioning events",
+	UsageText: "west port audit [--since 24h] [--device laptop] [--action device.provision]",
+	Before:    localOnly,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "since",
+			Usage: "Events at or after a duration ago, date or RFC3339 time, e.g. 24h or 2026-01-31",
+		},
+		&cli.StringFlag{
+			Name:  "until",
+			Usage: "Events before a duration ago, date or RFC3339 time",
+		},
+		&cli.StringFlag{
+			Name:  "device",
+			Usage: "Only events for this device name",
+		},
+		&cli.StringFlag{
+			Name:  "action",
+			Usage: "Only this action or action prefix, e.g. device.provision or device",
+		},
+		&cli.IntFlag{
+			Name:  "limit",
+			Value: 100,
+			Usage: "Maximum number of events, newest first",
+		},
+		&cli.BoolFlag{
+			Name:  "json",
+			Usage: "Print one JSON object per event",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		since, err := audit.ParseTime(c.String("since"))
+		if err != nil {
+			return err
+		}
+		until, err := audit.ParseTime(c.String("until"))
+		if err != nil {
+			return err
+		}
+
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+
+		events, err := audit.Events(ctx, client, &audit.Filter{
+			Since:  since,
+			Until:  until,
+			Device: c.String("device"),
+			Action: c.String("action"),
+			Limit:  c.Int("limit"),
+		})
+		if err != nil {
+			return errutil.WrapErr(err, "error reading audit events")
+		}
+
+		if c.Bool("json") {
+			enc := json.NewEncoder(os.Stdout)
+			for _, e := range events {
+				err = enc.Encode(e)
+				if err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "TIME\tACTION\tACTOR\tREMOTE\tDEVICE\tRESULT\tDETAILS")
+		for _, e := range events {
+			result := "ok"
+			if !e.Success {
+				result = "failed: " + e.Error
+			}
+			details := []string{}
+			for _, k := range slices.Sorted(maps.Keys(e.Details)) {
+				details = append(details, k+"="+e.Details[k])
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				e.CreatedTime.Format(time.RFC3339),
+				e.Action,
+				e.Actor,
+				dash(e.RemoteAddr),
+				dash(e.DeviceName),
+				result,
+				strings.Join(details, " "),
+			)
+		}
+		return w.Flush()
+	},
+}
+
+func dash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+// How often audit events past their retention are deleted
+const auditPruneInterval = 24 * time.Hour
+
+// Deletes audit events older than retention now and daily until ctx is done.
+func pruneAuditLog(ctx context.Context, client *ent.Client, retention time.Duration) {
+	ticker := time.NewTicker(auditPruneInterval)
+	defer ticker.Stop()
+	for {
+		n, err := audit.Prune(ctx, client, time.Now().Add(-retention))
+		if err != nil && ctx.Err() == nil {
+			l.Log.Err(err).Msg("error pruning audit log")
+		} else if n > 0 {
+			l.Log.Info().Int("events", n).Msg("Pruned audit log")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,183 @@
+// Audit log of administrative and provisioning events.
+package audit
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"strings"
+	"time"
+
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/auditevent"
+	"github.com/sprisa/west/westport/db/ent/privacy"
+	"github.com/sprisa/x/errutil"
+)
+
+const (
+	DeviceCreate    = "device.create"
+	DeviceUpdate    = "device.update"
+	DeviceDelete    = "device.delete"
+	DeviceProvision = "device.provision"
+	CertIssue       = "cert.issue"
+	SettingsCreate  = "settings.create"
+	SettingsUpdate  = "settings.update"
+	AdminTokenIssue = "admin_token.issue"
+
+	AdminTokenRevoke    = "admin_token.revoke"
+	EnrollmentKeyCreate = "enrollment_key.create"
+	EnrollmentKeyRevoke = "enrollment_key.revoke"
+	TokenIssue          = "token.issue"
+	TokenRevoke         = "token.revoke"
+	NetworkApply        = "network.apply"
+)
+
+// Who is acting. Stored in the context by the CLI and API.
+type Actor struct {
+	// e.g. cli:alice@port-host, admin:alice or api
+	Name       string
+	RemoteAddr string
+	// Request came through the API
+	API bool
+	// API request holding a valid admin token
+	Admin bool
+}
+
+// Actor of work west port does on its own, e.g. renewing TLS certs.
+var System = &Actor{Name: "system"}
+
+// Actor of CLI commands, named after the local user and host.
+func CLI() *Actor {
+	name := "unknown"
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	if host, err := os.Hostname(); err == nil {
+		name += "@" + host
+	}
+	return &Actor{Name: "cli:" + name}
+}
+
+type actorKey struct{}
+
+func WithActor(ctx context.Context, a *Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, a)
+}
+
+// Actor in ctx. Defaults to System.
+func ActorFrom(ctx context.Context) *Actor {
+	if a, ok := ctx.Value(actorKey{}).(*Actor); ok && a != nil {
+		return a
+	}
+	return System
+}
+
+type Event struct {
+	Action string
+	// Overrides the context actor, e.g. device:laptop when provisioning
+	Actor      string
+	DeviceID   int
+	DeviceName string
+	// Failed actions record their error
+	Err     error
+	Details map[string]string
+}
+
+// Writes e with the actor in ctx.
+func Record(ctx context.Context, client *ent.Client, e *Event) error {
+	actor := ActorFrom(ctx)
+	name := actor.Name
+	if e.Actor != "" {
+		name = e.Actor
+	}
+	create := client.AuditEvent.Create().
+		SetAction(e.Action).
+		SetActor(name).
+		SetRemoteAddr(actor.RemoteAddr).
+		SetDeviceName(e.DeviceName).
+		SetSuccess(e.Err == nil)
+	if e.DeviceID != 0 {
+		create.SetDeviceID(e.DeviceID)
+	}
+	if e.Err != nil {
+		create.SetError(e.Err.Error())
+	}
+	if len(e.Details) > 0 {
+		create.SetDetails(e.Details)
+	}
+	err := create.Exec(ctx)
+	if err != nil {
+		return errutil.WrapErr(err, "error recording audit event %s", e.Action)
+	}
+	return nil
+}
+
+// Deletes events recorded before before, returning how many. Only used for
+// retention, events are otherwise never deleted.
+func Prune(ctx context.Context, client *ent.Client, before time.Time) (int, error) {
+	// The policy only allows creating events
+	n, err := client.AuditEvent.Delete().
+		Where(auditevent.CreatedTimeLT(before)).
+		Exec(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil {
+		return 0, errutil.WrapErr(err, "error pruning audit events")
+	}
+	return n, nil
+}
+
+type Filter struct {
+	// Zero times are unbounded
+	Since time.Time
+	Until time.Time
+	// Device name
+	Device string
+	// Exact action or prefix, e.g. device matches device.create
+	Action string
+	// Defaults to 100
+	Limit int
+}
+
+// Events matching f, newest first.
+func Events(ctx context.Context, client *ent.Client, f *Filter) ([]*ent.AuditEvent, error) {
+	q := client.AuditEvent.Query()
+	if !f.Since.IsZero() {
+		q.Where(auditevent.CreatedTimeGTE(f.Since))
+	}
+	if !f.Until.IsZero() {
+		q.Where(auditevent.CreatedTimeLT(f.Until))
+	}
+	if f.Device != "" {
+		q.Where(auditevent.DeviceName(f.Device))
+	}
+	if f.Action != "" {
+		q.Where(auditevent.Or(
+			auditevent.Action(f.Action),
+			auditevent.ActionHasPrefix(strings.TrimSuffix(f.Action, ".")+"."),
+		))
+	}
+	limit := f.Limit
+	if limit <= 0 {
+		limit = 100
+	}
+	return q.
+		Order(ent.Desc(auditevent.FieldCreatedTime), ent.Desc(auditevent.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Parses a duration ago, e.g. 24h, or an RFC3339 time or date.
+func ParseTime(s string) (time.Time, error) {
+	if s == "" {
+		return time.Time{}, nil
+	}
+	if d, err := time.ParseDuration(s); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	for _, layout := range []string{time.RFC3339, time.DateOnly} {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid time `%s`. Use a duration like 24h, a date or an RFC3339 time", s)
+}
@@ -0,0 +1,125 @@
+package audit
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/hook"
+	"github.com/sprisa/west/westport/db/ent/privacy"
+)
+
+// Bookkeeping fields left out of changed field lists. Updates changing only
+// these, e.g. device check-ins, are not recorded.
+var ignoredFields = []string{
+	"updated_time",
+	"last_provision_time",
+	"last_seen_time",
+	"underlay_addr",
+	"client_version",
+	"os",
+	"arch",
+}
+
+// Records device creates, updates and deletes.
+func DeviceHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.DeviceFunc(func(ctx context.Context, m *ent.DeviceMutation) (ent.Value, error) {
+			fields := changedFields(m)
+			if m.Op().Is(ent.OpCreate) {
+				v, err := next.Mutate(ctx, m)
+				if err != nil {
+					return v, err
+				}
+				dvc := v.(*ent.Device)
+				return v, Record(ctx, m.Client(), &Event{
+					Action:     DeviceCreate,
+					DeviceID:   dvc.ID,
+					DeviceName: dvc.Name,
+					Details:    map[string]string{"ip": dvc.IP.ToIpAddr().String()},
+				})
+			}
+
+			action := DeviceUpdate
+			if m.Op().Is(ent.OpDelete | ent.OpDeleteOne) {
+				action = DeviceDelete
+			} else if len(fields) == 0 {
+				return next.Mutate(ctx, m)
+			}
+			// Affected devices are looked up first so deletes keep their names
+			ids, err := m.IDs(ctx)
+			if err != nil {
+				return nil, err
+			}
+			dvcs, err := m.Client().Device.Query().
+				Where(device.IDIn(ids...)).
+				Select(device.FieldName).
+				All(ctx)
+			if err != nil {
+				return nil, err
+			}
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			for _, dvc := range dvcs {
+				e := &Event{
+					Action:     action,
+					DeviceID:   dvc.ID,
+					DeviceName: dvc.Name,
+				}
+				if action == DeviceUpdate {
+					e.Details = map[string]string{"fields": strings.Join(fields, ",")}
+				}
+				err = Record(ctx, m.Client(), e)
+				if err != nil {
+					return v, err
+				}
+			}
+			return v, nil
+		})
+	}
+}
+
+// Records settings changes.
+func SettingsHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return hook.SettingsFunc(func(ctx context.Context, m *ent.SettingsMutation) (ent.Value, error) {
+			fields := changedFields(m)
+			v, err := next.Mutate(ctx, m)
+			if err != nil || len(fields) == 0 {
+				return v, err
+			}
+			action := SettingsUpdate
+			if m.Op().Is(ent.OpCreate) {
+				action = SettingsCreate
+			}
+			return v, Record(ctx, m.Client(), &Event{
+				Action:  action,
+				Details: map[string]string{"fields": strings.Join(fields, ",")},
+			})
+		})
+	}
+}
+
+// Names of set and cleared fields. Values are never recorded, many are secrets.
+func changedFields(m ent.Mutation) []string {
+	fields := append(m.Fields(), m.ClearedFields()...)
+	fields = slices.DeleteFunc(fields, func(f string) bool {
+		return slices.Contains(ignoredFields, f)
+	})
+	slices.Sort(fields)
+	return slices.Compact(fields)
+}
+
+// Denies API requests without an admin token.
+func DenyUnauthenticatedAPI() privacy.QueryMutationRule {
+	return privacy.ContextQueryMutationRule(func(ctx context.Context) error {
+		if a := ActorFrom(ctx); a.API && !a.Admin {
+			return privacy.Denyf("admin token required")
+		}
+		return privacy.Skip
+	})
+}
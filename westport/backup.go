@@ -0,0 +1,344 @@
+package westport
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"github.com/sprisa/west"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+// Backup file layout:
+//
+//	magic | uint32 manifest length | manifest json | nonce + ciphertext
+//
+// The manifest is readable without the password but authenticated as
+// additional data, so it can't be edited without failing decryption.
+var backupMagic = []byte("WESTBAK\n")
+
+const backupFormat = 1
+
+type BackupManifest struct {
+	Format        int       `json:"format"`
+	Version       string    `json:"version"`
+	SchemaVersion int64     `json:"schema_version"`
+	CreatedAt     time.Time `json:"created_at"`
+	Devices       int       `json:"devices"`
+	// Size and sha256 of the decrypted sqlite database
+	Size   int64  `json:"size"`
+	SHA256 string `json:"sha256"`
+}
+
+var BackupCommand = &cli.Command{
+	Name:      "backup",
+	Usage:     "Write an encrypted snapshot of the west port database",
+	UsageText: "west port backup [--output westdb.backup]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "output",
+			Aliases: []string{"o"},
+			Usage:   "Backup file path (default: westdb-<timestamp>.backup)",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		err := requireSQLite()
+		if err != nil {
+			return err
+		}
+		err = readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		// Backups are taken as is, migrating is left to west port start
+		status, err := migrate.GetStatus(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error reading db schema version")
+		}
+		if unknown := status.Unknown(); len(unknown) > 0 {
+			return fmt.Errorf(
+				"database schema version %d is newer than this binary (%d). Back up with the newer west.",
+				unknown[len(unknown)-1].Version, migrate.SchemaVersion,
+			)
+		}
+		devices, err := checkSettings(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		// Snapshot into a private temp dir. VACUUM INTO is consistent while
+		// west port is writing.
+		dir, err := os.MkdirTemp("", "westbackup")
+		if err != nil {
+			return errutil.WrapErr(err, "error creating temp dir")
+		}
+		defer os.RemoveAll(dir)
+		snapshot := filepath.Join(dir, "westdb")
+		err = db.VacuumInto(ctx, client, snapshot)
+		if err != nil {
+			return errutil.WrapErr(err, "error snapshotting db")
+		}
+		data, err := os.ReadFile(snapshot)
+		if err != nil {
+			return errutil.WrapErr(err, "error reading snapshot")
+		}
+
+		sum := sha256.Sum256(data)
+		manifest := &BackupManifest{
+			Format:        backupFormat,
+			Version:       west.Build,
+			SchemaVersion: status.Current(),
+			CreatedAt:     time.Now().UTC(),
+			Devices:       devices,
+			Size:          int64(len(data)),
+			SHA256:        hex.EncodeToString(sum[:]),
+		}
+
+		output := c.String("output")
+		if output == "" {
+			output = fmt.Sprintf("westdb-%s.backup", manifest.CreatedAt.Format("20060102-150405"))
+		}
+		f, err := os.OpenFile(output, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			return errutil.WrapErr(err, "error creating backup file")
+		}
+		err = writeBackup(f, manifest, data)
+		if err != nil {
+			f.Close()
+			os.Remove(output)
+			return errutil.WrapErr(err, "error writing backup")
+		}
+		err = f.Close()
+		if err != nil {
+			return errutil.WrapErr(err, "error writing backup")
+		}
+
+		l.Log.Info().
+			Str("file", output).
+			Int("devices", devices).
+			Int64("schema", manifest.SchemaVersion).
+			Msg("Backup written")
+		return nil
+	},
+}
+
+var RestoreCommand = &cli.Command{
+	Name:      "restore",
+	Usage:     "Replace the west port database with a backup",
+	UsageText: "west port restore [--force] <file>",
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "force",
+			Usage: "Replace an existing database. The old database is kept as <db>.<timestamp>.pre-restore",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		err := requireSQLite()
+		if err != nil {
+			return err
+		}
+		file := c.Args().First()
+		if file == "" {
+			return errors.New("backup file required")
+		}
+
+		f, err := os.Open(file)
+		if err != nil {
+			return errutil.WrapErr(err, "error opening backup")
+		}
+		defer f.Close()
+		manifest, manifestBytes, ciphertext, err := readBackup(f)
+		if err != nil {
+			return err
+		}
+		if manifest.SchemaVersion > migrate.SchemaVersion {
+			return fmt.Errorf(
+				"backup schema version %d is newer than this binary (%d). Restore with %s or newer.",
+				manifest.SchemaVersion, migrate.SchemaVersion, manifest.Version,
+			)
+		}
+
+		_, err = os.Stat(db.DBFilePath)
+		exists := err == nil
+		if exists && !c.Bool("force") {
+			return fmt.Errorf("database %s exists. Stop west port and rerun with --force to replace it.", db.DBFilePath)
+		}
+
+		err = readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+		data, err := openBackup(manifest, manifestBytes, ciphertext)
+		if err != nil {
+			return err
+		}
+
+		// Stage next to the database so the final rename is atomic
+		dir := filepath.Dir(db.DBFilePath)
+		tmp, err := os.CreateTemp(dir, filepath.Base(db.DBFilePath)+".restore-*")
+		if err != nil {
+			return errutil.WrapErr(err, "error creating restore file")
+		}
+		staged := tmp.Name()
+		defer os.Remove(staged)
+		_, err = tmp.Write(data)
+		if err == nil {
+			err = tmp.Sync()
+		}
+		if closeErr := tmp.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return errutil.WrapErr(err, "error writing restore file")
+		}
+
+		devices, err := checkBackup(ctx, staged)
+		if err != nil {
+			return err
+		}
+
+		if exists {
+			old := fmt.Sprintf("%s.%s.pre-restore", db.DBFilePath, time.Now().UTC().Format("20060102-150405"))
+			err = os.Rename(db.DBFilePath, old)
+			if err != nil {
+				return errutil.WrapErr(err, "error moving existing db")
+			}
+			l.Log.Info().Str("file", old).Msg("Existing database kept")
+		}
+		err = os.Rename(staged, db.DBFilePath)
+		if err != nil {
+			return errutil.WrapErr(err, "error replacing db")
+		}
+
+		l.Log.Info().
+			Str("db", db.DBFilePath).
+			Int("devices", devices).
+			Time("created_at", manifest.CreatedAt).
+			Msg("Backup restored. Restart west port to apply.")
+		return nil
+	},
+}
+
+// Backups copy the sqlite file. Postgres has its own tooling.
+func requireSQLite() error {
+	d, err := db.Dialect()
+	if err != nil {
+		return err
+	}
+	if d != dialect.SQLite {
+		return errors.New("backup and restore only support sqlite. Use pg_dump and pg_restore for postgres.")
+	}
+	return nil
+}
+
+// Opens and migrates the staged database, checking the password decrypts its
+// settings.
+func checkBackup(ctx context.Context, path string) (int, error) {
+	client, err := db.Open(fmt.Sprintf("file:%s?mode=rw&_fk=1", path))
+	if err != nil {
+		return 0, errutil.WrapErr(err, "error opening backup db")
+	}
+	defer client.Close()
+	// The backup file itself is the pre-migration copy
+	_, err = migrate.Up(ctx, client, &migrate.UpOpts{SkipBackup: true})
+	if err != nil {
+		return 0, errutil.WrapErr(err, "error migrating backup db")
+	}
+	return checkSettings(ctx, client)
+}
+
+// Reads the settings to check the password, returning the device count.
+func checkSettings(ctx context.Context, client *ent.Client) (int, error) {
+	_, err := client.Settings.Query().Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return 0, errors.New("error finding settings. Trying installing first.")
+		}
+		return 0, errutil.WrapErr(err, "error reading settings. Is the password correct?")
+	}
+	devices, err := client.Device.Query().Count(ctx)
+	if err != nil {
+		return 0, errutil.WrapErr(err, "error counting devices")
+	}
+	return devices, nil
+}
+
+func writeBackup(w io.Writer, manifest *BackupManifest, data []byte) error {
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		return err
+	}
+	ciphertext, err := helpers.Seal(data, manifestBytes)
+	if err != nil {
+		return err
+	}
+	var buf bytes.Buffer
+	buf.Write(backupMagic)
+	binary.Write(&buf, binary.BigEndian, uint32(len(manifestBytes)))
+	buf.Write(manifestBytes)
+	buf.Write(ciphertext)
+	_, err = w.Write(buf.Bytes())
+	return err
+}
+
+// Returns the manifest, its raw bytes for authentication and the ciphertext.
+func readBackup(r io.Reader) (*BackupManifest, []byte, []byte, error) {
+	b, err := io.ReadAll(r)
+	if err != nil {
+		return nil, nil, nil, errutil.WrapErr(err, "error reading backup")
+	}
+	if !bytes.HasPrefix(b, backupMagic) || len(b) < len(backupMagic)+4 {
+		return nil, nil, nil, errors.New("not a west port backup")
+	}
+	b = b[len(backupMagic):]
+	n := binary.BigEndian.Uint32(b)
+	b = b[4:]
+	if uint64(n) > uint64(len(b)) {
+		return nil, nil, nil, errors.New("backup truncated")
+	}
+	manifestBytes, ciphertext := b[:n], b[n:]
+	manifest := &BackupManifest{}
+	err = json.Unmarshal(manifestBytes, manifest)
+	if err != nil {
+		return nil, nil, nil, errutil.WrapErr(err, "error parsing backup manifest")
+	}
+	if manifest.Format != backupFormat {
+		return nil, nil, nil, fmt.Errorf("unsupported backup format %d", manifest.Format)
+	}
+	return manifest, manifestBytes, ciphertext, nil
+}
+
+// Decrypts the database in a backup and checks it against the manifest.
+func openBackup(manifest *BackupManifest, manifestBytes []byte, ciphertext []byte) ([]byte, error) {
+	data, err := helpers.Open(ciphertext, manifestBytes)
+	if err != nil {
+		return nil, errors.New("error decrypting backup. Is the password correct?")
+	}
+	sum := sha256.Sum256(data)
+	if int64(len(data)) != manifest.Size || hex.EncodeToString(sum[:]) != manifest.SHA256 {
+		return nil, errors.New("backup checksum mismatch")
+	}
+	return data, nil
+}
@@ -0,0 +1,106 @@
+package westport
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sprisa/west/westport/db/helpers"
+)
+
+func testBackup(t *testing.T, password string) ([]byte, []byte) {
+	t.Helper()
+	helpers.EncryptionKey = sha256.Sum256([]byte(password))
+	data := []byte("sqlite database")
+	sum := sha256.Sum256(data)
+	manifest := &BackupManifest{
+		Format:        backupFormat,
+		Version:       "west test",
+		SchemaVersion: 20261019024800,
+		CreatedAt:     time.Now().UTC(),
+		Devices:       2,
+		Size:          int64(len(data)),
+		SHA256:        hex.EncodeToString(sum[:]),
+	}
+	var buf bytes.Buffer
+	err := writeBackup(&buf, manifest, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes(), data
+}
+
+func TestBackupRoundTrip(t *testing.T) {
+	file, data := testBackup(t, "password")
+	manifest, manifestBytes, ciphertext, err := readBackup(bytes.NewReader(file))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if manifest.SchemaVersion != 20261019024800 || manifest.Devices != 2 {
+		t.Errorf("manifest = %+v", manifest)
+	}
+	got, err := openBackup(manifest, manifestBytes, ciphertext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Errorf("openBackup() = %q, want %q", got, data)
+	}
+}
+
+func TestBackupErrors(t *testing.T) {
+	for _, c := range []struct {
+		name string
+		// Changes a backup written with the password "password"
+		modify   func(file []byte) []byte
+		password string
+		want     string
+	}{
+		{
+			name: "tampered manifest",
+			modify: func(file []byte) []byte {
+				return bytes.Replace(file, []byte(`"devices":2`), []byte(`"devices":9`), 1)
+			},
+			want: "error decrypting backup",
+		},
+		{
+			name:     "wrong password",
+			modify:   func(file []byte) []byte { return file },
+			password: "wrong",
+			want:     "error decrypting backup",
+		},
+		{
+			name:   "truncated manifest",
+			modify: func(file []byte) []byte { return file[:len(backupMagic)+10] },
+			want:   "backup truncated",
+		},
+		{
+			name:   "truncated ciphertext",
+			modify: func(file []byte) []byte { return file[:len(file)-5] },
+			want:   "error decrypting backup",
+		},
+		{
+			name:   "not a backup",
+			modify: func(file []byte) []byte { return []byte("SQLite format 3") },
+			want:   "not a west port backup",
+		},
+	} {
+		t.Run(c.name, func(t *testing.T) {
+			file, _ := testBackup(t, "password")
+			file = c.modify(file)
+			if c.password != "" {
+				helpers.EncryptionKey = sha256.Sum256([]byte(c.password))
+			}
+			manifest, manifestBytes, ciphertext, err := readBackup(bytes.NewReader(file))
+			if err == nil {
+				_, err = openBackup(manifest, manifestBytes, ciphertext)
+			}
+			if err == nil || !strings.Contains(err.Error(), c.want) {
+				t.Errorf("expected error containing `%s`, got %v", c.want, err)
+			}
+		})
+	}
+}
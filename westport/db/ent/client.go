@@ -14,8 +14,15 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
+	"github.com/sprisa/west/westport/db/ent/auditevent"
 	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
 	"github.com/sprisa/west/westport/db/ent/settings"
+
+	stdsql "database/sql"
 )
 
 // Client is the client that holds all ent builders.
@@ -23,8 +30,18 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditEvent is the client for interacting with the AuditEvent builders.
+	AuditEvent *AuditEventClient
+	// DNSRecord is the client for interacting with the DNSRecord builders.
+	DNSRecord *DNSRecordClient
 	// Device is the client for interacting with the Device builders.
 	Device *DeviceClient
+	// DeviceProvision is the client for interacting with the DeviceProvision builders.
+	DeviceProvision *DeviceProvisionClient
+	// EnrollmentKey is the client for interacting with the EnrollmentKey builders.
+	EnrollmentKey *EnrollmentKeyClient
+	// RevokedToken is the client for interacting with the RevokedToken builders.
+	RevokedToken *RevokedTokenClient
 	// Settings is the client for interacting with the Settings builders.
 	Settings *SettingsClient
 	// additional fields for node api
@@ -40,7 +57,12 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditEvent = NewAuditEventClient(c.config)
+	c.DNSRecord = NewDNSRecordClient(c.config)
 	c.Device = NewDeviceClient(c.config)
+	c.DeviceProvision = NewDeviceProvisionClient(c.config)
+	c.EnrollmentKey = NewEnrollmentKeyClient(c.config)
+	c.RevokedToken = NewRevokedTokenClient(c.config)
 	c.Settings = NewSettingsClient(c.config)
 }
 
@@ -132,10 +154,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Device:   NewDeviceClient(cfg),
-		Settings: NewSettingsClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditEvent:      NewAuditEventClient(cfg),
+		DNSRecord:       NewDNSRecordClient(cfg),
+		Device:          NewDeviceClient(cfg),
+		DeviceProvision: NewDeviceProvisionClient(cfg),
+		EnrollmentKey:   NewEnrollmentKeyClient(cfg),
+		RevokedToken:    NewRevokedTokenClient(cfg),
+		Settings:        NewSettingsClient(cfg),
 	}, nil
 }
 
@@ -153,17 +180,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:      ctx,
-		config:   cfg,
-		Device:   NewDeviceClient(cfg),
-		Settings: NewSettingsClient(cfg),
+		ctx:             ctx,
+		config:          cfg,
+		AuditEvent:      NewAuditEventClient(cfg),
+		DNSRecord:       NewDNSRecordClient(cfg),
+		Device:          NewDeviceClient(cfg),
+		DeviceProvision: NewDeviceProvisionClient(cfg),
+		EnrollmentKey:   NewEnrollmentKeyClient(cfg),
+		RevokedToken:    NewRevokedTokenClient(cfg),
+		Settings:        NewSettingsClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Device.
+//		AuditEvent.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -185,22 +217,40 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Device.Use(hooks...)
-	c.Settings.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditEvent, c.DNSRecord, c.Device, c.DeviceProvision, c.EnrollmentKey,
+		c.RevokedToken, c.Settings,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Device.Intercept(interceptors...)
-	c.Settings.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditEvent, c.DNSRecord, c.Device, c.DeviceProvision, c.EnrollmentKey,
+		c.RevokedToken, c.Settings,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditEventMutation:
+		return c.AuditEvent.mutate(ctx, m)
+	case *DNSRecordMutation:
+		return c.DNSRecord.mutate(ctx, m)
 	case *DeviceMutation:
 		return c.Device.mutate(ctx, m)
+	case *DeviceProvisionMutation:
+		return c.DeviceProvision.mutate(ctx, m)
+	case *EnrollmentKeyMutation:
+		return c.EnrollmentKey.mutate(ctx, m)
+	case *RevokedTokenMutation:
+		return c.RevokedToken.mutate(ctx, m)
 	case *SettingsMutation:
 		return c.Settings.mutate(ctx, m)
 	default:
@@ -208,6 +258,273 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// AuditEventClient is a client for the AuditEvent schema.
+type AuditEventClient struct {
+	config
+}
+
+// NewAuditEventClient returns a client for the AuditEvent from the given config.
+func NewAuditEventClient(c config) *AuditEventClient {
+	return &AuditEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditevent.Hooks(f(g(h())))`.
+func (c *AuditEventClient) Use(hooks ...Hook) {
+	c.hooks.AuditEvent = append(c.hooks.AuditEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditevent.Intercept(f(g(h())))`.
+func (c *AuditEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditEvent = append(c.inters.AuditEvent, interceptors...)
+}
+
+// Create returns a builder for creating a AuditEvent entity.
+func (c *AuditEventClient) Create() *AuditEventCreate {
+	mutation := newAuditEventMutation(c.config, OpCreate)
+	return &AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditEvent entities.
+func (c *AuditEventClient) CreateBulk(builders ...*AuditEventCreate) *AuditEventCreateBulk {
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *AuditEventClient) MapCreateBulk(slice any, setFunc func(*AuditEventCreate, int)) *AuditEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &AuditEventCreateBulk{err: fmt.Errorf("calling to AuditEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*AuditEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &AuditEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditEvent.
+func (c *AuditEventClient) Update() *AuditEventUpdate {
+	mutation := newAuditEventMutation(c.config, OpUpdate)
+	return &AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditEventClient) UpdateOne(_m *AuditEvent) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEvent(_m))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditEventClient) UpdateOneID(id int) *AuditEventUpdateOne {
+	mutation := newAuditEventMutation(c.config, OpUpdateOne, withAuditEventID(id))
+	return &AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditEvent.
+func (c *AuditEventClient) Delete() *AuditEventDelete {
+	mutation := newAuditEventMutation(c.config, OpDelete)
+	return &AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditEventClient) DeleteOne(_m *AuditEvent) *AuditEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditEventClient) DeleteOneID(id int) *AuditEventDeleteOne {
+	builder := c.Delete().Where(auditevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditEventDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditEvent.
+func (c *AuditEventClient) Query() *AuditEventQuery {
+	return &AuditEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditEvent entity by its id.
+func (c *AuditEventClient) Get(ctx context.Context, id int) (*AuditEvent, error) {
+	return c.Query().Where(auditevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditEventClient) GetX(ctx context.Context, id int) *AuditEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditEventClient) Hooks() []Hook {
+	hooks := c.hooks.AuditEvent
+	return append(hooks[:len(hooks):len(hooks)], auditevent.Hooks[:]...)
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditEventClient) Interceptors() []Interceptor {
+	return c.inters.AuditEvent
+}
+
+func (c *AuditEventClient) mutate(ctx context.Context, m *AuditEventMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown AuditEvent mutation op: %q", m.Op())
+	}
+}
+
+// DNSRecordClient is a client for the DNSRecord schema.
+type DNSRecordClient struct {
+	config
+}
+
+// NewDNSRecordClient returns a client for the DNSRecord from the given config.
+func NewDNSRecordClient(c config) *DNSRecordClient {
+	return &DNSRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `dnsrecord.Hooks(f(g(h())))`.
+func (c *DNSRecordClient) Use(hooks ...Hook) {
+	c.hooks.DNSRecord = append(c.hooks.DNSRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `dnsrecord.Intercept(f(g(h())))`.
+func (c *DNSRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DNSRecord = append(c.inters.DNSRecord, interceptors...)
+}
+
+// Create returns a builder for creating a DNSRecord entity.
+func (c *DNSRecordClient) Create() *DNSRecordCreate {
+	mutation := newDNSRecordMutation(c.config, OpCreate)
+	return &DNSRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DNSRecord entities.
+func (c *DNSRecordClient) CreateBulk(builders ...*DNSRecordCreate) *DNSRecordCreateBulk {
+	return &DNSRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DNSRecordClient) MapCreateBulk(slice any, setFunc func(*DNSRecordCreate, int)) *DNSRecordCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DNSRecordCreateBulk{err: fmt.Errorf("calling to DNSRecordClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DNSRecordCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DNSRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DNSRecord.
+func (c *DNSRecordClient) Update() *DNSRecordUpdate {
+	mutation := newDNSRecordMutation(c.config, OpUpdate)
+	return &DNSRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DNSRecordClient) UpdateOne(_m *DNSRecord) *DNSRecordUpdateOne {
+	mutation := newDNSRecordMutation(c.config, OpUpdateOne, withDNSRecord(_m))
+	return &DNSRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DNSRecordClient) UpdateOneID(id int) *DNSRecordUpdateOne {
+	mutation := newDNSRecordMutation(c.config, OpUpdateOne, withDNSRecordID(id))
+	return &DNSRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DNSRecord.
+func (c *DNSRecordClient) Delete() *DNSRecordDelete {
+	mutation := newDNSRecordMutation(c.config, OpDelete)
+	return &DNSRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DNSRecordClient) DeleteOne(_m *DNSRecord) *DNSRecordDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DNSRecordClient) DeleteOneID(id int) *DNSRecordDeleteOne {
+	builder := c.Delete().Where(dnsrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DNSRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for DNSRecord.
+func (c *DNSRecordClient) Query() *DNSRecordQuery {
+	return &DNSRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDNSRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DNSRecord entity by its id.
+func (c *DNSRecordClient) Get(ctx context.Context, id int) (*DNSRecord, error) {
+	return c.Query().Where(dnsrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DNSRecordClient) GetX(ctx context.Context, id int) *DNSRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DNSRecordClient) Hooks() []Hook {
+	return c.hooks.DNSRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *DNSRecordClient) Interceptors() []Interceptor {
+	return c.inters.DNSRecord
+}
+
+func (c *DNSRecordClient) mutate(ctx context.Context, m *DNSRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DNSRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DNSRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DNSRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DNSRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DNSRecord mutation op: %q", m.Op())
+	}
+}
+
 // DeviceClient is a client for the Device schema.
 type DeviceClient struct {
 	config
@@ -318,7 +635,8 @@ func (c *DeviceClient) GetX(ctx context.Context, id int) *Device {
 
 // Hooks returns the client hooks.
 func (c *DeviceClient) Hooks() []Hook {
-	return c.hooks.Device
+	hooks := c.hooks.Device
+	return append(hooks[:len(hooks):len(hooks)], device.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -341,6 +659,405 @@ func (c *DeviceClient) mutate(ctx context.Context, m *DeviceMutation) (Value, er
 	}
 }
 
+// DeviceProvisionClient is a client for the DeviceProvision schema.
+type DeviceProvisionClient struct {
+	config
+}
+
+// NewDeviceProvisionClient returns a client for the DeviceProvision from the given config.
+func NewDeviceProvisionClient(c config) *DeviceProvisionClient {
+	return &DeviceProvisionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `deviceprovision.Hooks(f(g(h())))`.
+func (c *DeviceProvisionClient) Use(hooks ...Hook) {
+	c.hooks.DeviceProvision = append(c.hooks.DeviceProvision, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `deviceprovision.Intercept(f(g(h())))`.
+func (c *DeviceProvisionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.DeviceProvision = append(c.inters.DeviceProvision, interceptors...)
+}
+
+// Create returns a builder for creating a DeviceProvision entity.
+func (c *DeviceProvisionClient) Create() *DeviceProvisionCreate {
+	mutation := newDeviceProvisionMutation(c.config, OpCreate)
+	return &DeviceProvisionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of DeviceProvision entities.
+func (c *DeviceProvisionClient) CreateBulk(builders ...*DeviceProvisionCreate) *DeviceProvisionCreateBulk {
+	return &DeviceProvisionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *DeviceProvisionClient) MapCreateBulk(slice any, setFunc func(*DeviceProvisionCreate, int)) *DeviceProvisionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &DeviceProvisionCreateBulk{err: fmt.Errorf("calling to DeviceProvisionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*DeviceProvisionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &DeviceProvisionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for DeviceProvision.
+func (c *DeviceProvisionClient) Update() *DeviceProvisionUpdate {
+	mutation := newDeviceProvisionMutation(c.config, OpUpdate)
+	return &DeviceProvisionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *DeviceProvisionClient) UpdateOne(_m *DeviceProvision) *DeviceProvisionUpdateOne {
+	mutation := newDeviceProvisionMutation(c.config, OpUpdateOne, withDeviceProvision(_m))
+	return &DeviceProvisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *DeviceProvisionClient) UpdateOneID(id int) *DeviceProvisionUpdateOne {
+	mutation := newDeviceProvisionMutation(c.config, OpUpdateOne, withDeviceProvisionID(id))
+	return &DeviceProvisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for DeviceProvision.
+func (c *DeviceProvisionClient) Delete() *DeviceProvisionDelete {
+	mutation := newDeviceProvisionMutation(c.config, OpDelete)
+	return &DeviceProvisionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *DeviceProvisionClient) DeleteOne(_m *DeviceProvision) *DeviceProvisionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *DeviceProvisionClient) DeleteOneID(id int) *DeviceProvisionDeleteOne {
+	builder := c.Delete().Where(deviceprovision.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &DeviceProvisionDeleteOne{builder}
+}
+
+// Query returns a query builder for DeviceProvision.
+func (c *DeviceProvisionClient) Query() *DeviceProvisionQuery {
+	return &DeviceProvisionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeDeviceProvision},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a DeviceProvision entity by its id.
+func (c *DeviceProvisionClient) Get(ctx context.Context, id int) (*DeviceProvision, error) {
+	return c.Query().Where(deviceprovision.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *DeviceProvisionClient) GetX(ctx context.Context, id int) *DeviceProvision {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *DeviceProvisionClient) Hooks() []Hook {
+	return c.hooks.DeviceProvision
+}
+
+// Interceptors returns the client interceptors.
+func (c *DeviceProvisionClient) Interceptors() []Interceptor {
+	return c.inters.DeviceProvision
+}
+
+func (c *DeviceProvisionClient) mutate(ctx context.Context, m *DeviceProvisionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&DeviceProvisionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&DeviceProvisionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&DeviceProvisionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&DeviceProvisionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown DeviceProvision mutation op: %q", m.Op())
+	}
+}
+
+// EnrollmentKeyClient is a client for the EnrollmentKey schema.
+type EnrollmentKeyClient struct {
+	config
+}
+
+// NewEnrollmentKeyClient returns a client for the EnrollmentKey from the given config.
+func NewEnrollmentKeyClient(c config) *EnrollmentKeyClient {
+	return &EnrollmentKeyClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `enrollmentkey.Hooks(f(g(h())))`.
+func (c *EnrollmentKeyClient) Use(hooks ...Hook) {
+	c.hooks.EnrollmentKey = append(c.hooks.EnrollmentKey, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `enrollmentkey.Intercept(f(g(h())))`.
+func (c *EnrollmentKeyClient) Intercept(interceptors ...Interceptor) {
+	c.inters.EnrollmentKey = append(c.inters.EnrollmentKey, interceptors...)
+}
+
+// Create returns a builder for creating a EnrollmentKey entity.
+func (c *EnrollmentKeyClient) Create() *EnrollmentKeyCreate {
+	mutation := newEnrollmentKeyMutation(c.config, OpCreate)
+	return &EnrollmentKeyCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of EnrollmentKey entities.
+func (c *EnrollmentKeyClient) CreateBulk(builders ...*EnrollmentKeyCreate) *EnrollmentKeyCreateBulk {
+	return &EnrollmentKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *EnrollmentKeyClient) MapCreateBulk(slice any, setFunc func(*EnrollmentKeyCreate, int)) *EnrollmentKeyCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &EnrollmentKeyCreateBulk{err: fmt.Errorf("calling to EnrollmentKeyClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*EnrollmentKeyCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &EnrollmentKeyCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for EnrollmentKey.
+func (c *EnrollmentKeyClient) Update() *EnrollmentKeyUpdate {
+	mutation := newEnrollmentKeyMutation(c.config, OpUpdate)
+	return &EnrollmentKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *EnrollmentKeyClient) UpdateOne(_m *EnrollmentKey) *EnrollmentKeyUpdateOne {
+	mutation := newEnrollmentKeyMutation(c.config, OpUpdateOne, withEnrollmentKey(_m))
+	return &EnrollmentKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *EnrollmentKeyClient) UpdateOneID(id int) *EnrollmentKeyUpdateOne {
+	mutation := newEnrollmentKeyMutation(c.config, OpUpdateOne, withEnrollmentKeyID(id))
+	return &EnrollmentKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for EnrollmentKey.
+func (c *EnrollmentKeyClient) Delete() *EnrollmentKeyDelete {
+	mutation := newEnrollmentKeyMutation(c.config, OpDelete)
+	return &EnrollmentKeyDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *EnrollmentKeyClient) DeleteOne(_m *EnrollmentKey) *EnrollmentKeyDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *EnrollmentKeyClient) DeleteOneID(id int) *EnrollmentKeyDeleteOne {
+	builder := c.Delete().Where(enrollmentkey.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &EnrollmentKeyDeleteOne{builder}
+}
+
+// Query returns a query builder for EnrollmentKey.
+func (c *EnrollmentKeyClient) Query() *EnrollmentKeyQuery {
+	return &EnrollmentKeyQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeEnrollmentKey},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a EnrollmentKey entity by its id.
+func (c *EnrollmentKeyClient) Get(ctx context.Context, id int) (*EnrollmentKey, error) {
+	return c.Query().Where(enrollmentkey.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *EnrollmentKeyClient) GetX(ctx context.Context, id int) *EnrollmentKey {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *EnrollmentKeyClient) Hooks() []Hook {
+	return c.hooks.EnrollmentKey
+}
+
+// Interceptors returns the client interceptors.
+func (c *EnrollmentKeyClient) Interceptors() []Interceptor {
+	return c.inters.EnrollmentKey
+}
+
+func (c *EnrollmentKeyClient) mutate(ctx context.Context, m *EnrollmentKeyMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&EnrollmentKeyCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&EnrollmentKeyUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&EnrollmentKeyUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&EnrollmentKeyDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown EnrollmentKey mutation op: %q", m.Op())
+	}
+}
+
+// RevokedTokenClient is a client for the RevokedToken schema.
+type RevokedTokenClient struct {
+	config
+}
+
+// NewRevokedTokenClient returns a client for the RevokedToken from the given config.
+func NewRevokedTokenClient(c config) *RevokedTokenClient {
+	return &RevokedTokenClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `revokedtoken.Hooks(f(g(h())))`.
+func (c *RevokedTokenClient) Use(hooks ...Hook) {
+	c.hooks.RevokedToken = append(c.hooks.RevokedToken, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `revokedtoken.Intercept(f(g(h())))`.
+func (c *RevokedTokenClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RevokedToken = append(c.inters.RevokedToken, interceptors...)
+}
+
+// Create returns a builder for creating a RevokedToken entity.
+func (c *RevokedTokenClient) Create() *RevokedTokenCreate {
+	mutation := newRevokedTokenMutation(c.config, OpCreate)
+	return &RevokedTokenCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RevokedToken entities.
+func (c *RevokedTokenClient) CreateBulk(builders ...*RevokedTokenCreate) *RevokedTokenCreateBulk {
+	return &RevokedTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RevokedTokenClient) MapCreateBulk(slice any, setFunc func(*RevokedTokenCreate, int)) *RevokedTokenCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RevokedTokenCreateBulk{err: fmt.Errorf("calling to RevokedTokenClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RevokedTokenCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RevokedTokenCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RevokedToken.
+func (c *RevokedTokenClient) Update() *RevokedTokenUpdate {
+	mutation := newRevokedTokenMutation(c.config, OpUpdate)
+	return &RevokedTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RevokedTokenClient) UpdateOne(_m *RevokedToken) *RevokedTokenUpdateOne {
+	mutation := newRevokedTokenMutation(c.config, OpUpdateOne, withRevokedToken(_m))
+	return &RevokedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RevokedTokenClient) UpdateOneID(id int) *RevokedTokenUpdateOne {
+	mutation := newRevokedTokenMutation(c.config, OpUpdateOne, withRevokedTokenID(id))
+	return &RevokedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RevokedToken.
+func (c *RevokedTokenClient) Delete() *RevokedTokenDelete {
+	mutation := newRevokedTokenMutation(c.config, OpDelete)
+	return &RevokedTokenDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RevokedTokenClient) DeleteOne(_m *RevokedToken) *RevokedTokenDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RevokedTokenClient) DeleteOneID(id int) *RevokedTokenDeleteOne {
+	builder := c.Delete().Where(revokedtoken.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RevokedTokenDeleteOne{builder}
+}
+
+// Query returns a query builder for RevokedToken.
+func (c *RevokedTokenClient) Query() *RevokedTokenQuery {
+	return &RevokedTokenQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRevokedToken},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RevokedToken entity by its id.
+func (c *RevokedTokenClient) Get(ctx context.Context, id int) (*RevokedToken, error) {
+	return c.Query().Where(revokedtoken.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RevokedTokenClient) GetX(ctx context.Context, id int) *RevokedToken {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RevokedTokenClient) Hooks() []Hook {
+	return c.hooks.RevokedToken
+}
+
+// Interceptors returns the client interceptors.
+func (c *RevokedTokenClient) Interceptors() []Interceptor {
+	return c.inters.RevokedToken
+}
+
+func (c *RevokedTokenClient) mutate(ctx context.Context, m *RevokedTokenMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RevokedTokenCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RevokedTokenUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RevokedTokenUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RevokedTokenDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RevokedToken mutation op: %q", m.Op())
+	}
+}
+
 // SettingsClient is a client for the Settings schema.
 type SettingsClient struct {
 	config
@@ -451,7 +1168,8 @@ func (c *SettingsClient) GetX(ctx context.Context, id int) *Settings {
 
 // Hooks returns the client hooks.
 func (c *SettingsClient) Hooks() []Hook {
-	return c.hooks.Settings
+	hooks := c.hooks.Settings
+	return append(hooks[:len(hooks):len(hooks)], settings.Hooks[:]...)
 }
 
 // Interceptors returns the client interceptors.
@@ -477,9 +1195,35 @@ func (c *SettingsClient) mutate(ctx context.Context, m *SettingsMutation) (Value
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Device, Settings []ent.Hook
+		AuditEvent, DNSRecord, Device, DeviceProvision, EnrollmentKey, RevokedToken,
+		Settings []ent.Hook
 	}
 	inters struct {
-		Device, Settings []ent.Interceptor
+		AuditEvent, DNSRecord, Device, DeviceProvision, EnrollmentKey, RevokedToken,
+		Settings []ent.Interceptor
 	}
 )
+
+// ExecContext allows calling the underlying ExecContext method of the driver if it is supported by it.
+// See, database/sql#DB.ExecContext for more information.
+func (c *config) ExecContext(ctx context.Context, query string, args ...any) (stdsql.Result, error) {
+	ex, ok := c.driver.(interface {
+		ExecContext(context.Context, string, ...any) (stdsql.Result, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.ExecContext is not supported")
+	}
+	return ex.ExecContext(ctx, query, args...)
+}
+
+// QueryContext allows calling the underlying QueryContext method of the driver if it is supported by it.
+// See, database/sql#DB.QueryContext for more information.
+func (c *config) QueryContext(ctx context.Context, query string, args ...any) (*stdsql.Rows, error) {
+	q, ok := c.driver.(interface {
+		QueryContext(context.Context, string, ...any) (*stdsql.Rows, error)
+	})
+	if !ok {
+		return nil, fmt.Errorf("Driver.QueryContext is not supported")
+	}
+	return q.QueryContext(ctx, query, args...)
+}
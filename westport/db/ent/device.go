@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -30,7 +31,33 @@ type Device struct {
 	// Access Token leased to a provisioned device. Can only issue 1 at a time, similar to a lock. Used to verify only 1 instance of the Device is running.
 	LeasedAccessToken *string `json:"-"`
 	// Token holds the value of the "token" field.
-	Token        helpers.EncryptedBytes `json:"-"`
+	Token helpers.EncryptedBytes `json:"-"`
+	// Token is exchanged for a new one on first provision, so it can't be used twice
+	SingleUseToken bool `json:"single_use_token,omitempty"`
+	// Nebula groups signed into the device cert, for firewall rules
+	Groups []string `json:"groups,omitempty"`
+	// IPv4 LAN cidrs this device routes for. Signed into the device cert and pushed to all other devices as unsafe routes.
+	AdvertiseRoutes []string `json:"advertise_routes,omitempty"`
+	// Device can route internet traffic for other devices. Opted into with `west start --exit-node`
+	ExitNode bool `json:"exit_node,omitempty"`
+	// Device relays traffic for peers that cannot hole punch, e.g. behind symmetric NAT
+	Relay bool `json:"relay,omitempty"`
+	// Device is an additional lighthouse alongside west port
+	Lighthouse bool `json:"lighthouse,omitempty"`
+	// Public underlay host:port other devices use to reach this device. Required for lighthouses
+	PublicEndpoint string `json:"public_endpoint,omitempty"`
+	// Last time the device provisioned or checked in with the API
+	LastProvisionTime *time.Time `json:"last_provision_time,omitempty"`
+	// Last time the device had a tunnel to west port's lighthouse
+	LastSeenTime *time.Time `json:"last_seen_time,omitempty"`
+	// Last known underlay address. The lighthouse's host:port for the device, or the API caller's IP after provisioning
+	UnderlayAddr string `json:"underlay_addr,omitempty"`
+	// West version the device last provisioned with
+	ClientVersion string `json:"client_version,omitempty"`
+	// Operating system the device last provisioned from, e.g. linux
+	Os string `json:"os,omitempty"`
+	// CPU architecture the device last provisioned from, e.g. arm64
+	Arch         string `json:"arch,omitempty"`
 	selectValues sql.SelectValues
 }
 
@@ -39,13 +66,17 @@ func (*Device) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case device.FieldGroups, device.FieldAdvertiseRoutes:
+			values[i] = new([]byte)
 		case device.FieldToken:
 			values[i] = new(helpers.EncryptedBytes)
+		case device.FieldSingleUseToken, device.FieldExitNode, device.FieldRelay, device.FieldLighthouse:
+			values[i] = new(sql.NullBool)
 		case device.FieldID, device.FieldIP:
 			values[i] = new(sql.NullInt64)
-		case device.FieldName, device.FieldLeasedAccessToken:
+		case device.FieldName, device.FieldLeasedAccessToken, device.FieldPublicEndpoint, device.FieldUnderlayAddr, device.FieldClientVersion, device.FieldOs, device.FieldArch:
 			values[i] = new(sql.NullString)
-		case device.FieldCreatedTime, device.FieldUpdatedTime:
+		case device.FieldCreatedTime, device.FieldUpdatedTime, device.FieldLastProvisionTime, device.FieldLastSeenTime:
 			values[i] = new(sql.NullTime)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -105,6 +136,90 @@ func (_m *Device) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.Token = *value
 			}
+		case device.FieldSingleUseToken:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field single_use_token", values[i])
+			} else if value.Valid {
+				_m.SingleUseToken = value.Bool
+			}
+		case device.FieldGroups:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field groups", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Groups); err != nil {
+					return fmt.Errorf("unmarshal field groups: %w", err)
+				}
+			}
+		case device.FieldAdvertiseRoutes:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field advertise_routes", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.AdvertiseRoutes); err != nil {
+					return fmt.Errorf("unmarshal field advertise_routes: %w", err)
+				}
+			}
+		case device.FieldExitNode:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_node", values[i])
+			} else if value.Valid {
+				_m.ExitNode = value.Bool
+			}
+		case device.FieldRelay:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field relay", values[i])
+			} else if value.Valid {
+				_m.Relay = value.Bool
+			}
+		case device.FieldLighthouse:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field lighthouse", values[i])
+			} else if value.Valid {
+				_m.Lighthouse = value.Bool
+			}
+		case device.FieldPublicEndpoint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_endpoint", values[i])
+			} else if value.Valid {
+				_m.PublicEndpoint = value.String
+			}
+		case device.FieldLastProvisionTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_provision_time", values[i])
+			} else if value.Valid {
+				_m.LastProvisionTime = new(time.Time)
+				*_m.LastProvisionTime = value.Time
+			}
+		case device.FieldLastSeenTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_seen_time", values[i])
+			} else if value.Valid {
+				_m.LastSeenTime = new(time.Time)
+				*_m.LastSeenTime = value.Time
+			}
+		case device.FieldUnderlayAddr:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field underlay_addr", values[i])
+			} else if value.Valid {
+				_m.UnderlayAddr = value.String
+			}
+		case device.FieldClientVersion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_version", values[i])
+			} else if value.Valid {
+				_m.ClientVersion = value.String
+			}
+		case device.FieldOs:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field os", values[i])
+			} else if value.Valid {
+				_m.Os = value.String
+			}
+		case device.FieldArch:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field arch", values[i])
+			} else if value.Valid {
+				_m.Arch = value.String
+			}
 		default:
 			_m.selectValues.Set(columns[i], values[i])
 		}
@@ -156,6 +271,49 @@ func (_m *Device) String() string {
 	builder.WriteString("leased_access_token=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("single_use_token=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SingleUseToken))
+	builder.WriteString(", ")
+	builder.WriteString("groups=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Groups))
+	builder.WriteString(", ")
+	builder.WriteString("advertise_routes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AdvertiseRoutes))
+	builder.WriteString(", ")
+	builder.WriteString("exit_node=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ExitNode))
+	builder.WriteString(", ")
+	builder.WriteString("relay=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Relay))
+	builder.WriteString(", ")
+	builder.WriteString("lighthouse=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Lighthouse))
+	builder.WriteString(", ")
+	builder.WriteString("public_endpoint=")
+	builder.WriteString(_m.PublicEndpoint)
+	builder.WriteString(", ")
+	if v := _m.LastProvisionTime; v != nil {
+		builder.WriteString("last_provision_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastSeenTime; v != nil {
+		builder.WriteString("last_seen_time=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("underlay_addr=")
+	builder.WriteString(_m.UnderlayAddr)
+	builder.WriteString(", ")
+	builder.WriteString("client_version=")
+	builder.WriteString(_m.ClientVersion)
+	builder.WriteString(", ")
+	builder.WriteString("os=")
+	builder.WriteString(_m.Os)
+	builder.WriteString(", ")
+	builder.WriteString("arch=")
+	builder.WriteString(_m.Arch)
 	builder.WriteByte(')')
 	return builder.String()
 }
@@ -5,6 +5,7 @@ package device
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -25,6 +26,32 @@ const (
 	FieldLeasedAccessToken = "leased_access_token"
 	// FieldToken holds the string denoting the token field in the database.
 	FieldToken = "token"
+	// FieldSingleUseToken holds the string denoting the single_use_token field in the database.
+	FieldSingleUseToken = "single_use_token"
+	// FieldGroups holds the string denoting the groups field in the database.
+	FieldGroups = "groups"
+	// FieldAdvertiseRoutes holds the string denoting the advertise_routes field in the database.
+	FieldAdvertiseRoutes = "advertise_routes"
+	// FieldExitNode holds the string denoting the exit_node field in the database.
+	FieldExitNode = "exit_node"
+	// FieldRelay holds the string denoting the relay field in the database.
+	FieldRelay = "relay"
+	// FieldLighthouse holds the string denoting the lighthouse field in the database.
+	FieldLighthouse = "lighthouse"
+	// FieldPublicEndpoint holds the string denoting the public_endpoint field in the database.
+	FieldPublicEndpoint = "public_endpoint"
+	// FieldLastProvisionTime holds the string denoting the last_provision_time field in the database.
+	FieldLastProvisionTime = "last_provision_time"
+	// FieldLastSeenTime holds the string denoting the last_seen_time field in the database.
+	FieldLastSeenTime = "last_seen_time"
+	// FieldUnderlayAddr holds the string denoting the underlay_addr field in the database.
+	FieldUnderlayAddr = "underlay_addr"
+	// FieldClientVersion holds the string denoting the client_version field in the database.
+	FieldClientVersion = "client_version"
+	// FieldOs holds the string denoting the os field in the database.
+	FieldOs = "os"
+	// FieldArch holds the string denoting the arch field in the database.
+	FieldArch = "arch"
 	// Table holds the table name of the device in the database.
 	Table = "devices"
 )
@@ -38,6 +65,19 @@ var Columns = []string{
 	FieldIP,
 	FieldLeasedAccessToken,
 	FieldToken,
+	FieldSingleUseToken,
+	FieldGroups,
+	FieldAdvertiseRoutes,
+	FieldExitNode,
+	FieldRelay,
+	FieldLighthouse,
+	FieldPublicEndpoint,
+	FieldLastProvisionTime,
+	FieldLastSeenTime,
+	FieldUnderlayAddr,
+	FieldClientVersion,
+	FieldOs,
+	FieldArch,
 }
 
 // ValidColumn reports if the column name is valid (part of the table columns).
@@ -50,7 +90,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/sprisa/west/westport/db/ent/runtime"
 var (
+	Hooks [2]ent.Hook
 	// DefaultCreatedTime holds the default value on creation for the "created_time" field.
 	DefaultCreatedTime func() time.Time
 	// DefaultUpdatedTime holds the default value on creation for the "updated_time" field.
@@ -61,6 +107,14 @@ var (
 	DefaultName func() string
 	// IPValidator is a validator for the "ip" field. It is called by the builders before save.
 	IPValidator func(uint32) error
+	// DefaultSingleUseToken holds the default value on creation for the "single_use_token" field.
+	DefaultSingleUseToken bool
+	// DefaultExitNode holds the default value on creation for the "exit_node" field.
+	DefaultExitNode bool
+	// DefaultRelay holds the default value on creation for the "relay" field.
+	DefaultRelay bool
+	// DefaultLighthouse holds the default value on creation for the "lighthouse" field.
+	DefaultLighthouse bool
 )
 
 // OrderOption defines the ordering options for the Device queries.
@@ -95,3 +149,58 @@ func ByIP(opts ...sql.OrderTermOption) OrderOption {
 func ByLeasedAccessToken(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldLeasedAccessToken, opts...).ToFunc()
 }
+
+// BySingleUseToken orders the results by the single_use_token field.
+func BySingleUseToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSingleUseToken, opts...).ToFunc()
+}
+
+// ByExitNode orders the results by the exit_node field.
+func ByExitNode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExitNode, opts...).ToFunc()
+}
+
+// ByRelay orders the results by the relay field.
+func ByRelay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRelay, opts...).ToFunc()
+}
+
+// ByLighthouse orders the results by the lighthouse field.
+func ByLighthouse(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLighthouse, opts...).ToFunc()
+}
+
+// ByPublicEndpoint orders the results by the public_endpoint field.
+func ByPublicEndpoint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicEndpoint, opts...).ToFunc()
+}
+
+// ByLastProvisionTime orders the results by the last_provision_time field.
+func ByLastProvisionTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastProvisionTime, opts...).ToFunc()
+}
+
+// ByLastSeenTime orders the results by the last_seen_time field.
+func ByLastSeenTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastSeenTime, opts...).ToFunc()
+}
+
+// ByUnderlayAddr orders the results by the underlay_addr field.
+func ByUnderlayAddr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUnderlayAddr, opts...).ToFunc()
+}
+
+// ByClientVersion orders the results by the client_version field.
+func ByClientVersion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientVersion, opts...).ToFunc()
+}
+
+// ByOs orders the results by the os field.
+func ByOs(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOs, opts...).ToFunc()
+}
+
+// ByArch orders the results by the arch field.
+func ByArch(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldArch, opts...).ToFunc()
+}
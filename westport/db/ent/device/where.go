@@ -87,6 +87,61 @@ func Token(v helpers.EncryptedBytes) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldToken, v))
 }
 
+// SingleUseToken applies equality check predicate on the "single_use_token" field. It's identical to SingleUseTokenEQ.
+func SingleUseToken(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldSingleUseToken, v))
+}
+
+// ExitNode applies equality check predicate on the "exit_node" field. It's identical to ExitNodeEQ.
+func ExitNode(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldExitNode, v))
+}
+
+// Relay applies equality check predicate on the "relay" field. It's identical to RelayEQ.
+func Relay(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRelay, v))
+}
+
+// Lighthouse applies equality check predicate on the "lighthouse" field. It's identical to LighthouseEQ.
+func Lighthouse(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLighthouse, v))
+}
+
+// PublicEndpoint applies equality check predicate on the "public_endpoint" field. It's identical to PublicEndpointEQ.
+func PublicEndpoint(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldPublicEndpoint, v))
+}
+
+// LastProvisionTime applies equality check predicate on the "last_provision_time" field. It's identical to LastProvisionTimeEQ.
+func LastProvisionTime(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastProvisionTime, v))
+}
+
+// LastSeenTime applies equality check predicate on the "last_seen_time" field. It's identical to LastSeenTimeEQ.
+func LastSeenTime(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenTime, v))
+}
+
+// UnderlayAddr applies equality check predicate on the "underlay_addr" field. It's identical to UnderlayAddrEQ.
+func UnderlayAddr(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUnderlayAddr, v))
+}
+
+// ClientVersion applies equality check predicate on the "client_version" field. It's identical to ClientVersionEQ.
+func ClientVersion(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// Os applies equality check predicate on the "os" field. It's identical to OsEQ.
+func Os(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOs, v))
+}
+
+// Arch applies equality check predicate on the "arch" field. It's identical to ArchEQ.
+func Arch(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldArch, v))
+}
+
 // CreatedTimeEQ applies the EQ predicate on the "created_time" field.
 func CreatedTimeEQ(v time.Time) predicate.Device {
 	return predicate.Device(sql.FieldEQ(FieldCreatedTime, v))
@@ -401,6 +456,541 @@ func TokenLTE(v helpers.EncryptedBytes) predicate.Device {
 	return predicate.Device(sql.FieldLTE(FieldToken, v))
 }
 
+// SingleUseTokenEQ applies the EQ predicate on the "single_use_token" field.
+func SingleUseTokenEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldSingleUseToken, v))
+}
+
+// SingleUseTokenNEQ applies the NEQ predicate on the "single_use_token" field.
+func SingleUseTokenNEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldSingleUseToken, v))
+}
+
+// GroupsIsNil applies the IsNil predicate on the "groups" field.
+func GroupsIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldGroups))
+}
+
+// GroupsNotNil applies the NotNil predicate on the "groups" field.
+func GroupsNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldGroups))
+}
+
+// AdvertiseRoutesIsNil applies the IsNil predicate on the "advertise_routes" field.
+func AdvertiseRoutesIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldAdvertiseRoutes))
+}
+
+// AdvertiseRoutesNotNil applies the NotNil predicate on the "advertise_routes" field.
+func AdvertiseRoutesNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldAdvertiseRoutes))
+}
+
+// ExitNodeEQ applies the EQ predicate on the "exit_node" field.
+func ExitNodeEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldExitNode, v))
+}
+
+// ExitNodeNEQ applies the NEQ predicate on the "exit_node" field.
+func ExitNodeNEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldExitNode, v))
+}
+
+// RelayEQ applies the EQ predicate on the "relay" field.
+func RelayEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldRelay, v))
+}
+
+// RelayNEQ applies the NEQ predicate on the "relay" field.
+func RelayNEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldRelay, v))
+}
+
+// LighthouseEQ applies the EQ predicate on the "lighthouse" field.
+func LighthouseEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLighthouse, v))
+}
+
+// LighthouseNEQ applies the NEQ predicate on the "lighthouse" field.
+func LighthouseNEQ(v bool) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLighthouse, v))
+}
+
+// PublicEndpointEQ applies the EQ predicate on the "public_endpoint" field.
+func PublicEndpointEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointNEQ applies the NEQ predicate on the "public_endpoint" field.
+func PublicEndpointNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointIn applies the In predicate on the "public_endpoint" field.
+func PublicEndpointIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldPublicEndpoint, vs...))
+}
+
+// PublicEndpointNotIn applies the NotIn predicate on the "public_endpoint" field.
+func PublicEndpointNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldPublicEndpoint, vs...))
+}
+
+// PublicEndpointGT applies the GT predicate on the "public_endpoint" field.
+func PublicEndpointGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointGTE applies the GTE predicate on the "public_endpoint" field.
+func PublicEndpointGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointLT applies the LT predicate on the "public_endpoint" field.
+func PublicEndpointLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointLTE applies the LTE predicate on the "public_endpoint" field.
+func PublicEndpointLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointContains applies the Contains predicate on the "public_endpoint" field.
+func PublicEndpointContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointHasPrefix applies the HasPrefix predicate on the "public_endpoint" field.
+func PublicEndpointHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointHasSuffix applies the HasSuffix predicate on the "public_endpoint" field.
+func PublicEndpointHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointIsNil applies the IsNil predicate on the "public_endpoint" field.
+func PublicEndpointIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldPublicEndpoint))
+}
+
+// PublicEndpointNotNil applies the NotNil predicate on the "public_endpoint" field.
+func PublicEndpointNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldPublicEndpoint))
+}
+
+// PublicEndpointEqualFold applies the EqualFold predicate on the "public_endpoint" field.
+func PublicEndpointEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldPublicEndpoint, v))
+}
+
+// PublicEndpointContainsFold applies the ContainsFold predicate on the "public_endpoint" field.
+func PublicEndpointContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldPublicEndpoint, v))
+}
+
+// LastProvisionTimeEQ applies the EQ predicate on the "last_provision_time" field.
+func LastProvisionTimeEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastProvisionTime, v))
+}
+
+// LastProvisionTimeNEQ applies the NEQ predicate on the "last_provision_time" field.
+func LastProvisionTimeNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastProvisionTime, v))
+}
+
+// LastProvisionTimeIn applies the In predicate on the "last_provision_time" field.
+func LastProvisionTimeIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastProvisionTime, vs...))
+}
+
+// LastProvisionTimeNotIn applies the NotIn predicate on the "last_provision_time" field.
+func LastProvisionTimeNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastProvisionTime, vs...))
+}
+
+// LastProvisionTimeGT applies the GT predicate on the "last_provision_time" field.
+func LastProvisionTimeGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastProvisionTime, v))
+}
+
+// LastProvisionTimeGTE applies the GTE predicate on the "last_provision_time" field.
+func LastProvisionTimeGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastProvisionTime, v))
+}
+
+// LastProvisionTimeLT applies the LT predicate on the "last_provision_time" field.
+func LastProvisionTimeLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastProvisionTime, v))
+}
+
+// LastProvisionTimeLTE applies the LTE predicate on the "last_provision_time" field.
+func LastProvisionTimeLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastProvisionTime, v))
+}
+
+// LastProvisionTimeIsNil applies the IsNil predicate on the "last_provision_time" field.
+func LastProvisionTimeIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastProvisionTime))
+}
+
+// LastProvisionTimeNotNil applies the NotNil predicate on the "last_provision_time" field.
+func LastProvisionTimeNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastProvisionTime))
+}
+
+// LastSeenTimeEQ applies the EQ predicate on the "last_seen_time" field.
+func LastSeenTimeEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeNEQ applies the NEQ predicate on the "last_seen_time" field.
+func LastSeenTimeNEQ(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeIn applies the In predicate on the "last_seen_time" field.
+func LastSeenTimeIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldLastSeenTime, vs...))
+}
+
+// LastSeenTimeNotIn applies the NotIn predicate on the "last_seen_time" field.
+func LastSeenTimeNotIn(vs ...time.Time) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldLastSeenTime, vs...))
+}
+
+// LastSeenTimeGT applies the GT predicate on the "last_seen_time" field.
+func LastSeenTimeGT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeGTE applies the GTE predicate on the "last_seen_time" field.
+func LastSeenTimeGTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeLT applies the LT predicate on the "last_seen_time" field.
+func LastSeenTimeLT(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeLTE applies the LTE predicate on the "last_seen_time" field.
+func LastSeenTimeLTE(v time.Time) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldLastSeenTime, v))
+}
+
+// LastSeenTimeIsNil applies the IsNil predicate on the "last_seen_time" field.
+func LastSeenTimeIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldLastSeenTime))
+}
+
+// LastSeenTimeNotNil applies the NotNil predicate on the "last_seen_time" field.
+func LastSeenTimeNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldLastSeenTime))
+}
+
+// UnderlayAddrEQ applies the EQ predicate on the "underlay_addr" field.
+func UnderlayAddrEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrNEQ applies the NEQ predicate on the "underlay_addr" field.
+func UnderlayAddrNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrIn applies the In predicate on the "underlay_addr" field.
+func UnderlayAddrIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldUnderlayAddr, vs...))
+}
+
+// UnderlayAddrNotIn applies the NotIn predicate on the "underlay_addr" field.
+func UnderlayAddrNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldUnderlayAddr, vs...))
+}
+
+// UnderlayAddrGT applies the GT predicate on the "underlay_addr" field.
+func UnderlayAddrGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrGTE applies the GTE predicate on the "underlay_addr" field.
+func UnderlayAddrGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrLT applies the LT predicate on the "underlay_addr" field.
+func UnderlayAddrLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrLTE applies the LTE predicate on the "underlay_addr" field.
+func UnderlayAddrLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrContains applies the Contains predicate on the "underlay_addr" field.
+func UnderlayAddrContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrHasPrefix applies the HasPrefix predicate on the "underlay_addr" field.
+func UnderlayAddrHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrHasSuffix applies the HasSuffix predicate on the "underlay_addr" field.
+func UnderlayAddrHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrIsNil applies the IsNil predicate on the "underlay_addr" field.
+func UnderlayAddrIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldUnderlayAddr))
+}
+
+// UnderlayAddrNotNil applies the NotNil predicate on the "underlay_addr" field.
+func UnderlayAddrNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldUnderlayAddr))
+}
+
+// UnderlayAddrEqualFold applies the EqualFold predicate on the "underlay_addr" field.
+func UnderlayAddrEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldUnderlayAddr, v))
+}
+
+// UnderlayAddrContainsFold applies the ContainsFold predicate on the "underlay_addr" field.
+func UnderlayAddrContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldUnderlayAddr, v))
+}
+
+// ClientVersionEQ applies the EQ predicate on the "client_version" field.
+func ClientVersionEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// ClientVersionNEQ applies the NEQ predicate on the "client_version" field.
+func ClientVersionNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldClientVersion, v))
+}
+
+// ClientVersionIn applies the In predicate on the "client_version" field.
+func ClientVersionIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionNotIn applies the NotIn predicate on the "client_version" field.
+func ClientVersionNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionGT applies the GT predicate on the "client_version" field.
+func ClientVersionGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldClientVersion, v))
+}
+
+// ClientVersionGTE applies the GTE predicate on the "client_version" field.
+func ClientVersionGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldClientVersion, v))
+}
+
+// ClientVersionLT applies the LT predicate on the "client_version" field.
+func ClientVersionLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldClientVersion, v))
+}
+
+// ClientVersionLTE applies the LTE predicate on the "client_version" field.
+func ClientVersionLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldClientVersion, v))
+}
+
+// ClientVersionContains applies the Contains predicate on the "client_version" field.
+func ClientVersionContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldClientVersion, v))
+}
+
+// ClientVersionHasPrefix applies the HasPrefix predicate on the "client_version" field.
+func ClientVersionHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldClientVersion, v))
+}
+
+// ClientVersionHasSuffix applies the HasSuffix predicate on the "client_version" field.
+func ClientVersionHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldClientVersion, v))
+}
+
+// ClientVersionIsNil applies the IsNil predicate on the "client_version" field.
+func ClientVersionIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldClientVersion))
+}
+
+// ClientVersionNotNil applies the NotNil predicate on the "client_version" field.
+func ClientVersionNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldClientVersion))
+}
+
+// ClientVersionEqualFold applies the EqualFold predicate on the "client_version" field.
+func ClientVersionEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldClientVersion, v))
+}
+
+// ClientVersionContainsFold applies the ContainsFold predicate on the "client_version" field.
+func ClientVersionContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldClientVersion, v))
+}
+
+// OsEQ applies the EQ predicate on the "os" field.
+func OsEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldOs, v))
+}
+
+// OsNEQ applies the NEQ predicate on the "os" field.
+func OsNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldOs, v))
+}
+
+// OsIn applies the In predicate on the "os" field.
+func OsIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldOs, vs...))
+}
+
+// OsNotIn applies the NotIn predicate on the "os" field.
+func OsNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldOs, vs...))
+}
+
+// OsGT applies the GT predicate on the "os" field.
+func OsGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldOs, v))
+}
+
+// OsGTE applies the GTE predicate on the "os" field.
+func OsGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldOs, v))
+}
+
+// OsLT applies the LT predicate on the "os" field.
+func OsLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldOs, v))
+}
+
+// OsLTE applies the LTE predicate on the "os" field.
+func OsLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldOs, v))
+}
+
+// OsContains applies the Contains predicate on the "os" field.
+func OsContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldOs, v))
+}
+
+// OsHasPrefix applies the HasPrefix predicate on the "os" field.
+func OsHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldOs, v))
+}
+
+// OsHasSuffix applies the HasSuffix predicate on the "os" field.
+func OsHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldOs, v))
+}
+
+// OsIsNil applies the IsNil predicate on the "os" field.
+func OsIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldOs))
+}
+
+// OsNotNil applies the NotNil predicate on the "os" field.
+func OsNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldOs))
+}
+
+// OsEqualFold applies the EqualFold predicate on the "os" field.
+func OsEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldOs, v))
+}
+
+// OsContainsFold applies the ContainsFold predicate on the "os" field.
+func OsContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldOs, v))
+}
+
+// ArchEQ applies the EQ predicate on the "arch" field.
+func ArchEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldEQ(FieldArch, v))
+}
+
+// ArchNEQ applies the NEQ predicate on the "arch" field.
+func ArchNEQ(v string) predicate.Device {
+	return predicate.Device(sql.FieldNEQ(FieldArch, v))
+}
+
+// ArchIn applies the In predicate on the "arch" field.
+func ArchIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldIn(FieldArch, vs...))
+}
+
+// ArchNotIn applies the NotIn predicate on the "arch" field.
+func ArchNotIn(vs ...string) predicate.Device {
+	return predicate.Device(sql.FieldNotIn(FieldArch, vs...))
+}
+
+// ArchGT applies the GT predicate on the "arch" field.
+func ArchGT(v string) predicate.Device {
+	return predicate.Device(sql.FieldGT(FieldArch, v))
+}
+
+// ArchGTE applies the GTE predicate on the "arch" field.
+func ArchGTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldGTE(FieldArch, v))
+}
+
+// ArchLT applies the LT predicate on the "arch" field.
+func ArchLT(v string) predicate.Device {
+	return predicate.Device(sql.FieldLT(FieldArch, v))
+}
+
+// ArchLTE applies the LTE predicate on the "arch" field.
+func ArchLTE(v string) predicate.Device {
+	return predicate.Device(sql.FieldLTE(FieldArch, v))
+}
+
+// ArchContains applies the Contains predicate on the "arch" field.
+func ArchContains(v string) predicate.Device {
+	return predicate.Device(sql.FieldContains(FieldArch, v))
+}
+
+// ArchHasPrefix applies the HasPrefix predicate on the "arch" field.
+func ArchHasPrefix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasPrefix(FieldArch, v))
+}
+
+// ArchHasSuffix applies the HasSuffix predicate on the "arch" field.
+func ArchHasSuffix(v string) predicate.Device {
+	return predicate.Device(sql.FieldHasSuffix(FieldArch, v))
+}
+
+// ArchIsNil applies the IsNil predicate on the "arch" field.
+func ArchIsNil() predicate.Device {
+	return predicate.Device(sql.FieldIsNull(FieldArch))
+}
+
+// ArchNotNil applies the NotNil predicate on the "arch" field.
+func ArchNotNil() predicate.Device {
+	return predicate.Device(sql.FieldNotNull(FieldArch))
+}
+
+// ArchEqualFold applies the EqualFold predicate on the "arch" field.
+func ArchEqualFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldEqualFold(FieldArch, v))
+}
+
+// ArchContainsFold applies the ContainsFold predicate on the "arch" field.
+func ArchContainsFold(v string) predicate.Device {
+	return predicate.Device(sql.FieldContainsFold(FieldArch, v))
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Device) predicate.Device {
 	return predicate.Device(sql.AndPredicates(predicates...))
@@ -90,6 +90,172 @@ func (_c *DeviceCreate) SetToken(v helpers.EncryptedBytes) *DeviceCreate {
 	return _c
 }
 
+// SetSingleUseToken sets the "single_use_token" field.
+func (_c *DeviceCreate) SetSingleUseToken(v bool) *DeviceCreate {
+	_c.mutation.SetSingleUseToken(v)
+	return _c
+}
+
+// SetNillableSingleUseToken sets the "single_use_token" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableSingleUseToken(v *bool) *DeviceCreate {
+	if v != nil {
+		_c.SetSingleUseToken(*v)
+	}
+	return _c
+}
+
+// SetGroups sets the "groups" field.
+func (_c *DeviceCreate) SetGroups(v []string) *DeviceCreate {
+	_c.mutation.SetGroups(v)
+	return _c
+}
+
+// SetAdvertiseRoutes sets the "advertise_routes" field.
+func (_c *DeviceCreate) SetAdvertiseRoutes(v []string) *DeviceCreate {
+	_c.mutation.SetAdvertiseRoutes(v)
+	return _c
+}
+
+// SetExitNode sets the "exit_node" field.
+func (_c *DeviceCreate) SetExitNode(v bool) *DeviceCreate {
+	_c.mutation.SetExitNode(v)
+	return _c
+}
+
+// SetNillableExitNode sets the "exit_node" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableExitNode(v *bool) *DeviceCreate {
+	if v != nil {
+		_c.SetExitNode(*v)
+	}
+	return _c
+}
+
+// SetRelay sets the "relay" field.
+func (_c *DeviceCreate) SetRelay(v bool) *DeviceCreate {
+	_c.mutation.SetRelay(v)
+	return _c
+}
+
+// SetNillableRelay sets the "relay" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableRelay(v *bool) *DeviceCreate {
+	if v != nil {
+		_c.SetRelay(*v)
+	}
+	return _c
+}
+
+// SetLighthouse sets the "lighthouse" field.
+func (_c *DeviceCreate) SetLighthouse(v bool) *DeviceCreate {
+	_c.mutation.SetLighthouse(v)
+	return _c
+}
+
+// SetNillableLighthouse sets the "lighthouse" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableLighthouse(v *bool) *DeviceCreate {
+	if v != nil {
+		_c.SetLighthouse(*v)
+	}
+	return _c
+}
+
+// SetPublicEndpoint sets the "public_endpoint" field.
+func (_c *DeviceCreate) SetPublicEndpoint(v string) *DeviceCreate {
+	_c.mutation.SetPublicEndpoint(v)
+	return _c
+}
+
+// SetNillablePublicEndpoint sets the "public_endpoint" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillablePublicEndpoint(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetPublicEndpoint(*v)
+	}
+	return _c
+}
+
+// SetLastProvisionTime sets the "last_provision_time" field.
+func (_c *DeviceCreate) SetLastProvisionTime(v time.Time) *DeviceCreate {
+	_c.mutation.SetLastProvisionTime(v)
+	return _c
+}
+
+// SetNillableLastProvisionTime sets the "last_provision_time" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableLastProvisionTime(v *time.Time) *DeviceCreate {
+	if v != nil {
+		_c.SetLastProvisionTime(*v)
+	}
+	return _c
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (_c *DeviceCreate) SetLastSeenTime(v time.Time) *DeviceCreate {
+	_c.mutation.SetLastSeenTime(v)
+	return _c
+}
+
+// SetNillableLastSeenTime sets the "last_seen_time" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableLastSeenTime(v *time.Time) *DeviceCreate {
+	if v != nil {
+		_c.SetLastSeenTime(*v)
+	}
+	return _c
+}
+
+// SetUnderlayAddr sets the "underlay_addr" field.
+func (_c *DeviceCreate) SetUnderlayAddr(v string) *DeviceCreate {
+	_c.mutation.SetUnderlayAddr(v)
+	return _c
+}
+
+// SetNillableUnderlayAddr sets the "underlay_addr" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableUnderlayAddr(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetUnderlayAddr(*v)
+	}
+	return _c
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_c *DeviceCreate) SetClientVersion(v string) *DeviceCreate {
+	_c.mutation.SetClientVersion(v)
+	return _c
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableClientVersion(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetClientVersion(*v)
+	}
+	return _c
+}
+
+// SetOs sets the "os" field.
+func (_c *DeviceCreate) SetOs(v string) *DeviceCreate {
+	_c.mutation.SetOs(v)
+	return _c
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableOs(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetOs(*v)
+	}
+	return _c
+}
+
+// SetArch sets the "arch" field.
+func (_c *DeviceCreate) SetArch(v string) *DeviceCreate {
+	_c.mutation.SetArch(v)
+	return _c
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (_c *DeviceCreate) SetNillableArch(v *string) *DeviceCreate {
+	if v != nil {
+		_c.SetArch(*v)
+	}
+	return _c
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (_c *DeviceCreate) Mutation() *DeviceMutation {
 	return _c.mutation
@@ -97,7 +263,9 @@ func (_c *DeviceCreate) Mutation() *DeviceMutation {
 
 // Save creates the Device in the database.
 func (_c *DeviceCreate) Save(ctx context.Context) (*Device, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -124,19 +292,45 @@ func (_c *DeviceCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *DeviceCreate) defaults() {
+func (_c *DeviceCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedTime(); !ok {
+		if device.DefaultCreatedTime == nil {
+			return fmt.Errorf("ent: uninitialized device.DefaultCreatedTime (forgotten import ent/runtime?)")
+		}
 		v := device.DefaultCreatedTime()
 		_c.mutation.SetCreatedTime(v)
 	}
 	if _, ok := _c.mutation.UpdatedTime(); !ok {
+		if device.DefaultUpdatedTime == nil {
+			return fmt.Errorf("ent: uninitialized device.DefaultUpdatedTime (forgotten import ent/runtime?)")
+		}
 		v := device.DefaultUpdatedTime()
 		_c.mutation.SetUpdatedTime(v)
 	}
 	if _, ok := _c.mutation.Name(); !ok {
+		if device.DefaultName == nil {
+			return fmt.Errorf("ent: uninitialized device.DefaultName (forgotten import ent/runtime?)")
+		}
 		v := device.DefaultName()
 		_c.mutation.SetName(v)
 	}
+	if _, ok := _c.mutation.SingleUseToken(); !ok {
+		v := device.DefaultSingleUseToken
+		_c.mutation.SetSingleUseToken(v)
+	}
+	if _, ok := _c.mutation.ExitNode(); !ok {
+		v := device.DefaultExitNode
+		_c.mutation.SetExitNode(v)
+	}
+	if _, ok := _c.mutation.Relay(); !ok {
+		v := device.DefaultRelay
+		_c.mutation.SetRelay(v)
+	}
+	if _, ok := _c.mutation.Lighthouse(); !ok {
+		v := device.DefaultLighthouse
+		_c.mutation.SetLighthouse(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -161,6 +355,18 @@ func (_c *DeviceCreate) check() error {
 	if _, ok := _c.mutation.Token(); !ok {
 		return &ValidationError{Name: "token", err: errors.New(`ent: missing required field "Device.token"`)}
 	}
+	if _, ok := _c.mutation.SingleUseToken(); !ok {
+		return &ValidationError{Name: "single_use_token", err: errors.New(`ent: missing required field "Device.single_use_token"`)}
+	}
+	if _, ok := _c.mutation.ExitNode(); !ok {
+		return &ValidationError{Name: "exit_node", err: errors.New(`ent: missing required field "Device.exit_node"`)}
+	}
+	if _, ok := _c.mutation.Relay(); !ok {
+		return &ValidationError{Name: "relay", err: errors.New(`ent: missing required field "Device.relay"`)}
+	}
+	if _, ok := _c.mutation.Lighthouse(); !ok {
+		return &ValidationError{Name: "lighthouse", err: errors.New(`ent: missing required field "Device.lighthouse"`)}
+	}
 	return nil
 }
 
@@ -211,6 +417,58 @@ func (_c *DeviceCreate) createSpec() (*Device, *sqlgraph.CreateSpec) {
 		_spec.SetField(device.FieldToken, field.TypeBytes, value)
 		_node.Token = value
 	}
+	if value, ok := _c.mutation.SingleUseToken(); ok {
+		_spec.SetField(device.FieldSingleUseToken, field.TypeBool, value)
+		_node.SingleUseToken = value
+	}
+	if value, ok := _c.mutation.Groups(); ok {
+		_spec.SetField(device.FieldGroups, field.TypeJSON, value)
+		_node.Groups = value
+	}
+	if value, ok := _c.mutation.AdvertiseRoutes(); ok {
+		_spec.SetField(device.FieldAdvertiseRoutes, field.TypeJSON, value)
+		_node.AdvertiseRoutes = value
+	}
+	if value, ok := _c.mutation.ExitNode(); ok {
+		_spec.SetField(device.FieldExitNode, field.TypeBool, value)
+		_node.ExitNode = value
+	}
+	if value, ok := _c.mutation.Relay(); ok {
+		_spec.SetField(device.FieldRelay, field.TypeBool, value)
+		_node.Relay = value
+	}
+	if value, ok := _c.mutation.Lighthouse(); ok {
+		_spec.SetField(device.FieldLighthouse, field.TypeBool, value)
+		_node.Lighthouse = value
+	}
+	if value, ok := _c.mutation.PublicEndpoint(); ok {
+		_spec.SetField(device.FieldPublicEndpoint, field.TypeString, value)
+		_node.PublicEndpoint = value
+	}
+	if value, ok := _c.mutation.LastProvisionTime(); ok {
+		_spec.SetField(device.FieldLastProvisionTime, field.TypeTime, value)
+		_node.LastProvisionTime = &value
+	}
+	if value, ok := _c.mutation.LastSeenTime(); ok {
+		_spec.SetField(device.FieldLastSeenTime, field.TypeTime, value)
+		_node.LastSeenTime = &value
+	}
+	if value, ok := _c.mutation.UnderlayAddr(); ok {
+		_spec.SetField(device.FieldUnderlayAddr, field.TypeString, value)
+		_node.UnderlayAddr = value
+	}
+	if value, ok := _c.mutation.ClientVersion(); ok {
+		_spec.SetField(device.FieldClientVersion, field.TypeString, value)
+		_node.ClientVersion = value
+	}
+	if value, ok := _c.mutation.Os(); ok {
+		_spec.SetField(device.FieldOs, field.TypeString, value)
+		_node.Os = value
+	}
+	if value, ok := _c.mutation.Arch(); ok {
+		_spec.SetField(device.FieldArch, field.TypeString, value)
+		_node.Arch = value
+	}
 	return _node, _spec
 }
 
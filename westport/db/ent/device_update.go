@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/sprisa/west/westport/db/ent/device"
 	"github.com/sprisa/west/westport/db/ent/predicate"
@@ -75,6 +76,238 @@ func (_u *DeviceUpdate) SetToken(v helpers.EncryptedBytes) *DeviceUpdate {
 	return _u
 }
 
+// SetSingleUseToken sets the "single_use_token" field.
+func (_u *DeviceUpdate) SetSingleUseToken(v bool) *DeviceUpdate {
+	_u.mutation.SetSingleUseToken(v)
+	return _u
+}
+
+// SetNillableSingleUseToken sets the "single_use_token" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableSingleUseToken(v *bool) *DeviceUpdate {
+	if v != nil {
+		_u.SetSingleUseToken(*v)
+	}
+	return _u
+}
+
+// SetGroups sets the "groups" field.
+func (_u *DeviceUpdate) SetGroups(v []string) *DeviceUpdate {
+	_u.mutation.SetGroups(v)
+	return _u
+}
+
+// AppendGroups appends value to the "groups" field.
+func (_u *DeviceUpdate) AppendGroups(v []string) *DeviceUpdate {
+	_u.mutation.AppendGroups(v)
+	return _u
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (_u *DeviceUpdate) ClearGroups() *DeviceUpdate {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// SetAdvertiseRoutes sets the "advertise_routes" field.
+func (_u *DeviceUpdate) SetAdvertiseRoutes(v []string) *DeviceUpdate {
+	_u.mutation.SetAdvertiseRoutes(v)
+	return _u
+}
+
+// AppendAdvertiseRoutes appends value to the "advertise_routes" field.
+func (_u *DeviceUpdate) AppendAdvertiseRoutes(v []string) *DeviceUpdate {
+	_u.mutation.AppendAdvertiseRoutes(v)
+	return _u
+}
+
+// ClearAdvertiseRoutes clears the value of the "advertise_routes" field.
+func (_u *DeviceUpdate) ClearAdvertiseRoutes() *DeviceUpdate {
+	_u.mutation.ClearAdvertiseRoutes()
+	return _u
+}
+
+// SetExitNode sets the "exit_node" field.
+func (_u *DeviceUpdate) SetExitNode(v bool) *DeviceUpdate {
+	_u.mutation.SetExitNode(v)
+	return _u
+}
+
+// SetNillableExitNode sets the "exit_node" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableExitNode(v *bool) *DeviceUpdate {
+	if v != nil {
+		_u.SetExitNode(*v)
+	}
+	return _u
+}
+
+// SetRelay sets the "relay" field.
+func (_u *DeviceUpdate) SetRelay(v bool) *DeviceUpdate {
+	_u.mutation.SetRelay(v)
+	return _u
+}
+
+// SetNillableRelay sets the "relay" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableRelay(v *bool) *DeviceUpdate {
+	if v != nil {
+		_u.SetRelay(*v)
+	}
+	return _u
+}
+
+// SetLighthouse sets the "lighthouse" field.
+func (_u *DeviceUpdate) SetLighthouse(v bool) *DeviceUpdate {
+	_u.mutation.SetLighthouse(v)
+	return _u
+}
+
+// SetNillableLighthouse sets the "lighthouse" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableLighthouse(v *bool) *DeviceUpdate {
+	if v != nil {
+		_u.SetLighthouse(*v)
+	}
+	return _u
+}
+
+// SetPublicEndpoint sets the "public_endpoint" field.
+func (_u *DeviceUpdate) SetPublicEndpoint(v string) *DeviceUpdate {
+	_u.mutation.SetPublicEndpoint(v)
+	return _u
+}
+
+// SetNillablePublicEndpoint sets the "public_endpoint" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillablePublicEndpoint(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetPublicEndpoint(*v)
+	}
+	return _u
+}
+
+// ClearPublicEndpoint clears the value of the "public_endpoint" field.
+func (_u *DeviceUpdate) ClearPublicEndpoint() *DeviceUpdate {
+	_u.mutation.ClearPublicEndpoint()
+	return _u
+}
+
+// SetLastProvisionTime sets the "last_provision_time" field.
+func (_u *DeviceUpdate) SetLastProvisionTime(v time.Time) *DeviceUpdate {
+	_u.mutation.SetLastProvisionTime(v)
+	return _u
+}
+
+// SetNillableLastProvisionTime sets the "last_provision_time" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableLastProvisionTime(v *time.Time) *DeviceUpdate {
+	if v != nil {
+		_u.SetLastProvisionTime(*v)
+	}
+	return _u
+}
+
+// ClearLastProvisionTime clears the value of the "last_provision_time" field.
+func (_u *DeviceUpdate) ClearLastProvisionTime() *DeviceUpdate {
+	_u.mutation.ClearLastProvisionTime()
+	return _u
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (_u *DeviceUpdate) SetLastSeenTime(v time.Time) *DeviceUpdate {
+	_u.mutation.SetLastSeenTime(v)
+	return _u
+}
+
+// SetNillableLastSeenTime sets the "last_seen_time" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableLastSeenTime(v *time.Time) *DeviceUpdate {
+	if v != nil {
+		_u.SetLastSeenTime(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenTime clears the value of the "last_seen_time" field.
+func (_u *DeviceUpdate) ClearLastSeenTime() *DeviceUpdate {
+	_u.mutation.ClearLastSeenTime()
+	return _u
+}
+
+// SetUnderlayAddr sets the "underlay_addr" field.
+func (_u *DeviceUpdate) SetUnderlayAddr(v string) *DeviceUpdate {
+	_u.mutation.SetUnderlayAddr(v)
+	return _u
+}
+
+// SetNillableUnderlayAddr sets the "underlay_addr" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableUnderlayAddr(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetUnderlayAddr(*v)
+	}
+	return _u
+}
+
+// ClearUnderlayAddr clears the value of the "underlay_addr" field.
+func (_u *DeviceUpdate) ClearUnderlayAddr() *DeviceUpdate {
+	_u.mutation.ClearUnderlayAddr()
+	return _u
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_u *DeviceUpdate) SetClientVersion(v string) *DeviceUpdate {
+	_u.mutation.SetClientVersion(v)
+	return _u
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableClientVersion(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetClientVersion(*v)
+	}
+	return _u
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (_u *DeviceUpdate) ClearClientVersion() *DeviceUpdate {
+	_u.mutation.ClearClientVersion()
+	return _u
+}
+
+// SetOs sets the "os" field.
+func (_u *DeviceUpdate) SetOs(v string) *DeviceUpdate {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableOs(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *DeviceUpdate) ClearOs() *DeviceUpdate {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// SetArch sets the "arch" field.
+func (_u *DeviceUpdate) SetArch(v string) *DeviceUpdate {
+	_u.mutation.SetArch(v)
+	return _u
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (_u *DeviceUpdate) SetNillableArch(v *string) *DeviceUpdate {
+	if v != nil {
+		_u.SetArch(*v)
+	}
+	return _u
+}
+
+// ClearArch clears the value of the "arch" field.
+func (_u *DeviceUpdate) ClearArch() *DeviceUpdate {
+	_u.mutation.ClearArch()
+	return _u
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (_u *DeviceUpdate) Mutation() *DeviceMutation {
 	return _u.mutation
@@ -82,7 +315,9 @@ func (_u *DeviceUpdate) Mutation() *DeviceMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *DeviceUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -109,11 +344,15 @@ func (_u *DeviceUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DeviceUpdate) defaults() {
+func (_u *DeviceUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		if device.UpdateDefaultUpdatedTime == nil {
+			return fmt.Errorf("ent: uninitialized device.UpdateDefaultUpdatedTime (forgotten import ent/runtime?)")
+		}
 		v := device.UpdateDefaultUpdatedTime()
 		_u.mutation.SetUpdatedTime(v)
 	}
+	return nil
 }
 
 func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -140,6 +379,82 @@ func (_u *DeviceUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(device.FieldToken, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.SingleUseToken(); ok {
+		_spec.SetField(device.FieldSingleUseToken, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Groups(); ok {
+		_spec.SetField(device.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldGroups, value)
+		})
+	}
+	if _u.mutation.GroupsCleared() {
+		_spec.ClearField(device.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AdvertiseRoutes(); ok {
+		_spec.SetField(device.FieldAdvertiseRoutes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAdvertiseRoutes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldAdvertiseRoutes, value)
+		})
+	}
+	if _u.mutation.AdvertiseRoutesCleared() {
+		_spec.ClearField(device.FieldAdvertiseRoutes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExitNode(); ok {
+		_spec.SetField(device.FieldExitNode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Relay(); ok {
+		_spec.SetField(device.FieldRelay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Lighthouse(); ok {
+		_spec.SetField(device.FieldLighthouse, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PublicEndpoint(); ok {
+		_spec.SetField(device.FieldPublicEndpoint, field.TypeString, value)
+	}
+	if _u.mutation.PublicEndpointCleared() {
+		_spec.ClearField(device.FieldPublicEndpoint, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastProvisionTime(); ok {
+		_spec.SetField(device.FieldLastProvisionTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastProvisionTimeCleared() {
+		_spec.ClearField(device.FieldLastProvisionTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenTime(); ok {
+		_spec.SetField(device.FieldLastSeenTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenTimeCleared() {
+		_spec.ClearField(device.FieldLastSeenTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnderlayAddr(); ok {
+		_spec.SetField(device.FieldUnderlayAddr, field.TypeString, value)
+	}
+	if _u.mutation.UnderlayAddrCleared() {
+		_spec.ClearField(device.FieldUnderlayAddr, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientVersion(); ok {
+		_spec.SetField(device.FieldClientVersion, field.TypeString, value)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(device.FieldClientVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(device.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(device.FieldOs, field.TypeString)
+	}
+	if value, ok := _u.mutation.Arch(); ok {
+		_spec.SetField(device.FieldArch, field.TypeString, value)
+	}
+	if _u.mutation.ArchCleared() {
+		_spec.ClearField(device.FieldArch, field.TypeString)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{device.Label}
@@ -206,6 +521,238 @@ func (_u *DeviceUpdateOne) SetToken(v helpers.EncryptedBytes) *DeviceUpdateOne {
 	return _u
 }
 
+// SetSingleUseToken sets the "single_use_token" field.
+func (_u *DeviceUpdateOne) SetSingleUseToken(v bool) *DeviceUpdateOne {
+	_u.mutation.SetSingleUseToken(v)
+	return _u
+}
+
+// SetNillableSingleUseToken sets the "single_use_token" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableSingleUseToken(v *bool) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetSingleUseToken(*v)
+	}
+	return _u
+}
+
+// SetGroups sets the "groups" field.
+func (_u *DeviceUpdateOne) SetGroups(v []string) *DeviceUpdateOne {
+	_u.mutation.SetGroups(v)
+	return _u
+}
+
+// AppendGroups appends value to the "groups" field.
+func (_u *DeviceUpdateOne) AppendGroups(v []string) *DeviceUpdateOne {
+	_u.mutation.AppendGroups(v)
+	return _u
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (_u *DeviceUpdateOne) ClearGroups() *DeviceUpdateOne {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// SetAdvertiseRoutes sets the "advertise_routes" field.
+func (_u *DeviceUpdateOne) SetAdvertiseRoutes(v []string) *DeviceUpdateOne {
+	_u.mutation.SetAdvertiseRoutes(v)
+	return _u
+}
+
+// AppendAdvertiseRoutes appends value to the "advertise_routes" field.
+func (_u *DeviceUpdateOne) AppendAdvertiseRoutes(v []string) *DeviceUpdateOne {
+	_u.mutation.AppendAdvertiseRoutes(v)
+	return _u
+}
+
+// ClearAdvertiseRoutes clears the value of the "advertise_routes" field.
+func (_u *DeviceUpdateOne) ClearAdvertiseRoutes() *DeviceUpdateOne {
+	_u.mutation.ClearAdvertiseRoutes()
+	return _u
+}
+
+// SetExitNode sets the "exit_node" field.
+func (_u *DeviceUpdateOne) SetExitNode(v bool) *DeviceUpdateOne {
+	_u.mutation.SetExitNode(v)
+	return _u
+}
+
+// SetNillableExitNode sets the "exit_node" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableExitNode(v *bool) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetExitNode(*v)
+	}
+	return _u
+}
+
+// SetRelay sets the "relay" field.
+func (_u *DeviceUpdateOne) SetRelay(v bool) *DeviceUpdateOne {
+	_u.mutation.SetRelay(v)
+	return _u
+}
+
+// SetNillableRelay sets the "relay" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableRelay(v *bool) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetRelay(*v)
+	}
+	return _u
+}
+
+// SetLighthouse sets the "lighthouse" field.
+func (_u *DeviceUpdateOne) SetLighthouse(v bool) *DeviceUpdateOne {
+	_u.mutation.SetLighthouse(v)
+	return _u
+}
+
+// SetNillableLighthouse sets the "lighthouse" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableLighthouse(v *bool) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetLighthouse(*v)
+	}
+	return _u
+}
+
+// SetPublicEndpoint sets the "public_endpoint" field.
+func (_u *DeviceUpdateOne) SetPublicEndpoint(v string) *DeviceUpdateOne {
+	_u.mutation.SetPublicEndpoint(v)
+	return _u
+}
+
+// SetNillablePublicEndpoint sets the "public_endpoint" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillablePublicEndpoint(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetPublicEndpoint(*v)
+	}
+	return _u
+}
+
+// ClearPublicEndpoint clears the value of the "public_endpoint" field.
+func (_u *DeviceUpdateOne) ClearPublicEndpoint() *DeviceUpdateOne {
+	_u.mutation.ClearPublicEndpoint()
+	return _u
+}
+
+// SetLastProvisionTime sets the "last_provision_time" field.
+func (_u *DeviceUpdateOne) SetLastProvisionTime(v time.Time) *DeviceUpdateOne {
+	_u.mutation.SetLastProvisionTime(v)
+	return _u
+}
+
+// SetNillableLastProvisionTime sets the "last_provision_time" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableLastProvisionTime(v *time.Time) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetLastProvisionTime(*v)
+	}
+	return _u
+}
+
+// ClearLastProvisionTime clears the value of the "last_provision_time" field.
+func (_u *DeviceUpdateOne) ClearLastProvisionTime() *DeviceUpdateOne {
+	_u.mutation.ClearLastProvisionTime()
+	return _u
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (_u *DeviceUpdateOne) SetLastSeenTime(v time.Time) *DeviceUpdateOne {
+	_u.mutation.SetLastSeenTime(v)
+	return _u
+}
+
+// SetNillableLastSeenTime sets the "last_seen_time" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableLastSeenTime(v *time.Time) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetLastSeenTime(*v)
+	}
+	return _u
+}
+
+// ClearLastSeenTime clears the value of the "last_seen_time" field.
+func (_u *DeviceUpdateOne) ClearLastSeenTime() *DeviceUpdateOne {
+	_u.mutation.ClearLastSeenTime()
+	return _u
+}
+
+// SetUnderlayAddr sets the "underlay_addr" field.
+func (_u *DeviceUpdateOne) SetUnderlayAddr(v string) *DeviceUpdateOne {
+	_u.mutation.SetUnderlayAddr(v)
+	return _u
+}
+
+// SetNillableUnderlayAddr sets the "underlay_addr" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableUnderlayAddr(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetUnderlayAddr(*v)
+	}
+	return _u
+}
+
+// ClearUnderlayAddr clears the value of the "underlay_addr" field.
+func (_u *DeviceUpdateOne) ClearUnderlayAddr() *DeviceUpdateOne {
+	_u.mutation.ClearUnderlayAddr()
+	return _u
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_u *DeviceUpdateOne) SetClientVersion(v string) *DeviceUpdateOne {
+	_u.mutation.SetClientVersion(v)
+	return _u
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableClientVersion(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetClientVersion(*v)
+	}
+	return _u
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (_u *DeviceUpdateOne) ClearClientVersion() *DeviceUpdateOne {
+	_u.mutation.ClearClientVersion()
+	return _u
+}
+
+// SetOs sets the "os" field.
+func (_u *DeviceUpdateOne) SetOs(v string) *DeviceUpdateOne {
+	_u.mutation.SetOs(v)
+	return _u
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableOs(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetOs(*v)
+	}
+	return _u
+}
+
+// ClearOs clears the value of the "os" field.
+func (_u *DeviceUpdateOne) ClearOs() *DeviceUpdateOne {
+	_u.mutation.ClearOs()
+	return _u
+}
+
+// SetArch sets the "arch" field.
+func (_u *DeviceUpdateOne) SetArch(v string) *DeviceUpdateOne {
+	_u.mutation.SetArch(v)
+	return _u
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (_u *DeviceUpdateOne) SetNillableArch(v *string) *DeviceUpdateOne {
+	if v != nil {
+		_u.SetArch(*v)
+	}
+	return _u
+}
+
+// ClearArch clears the value of the "arch" field.
+func (_u *DeviceUpdateOne) ClearArch() *DeviceUpdateOne {
+	_u.mutation.ClearArch()
+	return _u
+}
+
 // Mutation returns the DeviceMutation object of the builder.
 func (_u *DeviceUpdateOne) Mutation() *DeviceMutation {
 	return _u.mutation
@@ -226,7 +773,9 @@ func (_u *DeviceUpdateOne) Select(field string, fields ...string) *DeviceUpdateO
 
 // Save executes the query and returns the updated Device entity.
 func (_u *DeviceUpdateOne) Save(ctx context.Context) (*Device, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -253,11 +802,15 @@ func (_u *DeviceUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *DeviceUpdateOne) defaults() {
+func (_u *DeviceUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		if device.UpdateDefaultUpdatedTime == nil {
+			return fmt.Errorf("ent: uninitialized device.UpdateDefaultUpdatedTime (forgotten import ent/runtime?)")
+		}
 		v := device.UpdateDefaultUpdatedTime()
 		_u.mutation.SetUpdatedTime(v)
 	}
+	return nil
 }
 
 func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err error) {
@@ -301,6 +854,82 @@ func (_u *DeviceUpdateOne) sqlSave(ctx context.Context) (_node *Device, err erro
 	if value, ok := _u.mutation.Token(); ok {
 		_spec.SetField(device.FieldToken, field.TypeBytes, value)
 	}
+	if value, ok := _u.mutation.SingleUseToken(); ok {
+		_spec.SetField(device.FieldSingleUseToken, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Groups(); ok {
+		_spec.SetField(device.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldGroups, value)
+		})
+	}
+	if _u.mutation.GroupsCleared() {
+		_spec.ClearField(device.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AdvertiseRoutes(); ok {
+		_spec.SetField(device.FieldAdvertiseRoutes, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedAdvertiseRoutes(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, device.FieldAdvertiseRoutes, value)
+		})
+	}
+	if _u.mutation.AdvertiseRoutesCleared() {
+		_spec.ClearField(device.FieldAdvertiseRoutes, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ExitNode(); ok {
+		_spec.SetField(device.FieldExitNode, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Relay(); ok {
+		_spec.SetField(device.FieldRelay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Lighthouse(); ok {
+		_spec.SetField(device.FieldLighthouse, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PublicEndpoint(); ok {
+		_spec.SetField(device.FieldPublicEndpoint, field.TypeString, value)
+	}
+	if _u.mutation.PublicEndpointCleared() {
+		_spec.ClearField(device.FieldPublicEndpoint, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastProvisionTime(); ok {
+		_spec.SetField(device.FieldLastProvisionTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastProvisionTimeCleared() {
+		_spec.ClearField(device.FieldLastProvisionTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.LastSeenTime(); ok {
+		_spec.SetField(device.FieldLastSeenTime, field.TypeTime, value)
+	}
+	if _u.mutation.LastSeenTimeCleared() {
+		_spec.ClearField(device.FieldLastSeenTime, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UnderlayAddr(); ok {
+		_spec.SetField(device.FieldUnderlayAddr, field.TypeString, value)
+	}
+	if _u.mutation.UnderlayAddrCleared() {
+		_spec.ClearField(device.FieldUnderlayAddr, field.TypeString)
+	}
+	if value, ok := _u.mutation.ClientVersion(); ok {
+		_spec.SetField(device.FieldClientVersion, field.TypeString, value)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(device.FieldClientVersion, field.TypeString)
+	}
+	if value, ok := _u.mutation.Os(); ok {
+		_spec.SetField(device.FieldOs, field.TypeString, value)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(device.FieldOs, field.TypeString)
+	}
+	if value, ok := _u.mutation.Arch(); ok {
+		_spec.SetField(device.FieldArch, field.TypeString, value)
+	}
+	if _u.mutation.ArchCleared() {
+		_spec.ClearField(device.FieldArch, field.TypeString)
+	}
 	_node = &Device{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package deviceprovision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedTime applies equality check predicate on the "created_time" field. It's identical to CreatedTimeEQ.
+func CreatedTime(v time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldCreatedTime, v))
+}
+
+// DeviceID applies equality check predicate on the "device_id" field. It's identical to DeviceIDEQ.
+func DeviceID(v int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// RemoteAddr applies equality check predicate on the "remote_addr" field. It's identical to RemoteAddrEQ.
+func RemoteAddr(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldRemoteAddr, v))
+}
+
+// ClientVersion applies equality check predicate on the "client_version" field. It's identical to ClientVersionEQ.
+func ClientVersion(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// Os applies equality check predicate on the "os" field. It's identical to OsEQ.
+func Os(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldOs, v))
+}
+
+// Arch applies equality check predicate on the "arch" field. It's identical to ArchEQ.
+func Arch(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldArch, v))
+}
+
+// ClientKey applies equality check predicate on the "client_key" field. It's identical to ClientKeyEQ.
+func ClientKey(v bool) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldClientKey, v))
+}
+
+// CreatedTimeEQ applies the EQ predicate on the "created_time" field.
+func CreatedTimeEQ(v time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldCreatedTime, v))
+}
+
+// CreatedTimeNEQ applies the NEQ predicate on the "created_time" field.
+func CreatedTimeNEQ(v time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldCreatedTime, v))
+}
+
+// CreatedTimeIn applies the In predicate on the "created_time" field.
+func CreatedTimeIn(vs ...time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIn(FieldCreatedTime, vs...))
+}
+
+// CreatedTimeNotIn applies the NotIn predicate on the "created_time" field.
+func CreatedTimeNotIn(vs ...time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotIn(FieldCreatedTime, vs...))
+}
+
+// CreatedTimeGT applies the GT predicate on the "created_time" field.
+func CreatedTimeGT(v time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGT(FieldCreatedTime, v))
+}
+
+// CreatedTimeGTE applies the GTE predicate on the "created_time" field.
+func CreatedTimeGTE(v time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGTE(FieldCreatedTime, v))
+}
+
+// CreatedTimeLT applies the LT predicate on the "created_time" field.
+func CreatedTimeLT(v time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLT(FieldCreatedTime, v))
+}
+
+// CreatedTimeLTE applies the LTE predicate on the "created_time" field.
+func CreatedTimeLTE(v time.Time) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLTE(FieldCreatedTime, v))
+}
+
+// DeviceIDEQ applies the EQ predicate on the "device_id" field.
+func DeviceIDEQ(v int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldDeviceID, v))
+}
+
+// DeviceIDNEQ applies the NEQ predicate on the "device_id" field.
+func DeviceIDNEQ(v int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldDeviceID, v))
+}
+
+// DeviceIDIn applies the In predicate on the "device_id" field.
+func DeviceIDIn(vs ...int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDNotIn applies the NotIn predicate on the "device_id" field.
+func DeviceIDNotIn(vs ...int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotIn(FieldDeviceID, vs...))
+}
+
+// DeviceIDGT applies the GT predicate on the "device_id" field.
+func DeviceIDGT(v int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGT(FieldDeviceID, v))
+}
+
+// DeviceIDGTE applies the GTE predicate on the "device_id" field.
+func DeviceIDGTE(v int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGTE(FieldDeviceID, v))
+}
+
+// DeviceIDLT applies the LT predicate on the "device_id" field.
+func DeviceIDLT(v int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLT(FieldDeviceID, v))
+}
+
+// DeviceIDLTE applies the LTE predicate on the "device_id" field.
+func DeviceIDLTE(v int) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLTE(FieldDeviceID, v))
+}
+
+// RemoteAddrEQ applies the EQ predicate on the "remote_addr" field.
+func RemoteAddrEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldRemoteAddr, v))
+}
+
+// RemoteAddrNEQ applies the NEQ predicate on the "remote_addr" field.
+func RemoteAddrNEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldRemoteAddr, v))
+}
+
+// RemoteAddrIn applies the In predicate on the "remote_addr" field.
+func RemoteAddrIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIn(FieldRemoteAddr, vs...))
+}
+
+// RemoteAddrNotIn applies the NotIn predicate on the "remote_addr" field.
+func RemoteAddrNotIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotIn(FieldRemoteAddr, vs...))
+}
+
+// RemoteAddrGT applies the GT predicate on the "remote_addr" field.
+func RemoteAddrGT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGT(FieldRemoteAddr, v))
+}
+
+// RemoteAddrGTE applies the GTE predicate on the "remote_addr" field.
+func RemoteAddrGTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGTE(FieldRemoteAddr, v))
+}
+
+// RemoteAddrLT applies the LT predicate on the "remote_addr" field.
+func RemoteAddrLT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLT(FieldRemoteAddr, v))
+}
+
+// RemoteAddrLTE applies the LTE predicate on the "remote_addr" field.
+func RemoteAddrLTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLTE(FieldRemoteAddr, v))
+}
+
+// RemoteAddrContains applies the Contains predicate on the "remote_addr" field.
+func RemoteAddrContains(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContains(FieldRemoteAddr, v))
+}
+
+// RemoteAddrHasPrefix applies the HasPrefix predicate on the "remote_addr" field.
+func RemoteAddrHasPrefix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasPrefix(FieldRemoteAddr, v))
+}
+
+// RemoteAddrHasSuffix applies the HasSuffix predicate on the "remote_addr" field.
+func RemoteAddrHasSuffix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasSuffix(FieldRemoteAddr, v))
+}
+
+// RemoteAddrIsNil applies the IsNil predicate on the "remote_addr" field.
+func RemoteAddrIsNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIsNull(FieldRemoteAddr))
+}
+
+// RemoteAddrNotNil applies the NotNil predicate on the "remote_addr" field.
+func RemoteAddrNotNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotNull(FieldRemoteAddr))
+}
+
+// RemoteAddrEqualFold applies the EqualFold predicate on the "remote_addr" field.
+func RemoteAddrEqualFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEqualFold(FieldRemoteAddr, v))
+}
+
+// RemoteAddrContainsFold applies the ContainsFold predicate on the "remote_addr" field.
+func RemoteAddrContainsFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContainsFold(FieldRemoteAddr, v))
+}
+
+// ClientVersionEQ applies the EQ predicate on the "client_version" field.
+func ClientVersionEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldClientVersion, v))
+}
+
+// ClientVersionNEQ applies the NEQ predicate on the "client_version" field.
+func ClientVersionNEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldClientVersion, v))
+}
+
+// ClientVersionIn applies the In predicate on the "client_version" field.
+func ClientVersionIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionNotIn applies the NotIn predicate on the "client_version" field.
+func ClientVersionNotIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotIn(FieldClientVersion, vs...))
+}
+
+// ClientVersionGT applies the GT predicate on the "client_version" field.
+func ClientVersionGT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGT(FieldClientVersion, v))
+}
+
+// ClientVersionGTE applies the GTE predicate on the "client_version" field.
+func ClientVersionGTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGTE(FieldClientVersion, v))
+}
+
+// ClientVersionLT applies the LT predicate on the "client_version" field.
+func ClientVersionLT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLT(FieldClientVersion, v))
+}
+
+// ClientVersionLTE applies the LTE predicate on the "client_version" field.
+func ClientVersionLTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLTE(FieldClientVersion, v))
+}
+
+// ClientVersionContains applies the Contains predicate on the "client_version" field.
+func ClientVersionContains(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContains(FieldClientVersion, v))
+}
+
+// ClientVersionHasPrefix applies the HasPrefix predicate on the "client_version" field.
+func ClientVersionHasPrefix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasPrefix(FieldClientVersion, v))
+}
+
+// ClientVersionHasSuffix applies the HasSuffix predicate on the "client_version" field.
+func ClientVersionHasSuffix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasSuffix(FieldClientVersion, v))
+}
+
+// ClientVersionIsNil applies the IsNil predicate on the "client_version" field.
+func ClientVersionIsNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIsNull(FieldClientVersion))
+}
+
+// ClientVersionNotNil applies the NotNil predicate on the "client_version" field.
+func ClientVersionNotNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotNull(FieldClientVersion))
+}
+
+// ClientVersionEqualFold applies the EqualFold predicate on the "client_version" field.
+func ClientVersionEqualFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEqualFold(FieldClientVersion, v))
+}
+
+// ClientVersionContainsFold applies the ContainsFold predicate on the "client_version" field.
+func ClientVersionContainsFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContainsFold(FieldClientVersion, v))
+}
+
+// OsEQ applies the EQ predicate on the "os" field.
+func OsEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldOs, v))
+}
+
+// OsNEQ applies the NEQ predicate on the "os" field.
+func OsNEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldOs, v))
+}
+
+// OsIn applies the In predicate on the "os" field.
+func OsIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIn(FieldOs, vs...))
+}
+
+// OsNotIn applies the NotIn predicate on the "os" field.
+func OsNotIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotIn(FieldOs, vs...))
+}
+
+// OsGT applies the GT predicate on the "os" field.
+func OsGT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGT(FieldOs, v))
+}
+
+// OsGTE applies the GTE predicate on the "os" field.
+func OsGTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGTE(FieldOs, v))
+}
+
+// OsLT applies the LT predicate on the "os" field.
+func OsLT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLT(FieldOs, v))
+}
+
+// OsLTE applies the LTE predicate on the "os" field.
+func OsLTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLTE(FieldOs, v))
+}
+
+// OsContains applies the Contains predicate on the "os" field.
+func OsContains(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContains(FieldOs, v))
+}
+
+// OsHasPrefix applies the HasPrefix predicate on the "os" field.
+func OsHasPrefix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasPrefix(FieldOs, v))
+}
+
+// OsHasSuffix applies the HasSuffix predicate on the "os" field.
+func OsHasSuffix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasSuffix(FieldOs, v))
+}
+
+// OsIsNil applies the IsNil predicate on the "os" field.
+func OsIsNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIsNull(FieldOs))
+}
+
+// OsNotNil applies the NotNil predicate on the "os" field.
+func OsNotNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotNull(FieldOs))
+}
+
+// OsEqualFold applies the EqualFold predicate on the "os" field.
+func OsEqualFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEqualFold(FieldOs, v))
+}
+
+// OsContainsFold applies the ContainsFold predicate on the "os" field.
+func OsContainsFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContainsFold(FieldOs, v))
+}
+
+// ArchEQ applies the EQ predicate on the "arch" field.
+func ArchEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldArch, v))
+}
+
+// ArchNEQ applies the NEQ predicate on the "arch" field.
+func ArchNEQ(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldArch, v))
+}
+
+// ArchIn applies the In predicate on the "arch" field.
+func ArchIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIn(FieldArch, vs...))
+}
+
+// ArchNotIn applies the NotIn predicate on the "arch" field.
+func ArchNotIn(vs ...string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotIn(FieldArch, vs...))
+}
+
+// ArchGT applies the GT predicate on the "arch" field.
+func ArchGT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGT(FieldArch, v))
+}
+
+// ArchGTE applies the GTE predicate on the "arch" field.
+func ArchGTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldGTE(FieldArch, v))
+}
+
+// ArchLT applies the LT predicate on the "arch" field.
+func ArchLT(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLT(FieldArch, v))
+}
+
+// ArchLTE applies the LTE predicate on the "arch" field.
+func ArchLTE(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldLTE(FieldArch, v))
+}
+
+// ArchContains applies the Contains predicate on the "arch" field.
+func ArchContains(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContains(FieldArch, v))
+}
+
+// ArchHasPrefix applies the HasPrefix predicate on the "arch" field.
+func ArchHasPrefix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasPrefix(FieldArch, v))
+}
+
+// ArchHasSuffix applies the HasSuffix predicate on the "arch" field.
+func ArchHasSuffix(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldHasSuffix(FieldArch, v))
+}
+
+// ArchIsNil applies the IsNil predicate on the "arch" field.
+func ArchIsNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldIsNull(FieldArch))
+}
+
+// ArchNotNil applies the NotNil predicate on the "arch" field.
+func ArchNotNil() predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNotNull(FieldArch))
+}
+
+// ArchEqualFold applies the EqualFold predicate on the "arch" field.
+func ArchEqualFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEqualFold(FieldArch, v))
+}
+
+// ArchContainsFold applies the ContainsFold predicate on the "arch" field.
+func ArchContainsFold(v string) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldContainsFold(FieldArch, v))
+}
+
+// ClientKeyEQ applies the EQ predicate on the "client_key" field.
+func ClientKeyEQ(v bool) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldEQ(FieldClientKey, v))
+}
+
+// ClientKeyNEQ applies the NEQ predicate on the "client_key" field.
+func ClientKeyNEQ(v bool) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.FieldNEQ(FieldClientKey, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DeviceProvision) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DeviceProvision) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DeviceProvision) predicate.DeviceProvision {
+	return predicate.DeviceProvision(sql.NotPredicates(p))
+}
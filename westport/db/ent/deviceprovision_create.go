@@ -0,0 +1,312 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+)
+
+// DeviceProvisionCreate is the builder for creating a DeviceProvision entity.
+type DeviceProvisionCreate struct {
+	config
+	mutation *DeviceProvisionMutation
+	hooks    []Hook
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (_c *DeviceProvisionCreate) SetCreatedTime(v time.Time) *DeviceProvisionCreate {
+	_c.mutation.SetCreatedTime(v)
+	return _c
+}
+
+// SetNillableCreatedTime sets the "created_time" field if the given value is not nil.
+func (_c *DeviceProvisionCreate) SetNillableCreatedTime(v *time.Time) *DeviceProvisionCreate {
+	if v != nil {
+		_c.SetCreatedTime(*v)
+	}
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *DeviceProvisionCreate) SetDeviceID(v int) *DeviceProvisionCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetRemoteAddr sets the "remote_addr" field.
+func (_c *DeviceProvisionCreate) SetRemoteAddr(v string) *DeviceProvisionCreate {
+	_c.mutation.SetRemoteAddr(v)
+	return _c
+}
+
+// SetNillableRemoteAddr sets the "remote_addr" field if the given value is not nil.
+func (_c *DeviceProvisionCreate) SetNillableRemoteAddr(v *string) *DeviceProvisionCreate {
+	if v != nil {
+		_c.SetRemoteAddr(*v)
+	}
+	return _c
+}
+
+// SetClientVersion sets the "client_version" field.
+func (_c *DeviceProvisionCreate) SetClientVersion(v string) *DeviceProvisionCreate {
+	_c.mutation.SetClientVersion(v)
+	return _c
+}
+
+// SetNillableClientVersion sets the "client_version" field if the given value is not nil.
+func (_c *DeviceProvisionCreate) SetNillableClientVersion(v *string) *DeviceProvisionCreate {
+	if v != nil {
+		_c.SetClientVersion(*v)
+	}
+	return _c
+}
+
+// SetOs sets the "os" field.
+func (_c *DeviceProvisionCreate) SetOs(v string) *DeviceProvisionCreate {
+	_c.mutation.SetOs(v)
+	return _c
+}
+
+// SetNillableOs sets the "os" field if the given value is not nil.
+func (_c *DeviceProvisionCreate) SetNillableOs(v *string) *DeviceProvisionCreate {
+	if v != nil {
+		_c.SetOs(*v)
+	}
+	return _c
+}
+
+// SetArch sets the "arch" field.
+func (_c *DeviceProvisionCreate) SetArch(v string) *DeviceProvisionCreate {
+	_c.mutation.SetArch(v)
+	return _c
+}
+
+// SetNillableArch sets the "arch" field if the given value is not nil.
+func (_c *DeviceProvisionCreate) SetNillableArch(v *string) *DeviceProvisionCreate {
+	if v != nil {
+		_c.SetArch(*v)
+	}
+	return _c
+}
+
+// SetClientKey sets the "client_key" field.
+func (_c *DeviceProvisionCreate) SetClientKey(v bool) *DeviceProvisionCreate {
+	_c.mutation.SetClientKey(v)
+	return _c
+}
+
+// SetNillableClientKey sets the "client_key" field if the given value is not nil.
+func (_c *DeviceProvisionCreate) SetNillableClientKey(v *bool) *DeviceProvisionCreate {
+	if v != nil {
+		_c.SetClientKey(*v)
+	}
+	return _c
+}
+
+// Mutation returns the DeviceProvisionMutation object of the builder.
+func (_c *DeviceProvisionCreate) Mutation() *DeviceProvisionMutation {
+	return _c.mutation
+}
+
+// Save creates the DeviceProvision in the database.
+func (_c *DeviceProvisionCreate) Save(ctx context.Context) (*DeviceProvision, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DeviceProvisionCreate) SaveX(ctx context.Context) *DeviceProvision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceProvisionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceProvisionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DeviceProvisionCreate) defaults() {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		v := deviceprovision.DefaultCreatedTime()
+		_c.mutation.SetCreatedTime(v)
+	}
+	if _, ok := _c.mutation.ClientKey(); !ok {
+		v := deviceprovision.DefaultClientKey
+		_c.mutation.SetClientKey(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DeviceProvisionCreate) check() error {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		return &ValidationError{Name: "created_time", err: errors.New(`ent: missing required field "DeviceProvision.created_time"`)}
+	}
+	if _, ok := _c.mutation.DeviceID(); !ok {
+		return &ValidationError{Name: "device_id", err: errors.New(`ent: missing required field "DeviceProvision.device_id"`)}
+	}
+	if _, ok := _c.mutation.ClientKey(); !ok {
+		return &ValidationError{Name: "client_key", err: errors.New(`ent: missing required field "DeviceProvision.client_key"`)}
+	}
+	return nil
+}
+
+func (_c *DeviceProvisionCreate) sqlSave(ctx context.Context) (*DeviceProvision, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DeviceProvisionCreate) createSpec() (*DeviceProvision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DeviceProvision{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(deviceprovision.Table, sqlgraph.NewFieldSpec(deviceprovision.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedTime(); ok {
+		_spec.SetField(deviceprovision.FieldCreatedTime, field.TypeTime, value)
+		_node.CreatedTime = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(deviceprovision.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.RemoteAddr(); ok {
+		_spec.SetField(deviceprovision.FieldRemoteAddr, field.TypeString, value)
+		_node.RemoteAddr = value
+	}
+	if value, ok := _c.mutation.ClientVersion(); ok {
+		_spec.SetField(deviceprovision.FieldClientVersion, field.TypeString, value)
+		_node.ClientVersion = value
+	}
+	if value, ok := _c.mutation.Os(); ok {
+		_spec.SetField(deviceprovision.FieldOs, field.TypeString, value)
+		_node.Os = value
+	}
+	if value, ok := _c.mutation.Arch(); ok {
+		_spec.SetField(deviceprovision.FieldArch, field.TypeString, value)
+		_node.Arch = value
+	}
+	if value, ok := _c.mutation.ClientKey(); ok {
+		_spec.SetField(deviceprovision.FieldClientKey, field.TypeBool, value)
+		_node.ClientKey = value
+	}
+	return _node, _spec
+}
+
+// DeviceProvisionCreateBulk is the builder for creating many DeviceProvision entities in bulk.
+type DeviceProvisionCreateBulk struct {
+	config
+	err      error
+	builders []*DeviceProvisionCreate
+}
+
+// Save creates the DeviceProvision entities in the database.
+func (_c *DeviceProvisionCreateBulk) Save(ctx context.Context) ([]*DeviceProvision, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DeviceProvision, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DeviceProvisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DeviceProvisionCreateBulk) SaveX(ctx context.Context) []*DeviceProvision {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DeviceProvisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DeviceProvisionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
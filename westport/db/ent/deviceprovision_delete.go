@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// DeviceProvisionDelete is the builder for deleting a DeviceProvision entity.
+type DeviceProvisionDelete struct {
+	config
+	hooks    []Hook
+	mutation *DeviceProvisionMutation
+}
+
+// Where appends a list predicates to the DeviceProvisionDelete builder.
+func (_d *DeviceProvisionDelete) Where(ps ...predicate.DeviceProvision) *DeviceProvisionDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *DeviceProvisionDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeviceProvisionDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *DeviceProvisionDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(deviceprovision.Table, sqlgraph.NewFieldSpec(deviceprovision.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// DeviceProvisionDeleteOne is the builder for deleting a single DeviceProvision entity.
+type DeviceProvisionDeleteOne struct {
+	_d *DeviceProvisionDelete
+}
+
+// Where appends a list predicates to the DeviceProvisionDelete builder.
+func (_d *DeviceProvisionDeleteOne) Where(ps ...predicate.DeviceProvision) *DeviceProvisionDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *DeviceProvisionDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{deviceprovision.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *DeviceProvisionDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// DeviceProvisionUpdate is the builder for updating DeviceProvision entities.
+type DeviceProvisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DeviceProvisionMutation
+}
+
+// Where appends a list predicates to the DeviceProvisionUpdate builder.
+func (_u *DeviceProvisionUpdate) Where(ps ...predicate.DeviceProvision) *DeviceProvisionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the DeviceProvisionMutation object of the builder.
+func (_u *DeviceProvisionUpdate) Mutation() *DeviceProvisionMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DeviceProvisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceProvisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DeviceProvisionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceProvisionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeviceProvisionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deviceprovision.Table, deviceprovision.Columns, sqlgraph.NewFieldSpec(deviceprovision.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.RemoteAddrCleared() {
+		_spec.ClearField(deviceprovision.FieldRemoteAddr, field.TypeString)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(deviceprovision.FieldClientVersion, field.TypeString)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(deviceprovision.FieldOs, field.TypeString)
+	}
+	if _u.mutation.ArchCleared() {
+		_spec.ClearField(deviceprovision.FieldArch, field.TypeString)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceprovision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DeviceProvisionUpdateOne is the builder for updating a single DeviceProvision entity.
+type DeviceProvisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DeviceProvisionMutation
+}
+
+// Mutation returns the DeviceProvisionMutation object of the builder.
+func (_u *DeviceProvisionUpdateOne) Mutation() *DeviceProvisionMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DeviceProvisionUpdate builder.
+func (_u *DeviceProvisionUpdateOne) Where(ps ...predicate.DeviceProvision) *DeviceProvisionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DeviceProvisionUpdateOne) Select(field string, fields ...string) *DeviceProvisionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DeviceProvision entity.
+func (_u *DeviceProvisionUpdateOne) Save(ctx context.Context) (*DeviceProvision, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DeviceProvisionUpdateOne) SaveX(ctx context.Context) *DeviceProvision {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DeviceProvisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DeviceProvisionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *DeviceProvisionUpdateOne) sqlSave(ctx context.Context) (_node *DeviceProvision, err error) {
+	_spec := sqlgraph.NewUpdateSpec(deviceprovision.Table, deviceprovision.Columns, sqlgraph.NewFieldSpec(deviceprovision.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DeviceProvision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, deviceprovision.FieldID)
+		for _, f := range fields {
+			if !deviceprovision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != deviceprovision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.RemoteAddrCleared() {
+		_spec.ClearField(deviceprovision.FieldRemoteAddr, field.TypeString)
+	}
+	if _u.mutation.ClientVersionCleared() {
+		_spec.ClearField(deviceprovision.FieldClientVersion, field.TypeString)
+	}
+	if _u.mutation.OsCleared() {
+		_spec.ClearField(deviceprovision.FieldOs, field.TypeString)
+	}
+	if _u.mutation.ArchCleared() {
+		_spec.ClearField(deviceprovision.FieldArch, field.TypeString)
+	}
+	_node = &DeviceProvision{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{deviceprovision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,320 @@
+// Code generated by ent, DO NOT EDIT.
+
+package dnsrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedTime applies equality check predicate on the "created_time" field. It's identical to CreatedTimeEQ.
+func CreatedTime(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldCreatedTime, v))
+}
+
+// UpdatedTime applies equality check predicate on the "updated_time" field. It's identical to UpdatedTimeEQ.
+func UpdatedTime(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldUpdatedTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldName, v))
+}
+
+// Value applies equality check predicate on the "value" field. It's identical to ValueEQ.
+func Value(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldValue, v))
+}
+
+// CreatedTimeEQ applies the EQ predicate on the "created_time" field.
+func CreatedTimeEQ(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldCreatedTime, v))
+}
+
+// CreatedTimeNEQ applies the NEQ predicate on the "created_time" field.
+func CreatedTimeNEQ(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNEQ(FieldCreatedTime, v))
+}
+
+// CreatedTimeIn applies the In predicate on the "created_time" field.
+func CreatedTimeIn(vs ...time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldIn(FieldCreatedTime, vs...))
+}
+
+// CreatedTimeNotIn applies the NotIn predicate on the "created_time" field.
+func CreatedTimeNotIn(vs ...time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNotIn(FieldCreatedTime, vs...))
+}
+
+// CreatedTimeGT applies the GT predicate on the "created_time" field.
+func CreatedTimeGT(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGT(FieldCreatedTime, v))
+}
+
+// CreatedTimeGTE applies the GTE predicate on the "created_time" field.
+func CreatedTimeGTE(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGTE(FieldCreatedTime, v))
+}
+
+// CreatedTimeLT applies the LT predicate on the "created_time" field.
+func CreatedTimeLT(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLT(FieldCreatedTime, v))
+}
+
+// CreatedTimeLTE applies the LTE predicate on the "created_time" field.
+func CreatedTimeLTE(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLTE(FieldCreatedTime, v))
+}
+
+// UpdatedTimeEQ applies the EQ predicate on the "updated_time" field.
+func UpdatedTimeEQ(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeNEQ applies the NEQ predicate on the "updated_time" field.
+func UpdatedTimeNEQ(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNEQ(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeIn applies the In predicate on the "updated_time" field.
+func UpdatedTimeIn(vs ...time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldIn(FieldUpdatedTime, vs...))
+}
+
+// UpdatedTimeNotIn applies the NotIn predicate on the "updated_time" field.
+func UpdatedTimeNotIn(vs ...time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNotIn(FieldUpdatedTime, vs...))
+}
+
+// UpdatedTimeGT applies the GT predicate on the "updated_time" field.
+func UpdatedTimeGT(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGT(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeGTE applies the GTE predicate on the "updated_time" field.
+func UpdatedTimeGTE(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGTE(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeLT applies the LT predicate on the "updated_time" field.
+func UpdatedTimeLT(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLT(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeLTE applies the LTE predicate on the "updated_time" field.
+func UpdatedTimeLTE(v time.Time) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLTE(FieldUpdatedTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldContainsFold(FieldName, v))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNotIn(FieldType, vs...))
+}
+
+// ValueEQ applies the EQ predicate on the "value" field.
+func ValueEQ(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEQ(FieldValue, v))
+}
+
+// ValueNEQ applies the NEQ predicate on the "value" field.
+func ValueNEQ(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNEQ(FieldValue, v))
+}
+
+// ValueIn applies the In predicate on the "value" field.
+func ValueIn(vs ...string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldIn(FieldValue, vs...))
+}
+
+// ValueNotIn applies the NotIn predicate on the "value" field.
+func ValueNotIn(vs ...string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldNotIn(FieldValue, vs...))
+}
+
+// ValueGT applies the GT predicate on the "value" field.
+func ValueGT(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGT(FieldValue, v))
+}
+
+// ValueGTE applies the GTE predicate on the "value" field.
+func ValueGTE(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldGTE(FieldValue, v))
+}
+
+// ValueLT applies the LT predicate on the "value" field.
+func ValueLT(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLT(FieldValue, v))
+}
+
+// ValueLTE applies the LTE predicate on the "value" field.
+func ValueLTE(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldLTE(FieldValue, v))
+}
+
+// ValueContains applies the Contains predicate on the "value" field.
+func ValueContains(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldContains(FieldValue, v))
+}
+
+// ValueHasPrefix applies the HasPrefix predicate on the "value" field.
+func ValueHasPrefix(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldHasPrefix(FieldValue, v))
+}
+
+// ValueHasSuffix applies the HasSuffix predicate on the "value" field.
+func ValueHasSuffix(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldHasSuffix(FieldValue, v))
+}
+
+// ValueEqualFold applies the EqualFold predicate on the "value" field.
+func ValueEqualFold(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldEqualFold(FieldValue, v))
+}
+
+// ValueContainsFold applies the ContainsFold predicate on the "value" field.
+func ValueContainsFold(v string) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.FieldContainsFold(FieldValue, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.DNSRecord) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.DNSRecord) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.DNSRecord) predicate.DNSRecord {
+	return predicate.DNSRecord(sql.NotPredicates(p))
+}
@@ -0,0 +1,271 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+)
+
+// DNSRecordCreate is the builder for creating a DNSRecord entity.
+type DNSRecordCreate struct {
+	config
+	mutation *DNSRecordMutation
+	hooks    []Hook
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (_c *DNSRecordCreate) SetCreatedTime(v time.Time) *DNSRecordCreate {
+	_c.mutation.SetCreatedTime(v)
+	return _c
+}
+
+// SetNillableCreatedTime sets the "created_time" field if the given value is not nil.
+func (_c *DNSRecordCreate) SetNillableCreatedTime(v *time.Time) *DNSRecordCreate {
+	if v != nil {
+		_c.SetCreatedTime(*v)
+	}
+	return _c
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (_c *DNSRecordCreate) SetUpdatedTime(v time.Time) *DNSRecordCreate {
+	_c.mutation.SetUpdatedTime(v)
+	return _c
+}
+
+// SetNillableUpdatedTime sets the "updated_time" field if the given value is not nil.
+func (_c *DNSRecordCreate) SetNillableUpdatedTime(v *time.Time) *DNSRecordCreate {
+	if v != nil {
+		_c.SetUpdatedTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *DNSRecordCreate) SetName(v string) *DNSRecordCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *DNSRecordCreate) SetType(v dnsrecord.Type) *DNSRecordCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetValue sets the "value" field.
+func (_c *DNSRecordCreate) SetValue(v string) *DNSRecordCreate {
+	_c.mutation.SetValue(v)
+	return _c
+}
+
+// Mutation returns the DNSRecordMutation object of the builder.
+func (_c *DNSRecordCreate) Mutation() *DNSRecordMutation {
+	return _c.mutation
+}
+
+// Save creates the DNSRecord in the database.
+func (_c *DNSRecordCreate) Save(ctx context.Context) (*DNSRecord, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *DNSRecordCreate) SaveX(ctx context.Context) *DNSRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DNSRecordCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DNSRecordCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *DNSRecordCreate) defaults() {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		v := dnsrecord.DefaultCreatedTime()
+		_c.mutation.SetCreatedTime(v)
+	}
+	if _, ok := _c.mutation.UpdatedTime(); !ok {
+		v := dnsrecord.DefaultUpdatedTime()
+		_c.mutation.SetUpdatedTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *DNSRecordCreate) check() error {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		return &ValidationError{Name: "created_time", err: errors.New(`ent: missing required field "DNSRecord.created_time"`)}
+	}
+	if _, ok := _c.mutation.UpdatedTime(); !ok {
+		return &ValidationError{Name: "updated_time", err: errors.New(`ent: missing required field "DNSRecord.updated_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "DNSRecord.name"`)}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "DNSRecord.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := dnsrecord.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "DNSRecord.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Value(); !ok {
+		return &ValidationError{Name: "value", err: errors.New(`ent: missing required field "DNSRecord.value"`)}
+	}
+	return nil
+}
+
+func (_c *DNSRecordCreate) sqlSave(ctx context.Context) (*DNSRecord, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *DNSRecordCreate) createSpec() (*DNSRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &DNSRecord{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(dnsrecord.Table, sqlgraph.NewFieldSpec(dnsrecord.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedTime(); ok {
+		_spec.SetField(dnsrecord.FieldCreatedTime, field.TypeTime, value)
+		_node.CreatedTime = value
+	}
+	if value, ok := _c.mutation.UpdatedTime(); ok {
+		_spec.SetField(dnsrecord.FieldUpdatedTime, field.TypeTime, value)
+		_node.UpdatedTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(dnsrecord.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(dnsrecord.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Value(); ok {
+		_spec.SetField(dnsrecord.FieldValue, field.TypeString, value)
+		_node.Value = value
+	}
+	return _node, _spec
+}
+
+// DNSRecordCreateBulk is the builder for creating many DNSRecord entities in bulk.
+type DNSRecordCreateBulk struct {
+	config
+	err      error
+	builders []*DNSRecordCreate
+}
+
+// Save creates the DNSRecord entities in the database.
+func (_c *DNSRecordCreateBulk) Save(ctx context.Context) ([]*DNSRecord, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*DNSRecord, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*DNSRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *DNSRecordCreateBulk) SaveX(ctx context.Context) []*DNSRecord {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *DNSRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *DNSRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,340 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// DNSRecordUpdate is the builder for updating DNSRecord entities.
+type DNSRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *DNSRecordMutation
+}
+
+// Where appends a list predicates to the DNSRecordUpdate builder.
+func (_u *DNSRecordUpdate) Where(ps ...predicate.DNSRecord) *DNSRecordUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (_u *DNSRecordUpdate) SetUpdatedTime(v time.Time) *DNSRecordUpdate {
+	_u.mutation.SetUpdatedTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DNSRecordUpdate) SetName(v string) *DNSRecordUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DNSRecordUpdate) SetNillableName(v *string) *DNSRecordUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DNSRecordUpdate) SetType(v dnsrecord.Type) *DNSRecordUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DNSRecordUpdate) SetNillableType(v *dnsrecord.Type) *DNSRecordUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *DNSRecordUpdate) SetValue(v string) *DNSRecordUpdate {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *DNSRecordUpdate) SetNillableValue(v *string) *DNSRecordUpdate {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DNSRecordMutation object of the builder.
+func (_u *DNSRecordUpdate) Mutation() *DNSRecordMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *DNSRecordUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DNSRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *DNSRecordUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DNSRecordUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DNSRecordUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		v := dnsrecord.UpdateDefaultUpdatedTime()
+		_u.mutation.SetUpdatedTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DNSRecordUpdate) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := dnsrecord.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "DNSRecord.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DNSRecordUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dnsrecord.Table, dnsrecord.Columns, sqlgraph.NewFieldSpec(dnsrecord.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedTime(); ok {
+		_spec.SetField(dnsrecord.FieldUpdatedTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(dnsrecord.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(dnsrecord.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(dnsrecord.FieldValue, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dnsrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// DNSRecordUpdateOne is the builder for updating a single DNSRecord entity.
+type DNSRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *DNSRecordMutation
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (_u *DNSRecordUpdateOne) SetUpdatedTime(v time.Time) *DNSRecordUpdateOne {
+	_u.mutation.SetUpdatedTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *DNSRecordUpdateOne) SetName(v string) *DNSRecordUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *DNSRecordUpdateOne) SetNillableName(v *string) *DNSRecordUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *DNSRecordUpdateOne) SetType(v dnsrecord.Type) *DNSRecordUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *DNSRecordUpdateOne) SetNillableType(v *dnsrecord.Type) *DNSRecordUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetValue sets the "value" field.
+func (_u *DNSRecordUpdateOne) SetValue(v string) *DNSRecordUpdateOne {
+	_u.mutation.SetValue(v)
+	return _u
+}
+
+// SetNillableValue sets the "value" field if the given value is not nil.
+func (_u *DNSRecordUpdateOne) SetNillableValue(v *string) *DNSRecordUpdateOne {
+	if v != nil {
+		_u.SetValue(*v)
+	}
+	return _u
+}
+
+// Mutation returns the DNSRecordMutation object of the builder.
+func (_u *DNSRecordUpdateOne) Mutation() *DNSRecordMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the DNSRecordUpdate builder.
+func (_u *DNSRecordUpdateOne) Where(ps ...predicate.DNSRecord) *DNSRecordUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *DNSRecordUpdateOne) Select(field string, fields ...string) *DNSRecordUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated DNSRecord entity.
+func (_u *DNSRecordUpdateOne) Save(ctx context.Context) (*DNSRecord, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *DNSRecordUpdateOne) SaveX(ctx context.Context) *DNSRecord {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *DNSRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *DNSRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *DNSRecordUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		v := dnsrecord.UpdateDefaultUpdatedTime()
+		_u.mutation.SetUpdatedTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *DNSRecordUpdateOne) check() error {
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := dnsrecord.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "DNSRecord.type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *DNSRecordUpdateOne) sqlSave(ctx context.Context) (_node *DNSRecord, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(dnsrecord.Table, dnsrecord.Columns, sqlgraph.NewFieldSpec(dnsrecord.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "DNSRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, dnsrecord.FieldID)
+		for _, f := range fields {
+			if !dnsrecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != dnsrecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedTime(); ok {
+		_spec.SetField(dnsrecord.FieldUpdatedTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(dnsrecord.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(dnsrecord.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Value(); ok {
+		_spec.SetField(dnsrecord.FieldValue, field.TypeString, value)
+	}
+	_node = &DNSRecord{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{dnsrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,460 @@
+// Code generated by ent, DO NOT EDIT.
+
+package enrollmentkey
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldID, id))
+}
+
+// CreatedTime applies equality check predicate on the "created_time" field. It's identical to CreatedTimeEQ.
+func CreatedTime(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldCreatedTime, v))
+}
+
+// UpdatedTime applies equality check predicate on the "updated_time" field. It's identical to UpdatedTimeEQ.
+func UpdatedTime(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldUpdatedTime, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldName, v))
+}
+
+// Jti applies equality check predicate on the "jti" field. It's identical to JtiEQ.
+func Jti(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldJti, v))
+}
+
+// MaxUses applies equality check predicate on the "max_uses" field. It's identical to MaxUsesEQ.
+func MaxUses(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// Uses applies equality check predicate on the "uses" field. It's identical to UsesEQ.
+func Uses(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldUses, v))
+}
+
+// ExpiresTime applies equality check predicate on the "expires_time" field. It's identical to ExpiresTimeEQ.
+func ExpiresTime(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldExpiresTime, v))
+}
+
+// Revoked applies equality check predicate on the "revoked" field. It's identical to RevokedEQ.
+func Revoked(v bool) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldRevoked, v))
+}
+
+// CreatedTimeEQ applies the EQ predicate on the "created_time" field.
+func CreatedTimeEQ(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldCreatedTime, v))
+}
+
+// CreatedTimeNEQ applies the NEQ predicate on the "created_time" field.
+func CreatedTimeNEQ(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldCreatedTime, v))
+}
+
+// CreatedTimeIn applies the In predicate on the "created_time" field.
+func CreatedTimeIn(vs ...time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldCreatedTime, vs...))
+}
+
+// CreatedTimeNotIn applies the NotIn predicate on the "created_time" field.
+func CreatedTimeNotIn(vs ...time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldCreatedTime, vs...))
+}
+
+// CreatedTimeGT applies the GT predicate on the "created_time" field.
+func CreatedTimeGT(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldCreatedTime, v))
+}
+
+// CreatedTimeGTE applies the GTE predicate on the "created_time" field.
+func CreatedTimeGTE(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldCreatedTime, v))
+}
+
+// CreatedTimeLT applies the LT predicate on the "created_time" field.
+func CreatedTimeLT(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldCreatedTime, v))
+}
+
+// CreatedTimeLTE applies the LTE predicate on the "created_time" field.
+func CreatedTimeLTE(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldCreatedTime, v))
+}
+
+// UpdatedTimeEQ applies the EQ predicate on the "updated_time" field.
+func UpdatedTimeEQ(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeNEQ applies the NEQ predicate on the "updated_time" field.
+func UpdatedTimeNEQ(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeIn applies the In predicate on the "updated_time" field.
+func UpdatedTimeIn(vs ...time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldUpdatedTime, vs...))
+}
+
+// UpdatedTimeNotIn applies the NotIn predicate on the "updated_time" field.
+func UpdatedTimeNotIn(vs ...time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldUpdatedTime, vs...))
+}
+
+// UpdatedTimeGT applies the GT predicate on the "updated_time" field.
+func UpdatedTimeGT(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeGTE applies the GTE predicate on the "updated_time" field.
+func UpdatedTimeGTE(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeLT applies the LT predicate on the "updated_time" field.
+func UpdatedTimeLT(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldUpdatedTime, v))
+}
+
+// UpdatedTimeLTE applies the LTE predicate on the "updated_time" field.
+func UpdatedTimeLTE(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldUpdatedTime, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldContainsFold(FieldName, v))
+}
+
+// JtiEQ applies the EQ predicate on the "jti" field.
+func JtiEQ(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldJti, v))
+}
+
+// JtiNEQ applies the NEQ predicate on the "jti" field.
+func JtiNEQ(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldJti, v))
+}
+
+// JtiIn applies the In predicate on the "jti" field.
+func JtiIn(vs ...string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldJti, vs...))
+}
+
+// JtiNotIn applies the NotIn predicate on the "jti" field.
+func JtiNotIn(vs ...string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldJti, vs...))
+}
+
+// JtiGT applies the GT predicate on the "jti" field.
+func JtiGT(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldJti, v))
+}
+
+// JtiGTE applies the GTE predicate on the "jti" field.
+func JtiGTE(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldJti, v))
+}
+
+// JtiLT applies the LT predicate on the "jti" field.
+func JtiLT(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldJti, v))
+}
+
+// JtiLTE applies the LTE predicate on the "jti" field.
+func JtiLTE(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldJti, v))
+}
+
+// JtiContains applies the Contains predicate on the "jti" field.
+func JtiContains(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldContains(FieldJti, v))
+}
+
+// JtiHasPrefix applies the HasPrefix predicate on the "jti" field.
+func JtiHasPrefix(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldHasPrefix(FieldJti, v))
+}
+
+// JtiHasSuffix applies the HasSuffix predicate on the "jti" field.
+func JtiHasSuffix(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldHasSuffix(FieldJti, v))
+}
+
+// JtiEqualFold applies the EqualFold predicate on the "jti" field.
+func JtiEqualFold(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEqualFold(FieldJti, v))
+}
+
+// JtiContainsFold applies the ContainsFold predicate on the "jti" field.
+func JtiContainsFold(v string) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldContainsFold(FieldJti, v))
+}
+
+// GroupsIsNil applies the IsNil predicate on the "groups" field.
+func GroupsIsNil() predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIsNull(FieldGroups))
+}
+
+// GroupsNotNil applies the NotNil predicate on the "groups" field.
+func GroupsNotNil() predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotNull(FieldGroups))
+}
+
+// MaxUsesEQ applies the EQ predicate on the "max_uses" field.
+func MaxUsesEQ(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldMaxUses, v))
+}
+
+// MaxUsesNEQ applies the NEQ predicate on the "max_uses" field.
+func MaxUsesNEQ(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldMaxUses, v))
+}
+
+// MaxUsesIn applies the In predicate on the "max_uses" field.
+func MaxUsesIn(vs ...int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesNotIn applies the NotIn predicate on the "max_uses" field.
+func MaxUsesNotIn(vs ...int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldMaxUses, vs...))
+}
+
+// MaxUsesGT applies the GT predicate on the "max_uses" field.
+func MaxUsesGT(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldMaxUses, v))
+}
+
+// MaxUsesGTE applies the GTE predicate on the "max_uses" field.
+func MaxUsesGTE(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldMaxUses, v))
+}
+
+// MaxUsesLT applies the LT predicate on the "max_uses" field.
+func MaxUsesLT(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldMaxUses, v))
+}
+
+// MaxUsesLTE applies the LTE predicate on the "max_uses" field.
+func MaxUsesLTE(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldMaxUses, v))
+}
+
+// UsesEQ applies the EQ predicate on the "uses" field.
+func UsesEQ(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldUses, v))
+}
+
+// UsesNEQ applies the NEQ predicate on the "uses" field.
+func UsesNEQ(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldUses, v))
+}
+
+// UsesIn applies the In predicate on the "uses" field.
+func UsesIn(vs ...int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldUses, vs...))
+}
+
+// UsesNotIn applies the NotIn predicate on the "uses" field.
+func UsesNotIn(vs ...int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldUses, vs...))
+}
+
+// UsesGT applies the GT predicate on the "uses" field.
+func UsesGT(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldUses, v))
+}
+
+// UsesGTE applies the GTE predicate on the "uses" field.
+func UsesGTE(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldUses, v))
+}
+
+// UsesLT applies the LT predicate on the "uses" field.
+func UsesLT(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldUses, v))
+}
+
+// UsesLTE applies the LTE predicate on the "uses" field.
+func UsesLTE(v int) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldUses, v))
+}
+
+// ExpiresTimeEQ applies the EQ predicate on the "expires_time" field.
+func ExpiresTimeEQ(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldExpiresTime, v))
+}
+
+// ExpiresTimeNEQ applies the NEQ predicate on the "expires_time" field.
+func ExpiresTimeNEQ(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldExpiresTime, v))
+}
+
+// ExpiresTimeIn applies the In predicate on the "expires_time" field.
+func ExpiresTimeIn(vs ...time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldIn(FieldExpiresTime, vs...))
+}
+
+// ExpiresTimeNotIn applies the NotIn predicate on the "expires_time" field.
+func ExpiresTimeNotIn(vs ...time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNotIn(FieldExpiresTime, vs...))
+}
+
+// ExpiresTimeGT applies the GT predicate on the "expires_time" field.
+func ExpiresTimeGT(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGT(FieldExpiresTime, v))
+}
+
+// ExpiresTimeGTE applies the GTE predicate on the "expires_time" field.
+func ExpiresTimeGTE(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldGTE(FieldExpiresTime, v))
+}
+
+// ExpiresTimeLT applies the LT predicate on the "expires_time" field.
+func ExpiresTimeLT(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLT(FieldExpiresTime, v))
+}
+
+// ExpiresTimeLTE applies the LTE predicate on the "expires_time" field.
+func ExpiresTimeLTE(v time.Time) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldLTE(FieldExpiresTime, v))
+}
+
+// RevokedEQ applies the EQ predicate on the "revoked" field.
+func RevokedEQ(v bool) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldEQ(FieldRevoked, v))
+}
+
+// RevokedNEQ applies the NEQ predicate on the "revoked" field.
+func RevokedNEQ(v bool) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.FieldNEQ(FieldRevoked, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EnrollmentKey) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EnrollmentKey) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EnrollmentKey) predicate.EnrollmentKey {
+	return predicate.EnrollmentKey(sql.NotPredicates(p))
+}
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+)
+
+// EnrollmentKeyCreate is the builder for creating a EnrollmentKey entity.
+type EnrollmentKeyCreate struct {
+	config
+	mutation *EnrollmentKeyMutation
+	hooks    []Hook
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (_c *EnrollmentKeyCreate) SetCreatedTime(v time.Time) *EnrollmentKeyCreate {
+	_c.mutation.SetCreatedTime(v)
+	return _c
+}
+
+// SetNillableCreatedTime sets the "created_time" field if the given value is not nil.
+func (_c *EnrollmentKeyCreate) SetNillableCreatedTime(v *time.Time) *EnrollmentKeyCreate {
+	if v != nil {
+		_c.SetCreatedTime(*v)
+	}
+	return _c
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (_c *EnrollmentKeyCreate) SetUpdatedTime(v time.Time) *EnrollmentKeyCreate {
+	_c.mutation.SetUpdatedTime(v)
+	return _c
+}
+
+// SetNillableUpdatedTime sets the "updated_time" field if the given value is not nil.
+func (_c *EnrollmentKeyCreate) SetNillableUpdatedTime(v *time.Time) *EnrollmentKeyCreate {
+	if v != nil {
+		_c.SetUpdatedTime(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *EnrollmentKeyCreate) SetName(v string) *EnrollmentKeyCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetJti sets the "jti" field.
+func (_c *EnrollmentKeyCreate) SetJti(v string) *EnrollmentKeyCreate {
+	_c.mutation.SetJti(v)
+	return _c
+}
+
+// SetGroups sets the "groups" field.
+func (_c *EnrollmentKeyCreate) SetGroups(v []string) *EnrollmentKeyCreate {
+	_c.mutation.SetGroups(v)
+	return _c
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_c *EnrollmentKeyCreate) SetMaxUses(v int) *EnrollmentKeyCreate {
+	_c.mutation.SetMaxUses(v)
+	return _c
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_c *EnrollmentKeyCreate) SetNillableMaxUses(v *int) *EnrollmentKeyCreate {
+	if v != nil {
+		_c.SetMaxUses(*v)
+	}
+	return _c
+}
+
+// SetUses sets the "uses" field.
+func (_c *EnrollmentKeyCreate) SetUses(v int) *EnrollmentKeyCreate {
+	_c.mutation.SetUses(v)
+	return _c
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_c *EnrollmentKeyCreate) SetNillableUses(v *int) *EnrollmentKeyCreate {
+	if v != nil {
+		_c.SetUses(*v)
+	}
+	return _c
+}
+
+// SetExpiresTime sets the "expires_time" field.
+func (_c *EnrollmentKeyCreate) SetExpiresTime(v time.Time) *EnrollmentKeyCreate {
+	_c.mutation.SetExpiresTime(v)
+	return _c
+}
+
+// SetRevoked sets the "revoked" field.
+func (_c *EnrollmentKeyCreate) SetRevoked(v bool) *EnrollmentKeyCreate {
+	_c.mutation.SetRevoked(v)
+	return _c
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (_c *EnrollmentKeyCreate) SetNillableRevoked(v *bool) *EnrollmentKeyCreate {
+	if v != nil {
+		_c.SetRevoked(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EnrollmentKeyMutation object of the builder.
+func (_c *EnrollmentKeyCreate) Mutation() *EnrollmentKeyMutation {
+	return _c.mutation
+}
+
+// Save creates the EnrollmentKey in the database.
+func (_c *EnrollmentKeyCreate) Save(ctx context.Context) (*EnrollmentKey, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EnrollmentKeyCreate) SaveX(ctx context.Context) *EnrollmentKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentKeyCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentKeyCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EnrollmentKeyCreate) defaults() {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		v := enrollmentkey.DefaultCreatedTime()
+		_c.mutation.SetCreatedTime(v)
+	}
+	if _, ok := _c.mutation.UpdatedTime(); !ok {
+		v := enrollmentkey.DefaultUpdatedTime()
+		_c.mutation.SetUpdatedTime(v)
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		v := enrollmentkey.DefaultMaxUses
+		_c.mutation.SetMaxUses(v)
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		v := enrollmentkey.DefaultUses
+		_c.mutation.SetUses(v)
+	}
+	if _, ok := _c.mutation.Revoked(); !ok {
+		v := enrollmentkey.DefaultRevoked
+		_c.mutation.SetRevoked(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EnrollmentKeyCreate) check() error {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		return &ValidationError{Name: "created_time", err: errors.New(`ent: missing required field "EnrollmentKey.created_time"`)}
+	}
+	if _, ok := _c.mutation.UpdatedTime(); !ok {
+		return &ValidationError{Name: "updated_time", err: errors.New(`ent: missing required field "EnrollmentKey.updated_time"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "EnrollmentKey.name"`)}
+	}
+	if _, ok := _c.mutation.Jti(); !ok {
+		return &ValidationError{Name: "jti", err: errors.New(`ent: missing required field "EnrollmentKey.jti"`)}
+	}
+	if _, ok := _c.mutation.MaxUses(); !ok {
+		return &ValidationError{Name: "max_uses", err: errors.New(`ent: missing required field "EnrollmentKey.max_uses"`)}
+	}
+	if v, ok := _c.mutation.MaxUses(); ok {
+		if err := enrollmentkey.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "EnrollmentKey.max_uses": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Uses(); !ok {
+		return &ValidationError{Name: "uses", err: errors.New(`ent: missing required field "EnrollmentKey.uses"`)}
+	}
+	if v, ok := _c.mutation.Uses(); ok {
+		if err := enrollmentkey.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "EnrollmentKey.uses": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresTime(); !ok {
+		return &ValidationError{Name: "expires_time", err: errors.New(`ent: missing required field "EnrollmentKey.expires_time"`)}
+	}
+	if _, ok := _c.mutation.Revoked(); !ok {
+		return &ValidationError{Name: "revoked", err: errors.New(`ent: missing required field "EnrollmentKey.revoked"`)}
+	}
+	return nil
+}
+
+func (_c *EnrollmentKeyCreate) sqlSave(ctx context.Context) (*EnrollmentKey, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EnrollmentKeyCreate) createSpec() (*EnrollmentKey, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EnrollmentKey{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(enrollmentkey.Table, sqlgraph.NewFieldSpec(enrollmentkey.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedTime(); ok {
+		_spec.SetField(enrollmentkey.FieldCreatedTime, field.TypeTime, value)
+		_node.CreatedTime = value
+	}
+	if value, ok := _c.mutation.UpdatedTime(); ok {
+		_spec.SetField(enrollmentkey.FieldUpdatedTime, field.TypeTime, value)
+		_node.UpdatedTime = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(enrollmentkey.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Jti(); ok {
+		_spec.SetField(enrollmentkey.FieldJti, field.TypeString, value)
+		_node.Jti = value
+	}
+	if value, ok := _c.mutation.Groups(); ok {
+		_spec.SetField(enrollmentkey.FieldGroups, field.TypeJSON, value)
+		_node.Groups = value
+	}
+	if value, ok := _c.mutation.MaxUses(); ok {
+		_spec.SetField(enrollmentkey.FieldMaxUses, field.TypeInt, value)
+		_node.MaxUses = value
+	}
+	if value, ok := _c.mutation.Uses(); ok {
+		_spec.SetField(enrollmentkey.FieldUses, field.TypeInt, value)
+		_node.Uses = value
+	}
+	if value, ok := _c.mutation.ExpiresTime(); ok {
+		_spec.SetField(enrollmentkey.FieldExpiresTime, field.TypeTime, value)
+		_node.ExpiresTime = value
+	}
+	if value, ok := _c.mutation.Revoked(); ok {
+		_spec.SetField(enrollmentkey.FieldRevoked, field.TypeBool, value)
+		_node.Revoked = value
+	}
+	return _node, _spec
+}
+
+// EnrollmentKeyCreateBulk is the builder for creating many EnrollmentKey entities in bulk.
+type EnrollmentKeyCreateBulk struct {
+	config
+	err      error
+	builders []*EnrollmentKeyCreate
+}
+
+// Save creates the EnrollmentKey entities in the database.
+func (_c *EnrollmentKeyCreateBulk) Save(ctx context.Context) ([]*EnrollmentKey, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EnrollmentKey, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EnrollmentKeyMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EnrollmentKeyCreateBulk) SaveX(ctx context.Context) []*EnrollmentKey {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EnrollmentKeyCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EnrollmentKeyCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
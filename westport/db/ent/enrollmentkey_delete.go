@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// EnrollmentKeyDelete is the builder for deleting a EnrollmentKey entity.
+type EnrollmentKeyDelete struct {
+	config
+	hooks    []Hook
+	mutation *EnrollmentKeyMutation
+}
+
+// Where appends a list predicates to the EnrollmentKeyDelete builder.
+func (_d *EnrollmentKeyDelete) Where(ps ...predicate.EnrollmentKey) *EnrollmentKeyDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *EnrollmentKeyDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EnrollmentKeyDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *EnrollmentKeyDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(enrollmentkey.Table, sqlgraph.NewFieldSpec(enrollmentkey.FieldID, field.TypeInt))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// EnrollmentKeyDeleteOne is the builder for deleting a single EnrollmentKey entity.
+type EnrollmentKeyDeleteOne struct {
+	_d *EnrollmentKeyDelete
+}
+
+// Where appends a list predicates to the EnrollmentKeyDelete builder.
+func (_d *EnrollmentKeyDeleteOne) Where(ps ...predicate.EnrollmentKey) *EnrollmentKeyDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *EnrollmentKeyDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{enrollmentkey.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *EnrollmentKeyDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
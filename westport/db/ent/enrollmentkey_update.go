@@ -0,0 +1,483 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/ent/predicate"
+)
+
+// EnrollmentKeyUpdate is the builder for updating EnrollmentKey entities.
+type EnrollmentKeyUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EnrollmentKeyMutation
+}
+
+// Where appends a list predicates to the EnrollmentKeyUpdate builder.
+func (_u *EnrollmentKeyUpdate) Where(ps ...predicate.EnrollmentKey) *EnrollmentKeyUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (_u *EnrollmentKeyUpdate) SetUpdatedTime(v time.Time) *EnrollmentKeyUpdate {
+	_u.mutation.SetUpdatedTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EnrollmentKeyUpdate) SetName(v string) *EnrollmentKeyUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdate) SetNillableName(v *string) *EnrollmentKeyUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetGroups sets the "groups" field.
+func (_u *EnrollmentKeyUpdate) SetGroups(v []string) *EnrollmentKeyUpdate {
+	_u.mutation.SetGroups(v)
+	return _u
+}
+
+// AppendGroups appends value to the "groups" field.
+func (_u *EnrollmentKeyUpdate) AppendGroups(v []string) *EnrollmentKeyUpdate {
+	_u.mutation.AppendGroups(v)
+	return _u
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (_u *EnrollmentKeyUpdate) ClearGroups() *EnrollmentKeyUpdate {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *EnrollmentKeyUpdate) SetMaxUses(v int) *EnrollmentKeyUpdate {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdate) SetNillableMaxUses(v *int) *EnrollmentKeyUpdate {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *EnrollmentKeyUpdate) AddMaxUses(v int) *EnrollmentKeyUpdate {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *EnrollmentKeyUpdate) SetUses(v int) *EnrollmentKeyUpdate {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdate) SetNillableUses(v *int) *EnrollmentKeyUpdate {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *EnrollmentKeyUpdate) AddUses(v int) *EnrollmentKeyUpdate {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetRevoked sets the "revoked" field.
+func (_u *EnrollmentKeyUpdate) SetRevoked(v bool) *EnrollmentKeyUpdate {
+	_u.mutation.SetRevoked(v)
+	return _u
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdate) SetNillableRevoked(v *bool) *EnrollmentKeyUpdate {
+	if v != nil {
+		_u.SetRevoked(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EnrollmentKeyMutation object of the builder.
+func (_u *EnrollmentKeyUpdate) Mutation() *EnrollmentKeyMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EnrollmentKeyUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentKeyUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EnrollmentKeyUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentKeyUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnrollmentKeyUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		v := enrollmentkey.UpdateDefaultUpdatedTime()
+		_u.mutation.SetUpdatedTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentKeyUpdate) check() error {
+	if v, ok := _u.mutation.MaxUses(); ok {
+		if err := enrollmentkey.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "EnrollmentKey.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Uses(); ok {
+		if err := enrollmentkey.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "EnrollmentKey.uses": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EnrollmentKeyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmentkey.Table, enrollmentkey.Columns, sqlgraph.NewFieldSpec(enrollmentkey.FieldID, field.TypeInt))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedTime(); ok {
+		_spec.SetField(enrollmentkey.FieldUpdatedTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(enrollmentkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Groups(); ok {
+		_spec.SetField(enrollmentkey.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, enrollmentkey.FieldGroups, value)
+		})
+	}
+	if _u.mutation.GroupsCleared() {
+		_spec.ClearField(enrollmentkey.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(enrollmentkey.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(enrollmentkey.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(enrollmentkey.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(enrollmentkey.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Revoked(); ok {
+		_spec.SetField(enrollmentkey.FieldRevoked, field.TypeBool, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmentkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EnrollmentKeyUpdateOne is the builder for updating a single EnrollmentKey entity.
+type EnrollmentKeyUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EnrollmentKeyMutation
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (_u *EnrollmentKeyUpdateOne) SetUpdatedTime(v time.Time) *EnrollmentKeyUpdateOne {
+	_u.mutation.SetUpdatedTime(v)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *EnrollmentKeyUpdateOne) SetName(v string) *EnrollmentKeyUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdateOne) SetNillableName(v *string) *EnrollmentKeyUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetGroups sets the "groups" field.
+func (_u *EnrollmentKeyUpdateOne) SetGroups(v []string) *EnrollmentKeyUpdateOne {
+	_u.mutation.SetGroups(v)
+	return _u
+}
+
+// AppendGroups appends value to the "groups" field.
+func (_u *EnrollmentKeyUpdateOne) AppendGroups(v []string) *EnrollmentKeyUpdateOne {
+	_u.mutation.AppendGroups(v)
+	return _u
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (_u *EnrollmentKeyUpdateOne) ClearGroups() *EnrollmentKeyUpdateOne {
+	_u.mutation.ClearGroups()
+	return _u
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (_u *EnrollmentKeyUpdateOne) SetMaxUses(v int) *EnrollmentKeyUpdateOne {
+	_u.mutation.ResetMaxUses()
+	_u.mutation.SetMaxUses(v)
+	return _u
+}
+
+// SetNillableMaxUses sets the "max_uses" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdateOne) SetNillableMaxUses(v *int) *EnrollmentKeyUpdateOne {
+	if v != nil {
+		_u.SetMaxUses(*v)
+	}
+	return _u
+}
+
+// AddMaxUses adds value to the "max_uses" field.
+func (_u *EnrollmentKeyUpdateOne) AddMaxUses(v int) *EnrollmentKeyUpdateOne {
+	_u.mutation.AddMaxUses(v)
+	return _u
+}
+
+// SetUses sets the "uses" field.
+func (_u *EnrollmentKeyUpdateOne) SetUses(v int) *EnrollmentKeyUpdateOne {
+	_u.mutation.ResetUses()
+	_u.mutation.SetUses(v)
+	return _u
+}
+
+// SetNillableUses sets the "uses" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdateOne) SetNillableUses(v *int) *EnrollmentKeyUpdateOne {
+	if v != nil {
+		_u.SetUses(*v)
+	}
+	return _u
+}
+
+// AddUses adds value to the "uses" field.
+func (_u *EnrollmentKeyUpdateOne) AddUses(v int) *EnrollmentKeyUpdateOne {
+	_u.mutation.AddUses(v)
+	return _u
+}
+
+// SetRevoked sets the "revoked" field.
+func (_u *EnrollmentKeyUpdateOne) SetRevoked(v bool) *EnrollmentKeyUpdateOne {
+	_u.mutation.SetRevoked(v)
+	return _u
+}
+
+// SetNillableRevoked sets the "revoked" field if the given value is not nil.
+func (_u *EnrollmentKeyUpdateOne) SetNillableRevoked(v *bool) *EnrollmentKeyUpdateOne {
+	if v != nil {
+		_u.SetRevoked(*v)
+	}
+	return _u
+}
+
+// Mutation returns the EnrollmentKeyMutation object of the builder.
+func (_u *EnrollmentKeyUpdateOne) Mutation() *EnrollmentKeyMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EnrollmentKeyUpdate builder.
+func (_u *EnrollmentKeyUpdateOne) Where(ps ...predicate.EnrollmentKey) *EnrollmentKeyUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EnrollmentKeyUpdateOne) Select(field string, fields ...string) *EnrollmentKeyUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EnrollmentKey entity.
+func (_u *EnrollmentKeyUpdateOne) Save(ctx context.Context) (*EnrollmentKey, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EnrollmentKeyUpdateOne) SaveX(ctx context.Context) *EnrollmentKey {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EnrollmentKeyUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EnrollmentKeyUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EnrollmentKeyUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		v := enrollmentkey.UpdateDefaultUpdatedTime()
+		_u.mutation.SetUpdatedTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EnrollmentKeyUpdateOne) check() error {
+	if v, ok := _u.mutation.MaxUses(); ok {
+		if err := enrollmentkey.MaxUsesValidator(v); err != nil {
+			return &ValidationError{Name: "max_uses", err: fmt.Errorf(`ent: validator failed for field "EnrollmentKey.max_uses": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Uses(); ok {
+		if err := enrollmentkey.UsesValidator(v); err != nil {
+			return &ValidationError{Name: "uses", err: fmt.Errorf(`ent: validator failed for field "EnrollmentKey.uses": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *EnrollmentKeyUpdateOne) sqlSave(ctx context.Context) (_node *EnrollmentKey, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(enrollmentkey.Table, enrollmentkey.Columns, sqlgraph.NewFieldSpec(enrollmentkey.FieldID, field.TypeInt))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EnrollmentKey.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, enrollmentkey.FieldID)
+		for _, f := range fields {
+			if !enrollmentkey.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != enrollmentkey.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UpdatedTime(); ok {
+		_spec.SetField(enrollmentkey.FieldUpdatedTime, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(enrollmentkey.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Groups(); ok {
+		_spec.SetField(enrollmentkey.FieldGroups, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedGroups(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, enrollmentkey.FieldGroups, value)
+		})
+	}
+	if _u.mutation.GroupsCleared() {
+		_spec.ClearField(enrollmentkey.FieldGroups, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.MaxUses(); ok {
+		_spec.SetField(enrollmentkey.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxUses(); ok {
+		_spec.AddField(enrollmentkey.FieldMaxUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Uses(); ok {
+		_spec.SetField(enrollmentkey.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedUses(); ok {
+		_spec.AddField(enrollmentkey.FieldUses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Revoked(); ok {
+		_spec.SetField(enrollmentkey.FieldRevoked, field.TypeBool, value)
+	}
+	_node = &EnrollmentKey{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{enrollmentkey.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -12,7 +12,12 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/sprisa/west/westport/db/ent/auditevent"
 	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
 	"github.com/sprisa/west/westport/db/ent/settings"
 )
 
@@ -74,8 +79,13 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			device.Table:   device.ValidColumn,
-			settings.Table: settings.ValidColumn,
+			auditevent.Table:      auditevent.ValidColumn,
+			dnsrecord.Table:       dnsrecord.ValidColumn,
+			device.Table:          device.ValidColumn,
+			deviceprovision.Table: deviceprovision.ValidColumn,
+			enrollmentkey.Table:   enrollmentkey.ValidColumn,
+			revokedtoken.Table:    revokedtoken.ValidColumn,
+			settings.Table:        settings.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -3,7 +3,12 @@
 package ent
 
 import (
+	"github.com/sprisa/west/westport/db/ent/auditevent"
 	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
 	"github.com/sprisa/west/westport/db/ent/settings"
 
 	"entgo.io/ent/dialect/sql"
@@ -14,8 +19,48 @@ import (
 
 // schemaGraph holds a representation of ent/schema at runtime.
 var schemaGraph = func() *sqlgraph.Schema {
-	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 2)}
+	graph := &sqlgraph.Schema{Nodes: make([]*sqlgraph.Node, 7)}
 	graph.Nodes[0] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   auditevent.Table,
+			Columns: auditevent.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: auditevent.FieldID,
+			},
+		},
+		Type: "AuditEvent",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			auditevent.FieldCreatedTime: {Type: field.TypeTime, Column: auditevent.FieldCreatedTime},
+			auditevent.FieldAction:      {Type: field.TypeString, Column: auditevent.FieldAction},
+			auditevent.FieldActor:       {Type: field.TypeString, Column: auditevent.FieldActor},
+			auditevent.FieldRemoteAddr:  {Type: field.TypeString, Column: auditevent.FieldRemoteAddr},
+			auditevent.FieldDeviceID:    {Type: field.TypeInt, Column: auditevent.FieldDeviceID},
+			auditevent.FieldDeviceName:  {Type: field.TypeString, Column: auditevent.FieldDeviceName},
+			auditevent.FieldSuccess:     {Type: field.TypeBool, Column: auditevent.FieldSuccess},
+			auditevent.FieldError:       {Type: field.TypeString, Column: auditevent.FieldError},
+			auditevent.FieldDetails:     {Type: field.TypeJSON, Column: auditevent.FieldDetails},
+		},
+	}
+	graph.Nodes[1] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   dnsrecord.Table,
+			Columns: dnsrecord.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: dnsrecord.FieldID,
+			},
+		},
+		Type: "DNSRecord",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			dnsrecord.FieldCreatedTime: {Type: field.TypeTime, Column: dnsrecord.FieldCreatedTime},
+			dnsrecord.FieldUpdatedTime: {Type: field.TypeTime, Column: dnsrecord.FieldUpdatedTime},
+			dnsrecord.FieldName:        {Type: field.TypeString, Column: dnsrecord.FieldName},
+			dnsrecord.FieldType:        {Type: field.TypeEnum, Column: dnsrecord.FieldType},
+			dnsrecord.FieldValue:       {Type: field.TypeString, Column: dnsrecord.FieldValue},
+		},
+	}
+	graph.Nodes[2] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   device.Table,
 			Columns: device.Columns,
@@ -32,9 +77,82 @@ var schemaGraph = func() *sqlgraph.Schema {
 			device.FieldIP:                {Type: field.TypeUint32, Column: device.FieldIP},
 			device.FieldLeasedAccessToken: {Type: field.TypeString, Column: device.FieldLeasedAccessToken},
 			device.FieldToken:             {Type: field.TypeBytes, Column: device.FieldToken},
+			device.FieldSingleUseToken:    {Type: field.TypeBool, Column: device.FieldSingleUseToken},
+			device.FieldGroups:            {Type: field.TypeJSON, Column: device.FieldGroups},
+			device.FieldAdvertiseRoutes:   {Type: field.TypeJSON, Column: device.FieldAdvertiseRoutes},
+			device.FieldExitNode:          {Type: field.TypeBool, Column: device.FieldExitNode},
+			device.FieldRelay:             {Type: field.TypeBool, Column: device.FieldRelay},
+			device.FieldLighthouse:        {Type: field.TypeBool, Column: device.FieldLighthouse},
+			device.FieldPublicEndpoint:    {Type: field.TypeString, Column: device.FieldPublicEndpoint},
+			device.FieldLastProvisionTime: {Type: field.TypeTime, Column: device.FieldLastProvisionTime},
+			device.FieldLastSeenTime:      {Type: field.TypeTime, Column: device.FieldLastSeenTime},
+			device.FieldUnderlayAddr:      {Type: field.TypeString, Column: device.FieldUnderlayAddr},
+			device.FieldClientVersion:     {Type: field.TypeString, Column: device.FieldClientVersion},
+			device.FieldOs:                {Type: field.TypeString, Column: device.FieldOs},
+			device.FieldArch:              {Type: field.TypeString, Column: device.FieldArch},
 		},
 	}
-	graph.Nodes[1] = &sqlgraph.Node{
+	graph.Nodes[3] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   deviceprovision.Table,
+			Columns: deviceprovision.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: deviceprovision.FieldID,
+			},
+		},
+		Type: "DeviceProvision",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			deviceprovision.FieldCreatedTime:   {Type: field.TypeTime, Column: deviceprovision.FieldCreatedTime},
+			deviceprovision.FieldDeviceID:      {Type: field.TypeInt, Column: deviceprovision.FieldDeviceID},
+			deviceprovision.FieldRemoteAddr:    {Type: field.TypeString, Column: deviceprovision.FieldRemoteAddr},
+			deviceprovision.FieldClientVersion: {Type: field.TypeString, Column: deviceprovision.FieldClientVersion},
+			deviceprovision.FieldOs:            {Type: field.TypeString, Column: deviceprovision.FieldOs},
+			deviceprovision.FieldArch:          {Type: field.TypeString, Column: deviceprovision.FieldArch},
+			deviceprovision.FieldClientKey:     {Type: field.TypeBool, Column: deviceprovision.FieldClientKey},
+		},
+	}
+	graph.Nodes[4] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   enrollmentkey.Table,
+			Columns: enrollmentkey.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: enrollmentkey.FieldID,
+			},
+		},
+		Type: "EnrollmentKey",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			enrollmentkey.FieldCreatedTime: {Type: field.TypeTime, Column: enrollmentkey.FieldCreatedTime},
+			enrollmentkey.FieldUpdatedTime: {Type: field.TypeTime, Column: enrollmentkey.FieldUpdatedTime},
+			enrollmentkey.FieldName:        {Type: field.TypeString, Column: enrollmentkey.FieldName},
+			enrollmentkey.FieldJti:         {Type: field.TypeString, Column: enrollmentkey.FieldJti},
+			enrollmentkey.FieldGroups:      {Type: field.TypeJSON, Column: enrollmentkey.FieldGroups},
+			enrollmentkey.FieldMaxUses:     {Type: field.TypeInt, Column: enrollmentkey.FieldMaxUses},
+			enrollmentkey.FieldUses:        {Type: field.TypeInt, Column: enrollmentkey.FieldUses},
+			enrollmentkey.FieldExpiresTime: {Type: field.TypeTime, Column: enrollmentkey.FieldExpiresTime},
+			enrollmentkey.FieldRevoked:     {Type: field.TypeBool, Column: enrollmentkey.FieldRevoked},
+		},
+	}
+	graph.Nodes[5] = &sqlgraph.Node{
+		NodeSpec: sqlgraph.NodeSpec{
+			Table:   revokedtoken.Table,
+			Columns: revokedtoken.Columns,
+			ID: &sqlgraph.FieldSpec{
+				Type:   field.TypeInt,
+				Column: revokedtoken.FieldID,
+			},
+		},
+		Type: "RevokedToken",
+		Fields: map[string]*sqlgraph.FieldSpec{
+			revokedtoken.FieldCreatedTime: {Type: field.TypeTime, Column: revokedtoken.FieldCreatedTime},
+			revokedtoken.FieldJti:         {Type: field.TypeString, Column: revokedtoken.FieldJti},
+			revokedtoken.FieldDeviceID:    {Type: field.TypeInt, Column: revokedtoken.FieldDeviceID},
+			revokedtoken.FieldDeviceName:  {Type: field.TypeString, Column: revokedtoken.FieldDeviceName},
+			revokedtoken.FieldExpiresTime: {Type: field.TypeTime, Column: revokedtoken.FieldExpiresTime},
+		},
+	}
+	graph.Nodes[6] = &sqlgraph.Node{
 		NodeSpec: sqlgraph.NodeSpec{
 			Table:   settings.Table,
 			Columns: settings.Columns,
@@ -55,6 +173,15 @@ var schemaGraph = func() *sqlgraph.Schema {
 			settings.FieldLighthouseKey:           {Type: field.TypeBytes, Column: settings.FieldLighthouseKey},
 			settings.FieldCidr:                    {Type: field.TypeString, Column: settings.FieldCidr},
 			settings.FieldPortOverlayIP:           {Type: field.TypeUint32, Column: settings.FieldPortOverlayIP},
+			settings.FieldPortRelay:               {Type: field.TypeBool, Column: settings.FieldPortRelay},
+			settings.FieldNebulaPort:              {Type: field.TypeInt, Column: settings.FieldNebulaPort},
+			settings.FieldHTTPPort:                {Type: field.TypeInt, Column: settings.FieldHTTPPort},
+			settings.FieldHTTPSPort:               {Type: field.TypeInt, Column: settings.FieldHTTPSPort},
+			settings.FieldPublicHost:              {Type: field.TypeString, Column: settings.FieldPublicHost},
+			settings.FieldPublicNebulaPort:        {Type: field.TypeInt, Column: settings.FieldPublicNebulaPort},
+			settings.FieldPublicAPIPort:           {Type: field.TypeInt, Column: settings.FieldPublicAPIPort},
+			settings.FieldRequireClientKeys:       {Type: field.TypeBool, Column: settings.FieldRequireClientKeys},
+			settings.FieldFirewallInbound:         {Type: field.TypeJSON, Column: settings.FieldFirewallInbound},
 			settings.FieldLetsencryptRegistration: {Type: field.TypeBytes, Column: settings.FieldLetsencryptRegistration},
 			settings.FieldTLSCert:                 {Type: field.TypeBytes, Column: settings.FieldTLSCert},
 			settings.FieldTLSCertKey:              {Type: field.TypeBytes, Column: settings.FieldTLSCertKey},
@@ -69,6 +196,156 @@ type predicateAdder interface {
 	addPredicate(func(s *sql.Selector))
 }
 
+// addPredicate implements the predicateAdder interface.
+func (_q *AuditEventQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the AuditEventQuery builder.
+func (_q *AuditEventQuery) Filter() *AuditEventFilter {
+	return &AuditEventFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *AuditEventMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the AuditEventMutation builder.
+func (m *AuditEventMutation) Filter() *AuditEventFilter {
+	return &AuditEventFilter{config: m.config, predicateAdder: m}
+}
+
+// AuditEventFilter provides a generic filtering capability at runtime for AuditEventQuery.
+type AuditEventFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *AuditEventFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *AuditEventFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(auditevent.FieldID))
+}
+
+// WhereCreatedTime applies the entql time.Time predicate on the created_time field.
+func (f *AuditEventFilter) WhereCreatedTime(p entql.TimeP) {
+	f.Where(p.Field(auditevent.FieldCreatedTime))
+}
+
+// WhereAction applies the entql string predicate on the action field.
+func (f *AuditEventFilter) WhereAction(p entql.StringP) {
+	f.Where(p.Field(auditevent.FieldAction))
+}
+
+// WhereActor applies the entql string predicate on the actor field.
+func (f *AuditEventFilter) WhereActor(p entql.StringP) {
+	f.Where(p.Field(auditevent.FieldActor))
+}
+
+// WhereRemoteAddr applies the entql string predicate on the remote_addr field.
+func (f *AuditEventFilter) WhereRemoteAddr(p entql.StringP) {
+	f.Where(p.Field(auditevent.FieldRemoteAddr))
+}
+
+// WhereDeviceID applies the entql int predicate on the device_id field.
+func (f *AuditEventFilter) WhereDeviceID(p entql.IntP) {
+	f.Where(p.Field(auditevent.FieldDeviceID))
+}
+
+// WhereDeviceName applies the entql string predicate on the device_name field.
+func (f *AuditEventFilter) WhereDeviceName(p entql.StringP) {
+	f.Where(p.Field(auditevent.FieldDeviceName))
+}
+
+// WhereSuccess applies the entql bool predicate on the success field.
+func (f *AuditEventFilter) WhereSuccess(p entql.BoolP) {
+	f.Where(p.Field(auditevent.FieldSuccess))
+}
+
+// WhereError applies the entql string predicate on the error field.
+func (f *AuditEventFilter) WhereError(p entql.StringP) {
+	f.Where(p.Field(auditevent.FieldError))
+}
+
+// WhereDetails applies the entql json.RawMessage predicate on the details field.
+func (f *AuditEventFilter) WhereDetails(p entql.BytesP) {
+	f.Where(p.Field(auditevent.FieldDetails))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *DNSRecordQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DNSRecordQuery builder.
+func (_q *DNSRecordQuery) Filter() *DNSRecordFilter {
+	return &DNSRecordFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DNSRecordMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DNSRecordMutation builder.
+func (m *DNSRecordMutation) Filter() *DNSRecordFilter {
+	return &DNSRecordFilter{config: m.config, predicateAdder: m}
+}
+
+// DNSRecordFilter provides a generic filtering capability at runtime for DNSRecordQuery.
+type DNSRecordFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DNSRecordFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DNSRecordFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(dnsrecord.FieldID))
+}
+
+// WhereCreatedTime applies the entql time.Time predicate on the created_time field.
+func (f *DNSRecordFilter) WhereCreatedTime(p entql.TimeP) {
+	f.Where(p.Field(dnsrecord.FieldCreatedTime))
+}
+
+// WhereUpdatedTime applies the entql time.Time predicate on the updated_time field.
+func (f *DNSRecordFilter) WhereUpdatedTime(p entql.TimeP) {
+	f.Where(p.Field(dnsrecord.FieldUpdatedTime))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *DNSRecordFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(dnsrecord.FieldName))
+}
+
+// WhereType applies the entql string predicate on the type field.
+func (f *DNSRecordFilter) WhereType(p entql.StringP) {
+	f.Where(p.Field(dnsrecord.FieldType))
+}
+
+// WhereValue applies the entql string predicate on the value field.
+func (f *DNSRecordFilter) WhereValue(p entql.StringP) {
+	f.Where(p.Field(dnsrecord.FieldValue))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *DeviceQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -98,7 +375,7 @@ type DeviceFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *DeviceFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[0].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[2].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -139,6 +416,296 @@ func (f *DeviceFilter) WhereToken(p entql.BytesP) {
 	f.Where(p.Field(device.FieldToken))
 }
 
+// WhereSingleUseToken applies the entql bool predicate on the single_use_token field.
+func (f *DeviceFilter) WhereSingleUseToken(p entql.BoolP) {
+	f.Where(p.Field(device.FieldSingleUseToken))
+}
+
+// WhereGroups applies the entql json.RawMessage predicate on the groups field.
+func (f *DeviceFilter) WhereGroups(p entql.BytesP) {
+	f.Where(p.Field(device.FieldGroups))
+}
+
+// WhereAdvertiseRoutes applies the entql json.RawMessage predicate on the advertise_routes field.
+func (f *DeviceFilter) WhereAdvertiseRoutes(p entql.BytesP) {
+	f.Where(p.Field(device.FieldAdvertiseRoutes))
+}
+
+// WhereExitNode applies the entql bool predicate on the exit_node field.
+func (f *DeviceFilter) WhereExitNode(p entql.BoolP) {
+	f.Where(p.Field(device.FieldExitNode))
+}
+
+// WhereRelay applies the entql bool predicate on the relay field.
+func (f *DeviceFilter) WhereRelay(p entql.BoolP) {
+	f.Where(p.Field(device.FieldRelay))
+}
+
+// WhereLighthouse applies the entql bool predicate on the lighthouse field.
+func (f *DeviceFilter) WhereLighthouse(p entql.BoolP) {
+	f.Where(p.Field(device.FieldLighthouse))
+}
+
+// WherePublicEndpoint applies the entql string predicate on the public_endpoint field.
+func (f *DeviceFilter) WherePublicEndpoint(p entql.StringP) {
+	f.Where(p.Field(device.FieldPublicEndpoint))
+}
+
+// WhereLastProvisionTime applies the entql time.Time predicate on the last_provision_time field.
+func (f *DeviceFilter) WhereLastProvisionTime(p entql.TimeP) {
+	f.Where(p.Field(device.FieldLastProvisionTime))
+}
+
+// WhereLastSeenTime applies the entql time.Time predicate on the last_seen_time field.
+func (f *DeviceFilter) WhereLastSeenTime(p entql.TimeP) {
+	f.Where(p.Field(device.FieldLastSeenTime))
+}
+
+// WhereUnderlayAddr applies the entql string predicate on the underlay_addr field.
+func (f *DeviceFilter) WhereUnderlayAddr(p entql.StringP) {
+	f.Where(p.Field(device.FieldUnderlayAddr))
+}
+
+// WhereClientVersion applies the entql string predicate on the client_version field.
+func (f *DeviceFilter) WhereClientVersion(p entql.StringP) {
+	f.Where(p.Field(device.FieldClientVersion))
+}
+
+// WhereOs applies the entql string predicate on the os field.
+func (f *DeviceFilter) WhereOs(p entql.StringP) {
+	f.Where(p.Field(device.FieldOs))
+}
+
+// WhereArch applies the entql string predicate on the arch field.
+func (f *DeviceFilter) WhereArch(p entql.StringP) {
+	f.Where(p.Field(device.FieldArch))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *DeviceProvisionQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the DeviceProvisionQuery builder.
+func (_q *DeviceProvisionQuery) Filter() *DeviceProvisionFilter {
+	return &DeviceProvisionFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *DeviceProvisionMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the DeviceProvisionMutation builder.
+func (m *DeviceProvisionMutation) Filter() *DeviceProvisionFilter {
+	return &DeviceProvisionFilter{config: m.config, predicateAdder: m}
+}
+
+// DeviceProvisionFilter provides a generic filtering capability at runtime for DeviceProvisionQuery.
+type DeviceProvisionFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *DeviceProvisionFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[3].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *DeviceProvisionFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(deviceprovision.FieldID))
+}
+
+// WhereCreatedTime applies the entql time.Time predicate on the created_time field.
+func (f *DeviceProvisionFilter) WhereCreatedTime(p entql.TimeP) {
+	f.Where(p.Field(deviceprovision.FieldCreatedTime))
+}
+
+// WhereDeviceID applies the entql int predicate on the device_id field.
+func (f *DeviceProvisionFilter) WhereDeviceID(p entql.IntP) {
+	f.Where(p.Field(deviceprovision.FieldDeviceID))
+}
+
+// WhereRemoteAddr applies the entql string predicate on the remote_addr field.
+func (f *DeviceProvisionFilter) WhereRemoteAddr(p entql.StringP) {
+	f.Where(p.Field(deviceprovision.FieldRemoteAddr))
+}
+
+// WhereClientVersion applies the entql string predicate on the client_version field.
+func (f *DeviceProvisionFilter) WhereClientVersion(p entql.StringP) {
+	f.Where(p.Field(deviceprovision.FieldClientVersion))
+}
+
+// WhereOs applies the entql string predicate on the os field.
+func (f *DeviceProvisionFilter) WhereOs(p entql.StringP) {
+	f.Where(p.Field(deviceprovision.FieldOs))
+}
+
+// WhereArch applies the entql string predicate on the arch field.
+func (f *DeviceProvisionFilter) WhereArch(p entql.StringP) {
+	f.Where(p.Field(deviceprovision.FieldArch))
+}
+
+// WhereClientKey applies the entql bool predicate on the client_key field.
+func (f *DeviceProvisionFilter) WhereClientKey(p entql.BoolP) {
+	f.Where(p.Field(deviceprovision.FieldClientKey))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *EnrollmentKeyQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the EnrollmentKeyQuery builder.
+func (_q *EnrollmentKeyQuery) Filter() *EnrollmentKeyFilter {
+	return &EnrollmentKeyFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *EnrollmentKeyMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the EnrollmentKeyMutation builder.
+func (m *EnrollmentKeyMutation) Filter() *EnrollmentKeyFilter {
+	return &EnrollmentKeyFilter{config: m.config, predicateAdder: m}
+}
+
+// EnrollmentKeyFilter provides a generic filtering capability at runtime for EnrollmentKeyQuery.
+type EnrollmentKeyFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *EnrollmentKeyFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[4].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *EnrollmentKeyFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(enrollmentkey.FieldID))
+}
+
+// WhereCreatedTime applies the entql time.Time predicate on the created_time field.
+func (f *EnrollmentKeyFilter) WhereCreatedTime(p entql.TimeP) {
+	f.Where(p.Field(enrollmentkey.FieldCreatedTime))
+}
+
+// WhereUpdatedTime applies the entql time.Time predicate on the updated_time field.
+func (f *EnrollmentKeyFilter) WhereUpdatedTime(p entql.TimeP) {
+	f.Where(p.Field(enrollmentkey.FieldUpdatedTime))
+}
+
+// WhereName applies the entql string predicate on the name field.
+func (f *EnrollmentKeyFilter) WhereName(p entql.StringP) {
+	f.Where(p.Field(enrollmentkey.FieldName))
+}
+
+// WhereJti applies the entql string predicate on the jti field.
+func (f *EnrollmentKeyFilter) WhereJti(p entql.StringP) {
+	f.Where(p.Field(enrollmentkey.FieldJti))
+}
+
+// WhereGroups applies the entql json.RawMessage predicate on the groups field.
+func (f *EnrollmentKeyFilter) WhereGroups(p entql.BytesP) {
+	f.Where(p.Field(enrollmentkey.FieldGroups))
+}
+
+// WhereMaxUses applies the entql int predicate on the max_uses field.
+func (f *EnrollmentKeyFilter) WhereMaxUses(p entql.IntP) {
+	f.Where(p.Field(enrollmentkey.FieldMaxUses))
+}
+
+// WhereUses applies the entql int predicate on the uses field.
+func (f *EnrollmentKeyFilter) WhereUses(p entql.IntP) {
+	f.Where(p.Field(enrollmentkey.FieldUses))
+}
+
+// WhereExpiresTime applies the entql time.Time predicate on the expires_time field.
+func (f *EnrollmentKeyFilter) WhereExpiresTime(p entql.TimeP) {
+	f.Where(p.Field(enrollmentkey.FieldExpiresTime))
+}
+
+// WhereRevoked applies the entql bool predicate on the revoked field.
+func (f *EnrollmentKeyFilter) WhereRevoked(p entql.BoolP) {
+	f.Where(p.Field(enrollmentkey.FieldRevoked))
+}
+
+// addPredicate implements the predicateAdder interface.
+func (_q *RevokedTokenQuery) addPredicate(pred func(s *sql.Selector)) {
+	_q.predicates = append(_q.predicates, pred)
+}
+
+// Filter returns a Filter implementation to apply filters on the RevokedTokenQuery builder.
+func (_q *RevokedTokenQuery) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{config: _q.config, predicateAdder: _q}
+}
+
+// addPredicate implements the predicateAdder interface.
+func (m *RevokedTokenMutation) addPredicate(pred func(s *sql.Selector)) {
+	m.predicates = append(m.predicates, pred)
+}
+
+// Filter returns an entql.Where implementation to apply filters on the RevokedTokenMutation builder.
+func (m *RevokedTokenMutation) Filter() *RevokedTokenFilter {
+	return &RevokedTokenFilter{config: m.config, predicateAdder: m}
+}
+
+// RevokedTokenFilter provides a generic filtering capability at runtime for RevokedTokenQuery.
+type RevokedTokenFilter struct {
+	predicateAdder
+	config
+}
+
+// Where applies the entql predicate on the query filter.
+func (f *RevokedTokenFilter) Where(p entql.P) {
+	f.addPredicate(func(s *sql.Selector) {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[5].Type, p, s); err != nil {
+			s.AddError(err)
+		}
+	})
+}
+
+// WhereID applies the entql int predicate on the id field.
+func (f *RevokedTokenFilter) WhereID(p entql.IntP) {
+	f.Where(p.Field(revokedtoken.FieldID))
+}
+
+// WhereCreatedTime applies the entql time.Time predicate on the created_time field.
+func (f *RevokedTokenFilter) WhereCreatedTime(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldCreatedTime))
+}
+
+// WhereJti applies the entql string predicate on the jti field.
+func (f *RevokedTokenFilter) WhereJti(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldJti))
+}
+
+// WhereDeviceID applies the entql int predicate on the device_id field.
+func (f *RevokedTokenFilter) WhereDeviceID(p entql.IntP) {
+	f.Where(p.Field(revokedtoken.FieldDeviceID))
+}
+
+// WhereDeviceName applies the entql string predicate on the device_name field.
+func (f *RevokedTokenFilter) WhereDeviceName(p entql.StringP) {
+	f.Where(p.Field(revokedtoken.FieldDeviceName))
+}
+
+// WhereExpiresTime applies the entql time.Time predicate on the expires_time field.
+func (f *RevokedTokenFilter) WhereExpiresTime(p entql.TimeP) {
+	f.Where(p.Field(revokedtoken.FieldExpiresTime))
+}
+
 // addPredicate implements the predicateAdder interface.
 func (_q *SettingsQuery) addPredicate(pred func(s *sql.Selector)) {
 	_q.predicates = append(_q.predicates, pred)
@@ -168,7 +735,7 @@ type SettingsFilter struct {
 // Where applies the entql predicate on the query filter.
 func (f *SettingsFilter) Where(p entql.P) {
 	f.addPredicate(func(s *sql.Selector) {
-		if err := schemaGraph.EvalP(schemaGraph.Nodes[1].Type, p, s); err != nil {
+		if err := schemaGraph.EvalP(schemaGraph.Nodes[6].Type, p, s); err != nil {
 			s.AddError(err)
 		}
 	})
@@ -229,6 +796,51 @@ func (f *SettingsFilter) WherePortOverlayIP(p entql.Uint32P) {
 	f.Where(p.Field(settings.FieldPortOverlayIP))
 }
 
+// WherePortRelay applies the entql bool predicate on the port_relay field.
+func (f *SettingsFilter) WherePortRelay(p entql.BoolP) {
+	f.Where(p.Field(settings.FieldPortRelay))
+}
+
+// WhereNebulaPort applies the entql int predicate on the nebula_port field.
+func (f *SettingsFilter) WhereNebulaPort(p entql.IntP) {
+	f.Where(p.Field(settings.FieldNebulaPort))
+}
+
+// WhereHTTPPort applies the entql int predicate on the http_port field.
+func (f *SettingsFilter) WhereHTTPPort(p entql.IntP) {
+	f.Where(p.Field(settings.FieldHTTPPort))
+}
+
+// WhereHTTPSPort applies the entql int predicate on the https_port field.
+func (f *SettingsFilter) WhereHTTPSPort(p entql.IntP) {
+	f.Where(p.Field(settings.FieldHTTPSPort))
+}
+
+// WherePublicHost applies the entql string predicate on the public_host field.
+func (f *SettingsFilter) WherePublicHost(p entql.StringP) {
+	f.Where(p.Field(settings.FieldPublicHost))
+}
+
+// WherePublicNebulaPort applies the entql int predicate on the public_nebula_port field.
+func (f *SettingsFilter) WherePublicNebulaPort(p entql.IntP) {
+	f.Where(p.Field(settings.FieldPublicNebulaPort))
+}
+
+// WherePublicAPIPort applies the entql int predicate on the public_api_port field.
+func (f *SettingsFilter) WherePublicAPIPort(p entql.IntP) {
+	f.Where(p.Field(settings.FieldPublicAPIPort))
+}
+
+// WhereRequireClientKeys applies the entql bool predicate on the require_client_keys field.
+func (f *SettingsFilter) WhereRequireClientKeys(p entql.BoolP) {
+	f.Where(p.Field(settings.FieldRequireClientKeys))
+}
+
+// WhereFirewallInbound applies the entql json.RawMessage predicate on the firewall_inbound field.
+func (f *SettingsFilter) WhereFirewallInbound(p entql.BytesP) {
+	f.Where(p.Field(settings.FieldFirewallInbound))
+}
+
 // WhereLetsencryptRegistration applies the entql []byte predicate on the letsencrypt_registration field.
 func (f *SettingsFilter) WhereLetsencryptRegistration(p entql.BytesP) {
 	f.Where(p.Field(settings.FieldLetsencryptRegistration))
@@ -6,9 +6,109 @@ import (
 	"context"
 
 	"github.com/99designs/gqlgen/graphql"
+	"github.com/sprisa/west/westport/db/ent/auditevent"
 	"github.com/sprisa/west/westport/db/ent/device"
 )
 
+// CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
+func (_q *AuditEventQuery) CollectFields(ctx context.Context, satisfies ...string) (*AuditEventQuery, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil {
+		return _q, nil
+	}
+	if err := _q.collectField(ctx, false, graphql.GetOperationContext(ctx), fc.Field, nil, satisfies...); err != nil {
+		return nil, err
+	}
+	return _q, nil
+}
+
+func (_q *AuditEventQuery) collectField(ctx context.Context, oneNode bool, opCtx *graphql.OperationContext, collected graphql.CollectedField, path []string, satisfies ...string) error {
+	path = append([]string(nil), path...)
+	var (
+		unknownSeen    bool
+		fieldSeen      = make(map[string]struct{}, len(auditevent.Columns))
+		selectedFields = []string{auditevent.FieldID}
+	)
+	for _, field := range graphql.CollectFields(opCtx, collected.Selections, satisfies) {
+		switch field.Name {
+		case "createdTime":
+			if _, ok := fieldSeen[auditevent.FieldCreatedTime]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldCreatedTime)
+				fieldSeen[auditevent.FieldCreatedTime] = struct{}{}
+			}
+		case "action":
+			if _, ok := fieldSeen[auditevent.FieldAction]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldAction)
+				fieldSeen[auditevent.FieldAction] = struct{}{}
+			}
+		case "actor":
+			if _, ok := fieldSeen[auditevent.FieldActor]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldActor)
+				fieldSeen[auditevent.FieldActor] = struct{}{}
+			}
+		case "remoteAddr":
+			if _, ok := fieldSeen[auditevent.FieldRemoteAddr]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldRemoteAddr)
+				fieldSeen[auditevent.FieldRemoteAddr] = struct{}{}
+			}
+		case "deviceID":
+			if _, ok := fieldSeen[auditevent.FieldDeviceID]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldDeviceID)
+				fieldSeen[auditevent.FieldDeviceID] = struct{}{}
+			}
+		case "deviceName":
+			if _, ok := fieldSeen[auditevent.FieldDeviceName]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldDeviceName)
+				fieldSeen[auditevent.FieldDeviceName] = struct{}{}
+			}
+		case "success":
+			if _, ok := fieldSeen[auditevent.FieldSuccess]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldSuccess)
+				fieldSeen[auditevent.FieldSuccess] = struct{}{}
+			}
+		case "error":
+			if _, ok := fieldSeen[auditevent.FieldError]; !ok {
+				selectedFields = append(selectedFields, auditevent.FieldError)
+				fieldSeen[auditevent.FieldError] = struct{}{}
+			}
+		case "id":
+		case "__typename":
+		default:
+			unknownSeen = true
+		}
+	}
+	if !unknownSeen {
+		_q.Select(selectedFields...)
+	}
+	return nil
+}
+
+type auditeventPaginateArgs struct {
+	first, last   *int
+	after, before *Cursor
+	opts          []AuditEventPaginateOption
+}
+
+func newAuditEventPaginateArgs(rv map[string]any) *auditeventPaginateArgs {
+	args := &auditeventPaginateArgs{}
+	if rv == nil {
+		return args
+	}
+	if v := rv[firstField]; v != nil {
+		args.first = v.(*int)
+	}
+	if v := rv[lastField]; v != nil {
+		args.last = v.(*int)
+	}
+	if v := rv[afterField]; v != nil {
+		args.after = v.(*Cursor)
+	}
+	if v := rv[beforeField]; v != nil {
+		args.before = v.(*Cursor)
+	}
+	return args
+}
+
 // CollectFields tells the query-builder to eagerly load connected nodes by resolver context.
 func (_q *DeviceQuery) CollectFields(ctx context.Context, satisfies ...string) (*DeviceQuery, error) {
 	fc := graphql.GetFieldContext(ctx)
@@ -50,6 +150,71 @@ func (_q *DeviceQuery) collectField(ctx context.Context, oneNode bool, opCtx *gr
 				selectedFields = append(selectedFields, device.FieldIP)
 				fieldSeen[device.FieldIP] = struct{}{}
 			}
+		case "singleUseToken":
+			if _, ok := fieldSeen[device.FieldSingleUseToken]; !ok {
+				selectedFields = append(selectedFields, device.FieldSingleUseToken)
+				fieldSeen[device.FieldSingleUseToken] = struct{}{}
+			}
+		case "groups":
+			if _, ok := fieldSeen[device.FieldGroups]; !ok {
+				selectedFields = append(selectedFields, device.FieldGroups)
+				fieldSeen[device.FieldGroups] = struct{}{}
+			}
+		case "advertiseRoutes":
+			if _, ok := fieldSeen[device.FieldAdvertiseRoutes]; !ok {
+				selectedFields = append(selectedFields, device.FieldAdvertiseRoutes)
+				fieldSeen[device.FieldAdvertiseRoutes] = struct{}{}
+			}
+		case "exitNode":
+			if _, ok := fieldSeen[device.FieldExitNode]; !ok {
+				selectedFields = append(selectedFields, device.FieldExitNode)
+				fieldSeen[device.FieldExitNode] = struct{}{}
+			}
+		case "relay":
+			if _, ok := fieldSeen[device.FieldRelay]; !ok {
+				selectedFields = append(selectedFields, device.FieldRelay)
+				fieldSeen[device.FieldRelay] = struct{}{}
+			}
+		case "lighthouse":
+			if _, ok := fieldSeen[device.FieldLighthouse]; !ok {
+				selectedFields = append(selectedFields, device.FieldLighthouse)
+				fieldSeen[device.FieldLighthouse] = struct{}{}
+			}
+		case "publicEndpoint":
+			if _, ok := fieldSeen[device.FieldPublicEndpoint]; !ok {
+				selectedFields = append(selectedFields, device.FieldPublicEndpoint)
+				fieldSeen[device.FieldPublicEndpoint] = struct{}{}
+			}
+		case "lastProvisionTime":
+			if _, ok := fieldSeen[device.FieldLastProvisionTime]; !ok {
+				selectedFields = append(selectedFields, device.FieldLastProvisionTime)
+				fieldSeen[device.FieldLastProvisionTime] = struct{}{}
+			}
+		case "lastSeenTime":
+			if _, ok := fieldSeen[device.FieldLastSeenTime]; !ok {
+				selectedFields = append(selectedFields, device.FieldLastSeenTime)
+				fieldSeen[device.FieldLastSeenTime] = struct{}{}
+			}
+		case "underlayAddr":
+			if _, ok := fieldSeen[device.FieldUnderlayAddr]; !ok {
+				selectedFields = append(selectedFields, device.FieldUnderlayAddr)
+				fieldSeen[device.FieldUnderlayAddr] = struct{}{}
+			}
+		case "clientVersion":
+			if _, ok := fieldSeen[device.FieldClientVersion]; !ok {
+				selectedFields = append(selectedFields, device.FieldClientVersion)
+				fieldSeen[device.FieldClientVersion] = struct{}{}
+			}
+		case "os":
+			if _, ok := fieldSeen[device.FieldOs]; !ok {
+				selectedFields = append(selectedFields, device.FieldOs)
+				fieldSeen[device.FieldOs] = struct{}{}
+			}
+		case "arch":
+			if _, ok := fieldSeen[device.FieldArch]; !ok {
+				selectedFields = append(selectedFields, device.FieldArch)
+				fieldSeen[device.FieldArch] = struct{}{}
+			}
 		case "id":
 		case "__typename":
 		default:
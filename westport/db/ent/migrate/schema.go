@@ -8,6 +8,64 @@ import (
 )
 
 var (
+	// AuditEventsColumns holds the columns for the "audit_events" table.
+	AuditEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_time", Type: field.TypeTime},
+		{Name: "action", Type: field.TypeString},
+		{Name: "actor", Type: field.TypeString},
+		{Name: "remote_addr", Type: field.TypeString, Nullable: true},
+		{Name: "device_id", Type: field.TypeInt, Nullable: true},
+		{Name: "device_name", Type: field.TypeString, Nullable: true},
+		{Name: "success", Type: field.TypeBool, Default: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+	}
+	// AuditEventsTable holds the schema information for the "audit_events" table.
+	AuditEventsTable = &schema.Table{
+		Name:       "audit_events",
+		Columns:    AuditEventsColumns,
+		PrimaryKey: []*schema.Column{AuditEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditevent_created_time",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[1]},
+			},
+			{
+				Name:    "auditevent_device_name",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[6]},
+			},
+			{
+				Name:    "auditevent_action",
+				Unique:  false,
+				Columns: []*schema.Column{AuditEventsColumns[2]},
+			},
+		},
+	}
+	// DNSRecordsColumns holds the columns for the "dns_records" table.
+	DNSRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_time", Type: field.TypeTime},
+		{Name: "updated_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"A", "CNAME", "TXT"}},
+		{Name: "value", Type: field.TypeString},
+	}
+	// DNSRecordsTable holds the schema information for the "dns_records" table.
+	DNSRecordsTable = &schema.Table{
+		Name:       "dns_records",
+		Columns:    DNSRecordsColumns,
+		PrimaryKey: []*schema.Column{DNSRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "dnsrecord_name",
+				Unique:  true,
+				Columns: []*schema.Column{DNSRecordsColumns[3]},
+			},
+		},
+	}
 	// DevicesColumns holds the columns for the "devices" table.
 	DevicesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -17,6 +75,19 @@ var (
 		{Name: "ip", Type: field.TypeUint32},
 		{Name: "leased_access_token", Type: field.TypeString, Nullable: true},
 		{Name: "token", Type: field.TypeBytes},
+		{Name: "single_use_token", Type: field.TypeBool, Default: false},
+		{Name: "groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "advertise_routes", Type: field.TypeJSON, Nullable: true},
+		{Name: "exit_node", Type: field.TypeBool, Default: false},
+		{Name: "relay", Type: field.TypeBool, Default: false},
+		{Name: "lighthouse", Type: field.TypeBool, Default: false},
+		{Name: "public_endpoint", Type: field.TypeString, Nullable: true},
+		{Name: "last_provision_time", Type: field.TypeTime, Nullable: true},
+		{Name: "last_seen_time", Type: field.TypeTime, Nullable: true},
+		{Name: "underlay_addr", Type: field.TypeString, Nullable: true},
+		{Name: "client_version", Type: field.TypeString, Nullable: true},
+		{Name: "os", Type: field.TypeString, Nullable: true},
+		{Name: "arch", Type: field.TypeString, Nullable: true},
 	}
 	// DevicesTable holds the schema information for the "devices" table.
 	DevicesTable = &schema.Table{
@@ -41,6 +112,83 @@ var (
 			},
 		},
 	}
+	// DeviceProvisionsColumns holds the columns for the "device_provisions" table.
+	DeviceProvisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_time", Type: field.TypeTime},
+		{Name: "device_id", Type: field.TypeInt},
+		{Name: "remote_addr", Type: field.TypeString, Nullable: true},
+		{Name: "client_version", Type: field.TypeString, Nullable: true},
+		{Name: "os", Type: field.TypeString, Nullable: true},
+		{Name: "arch", Type: field.TypeString, Nullable: true},
+		{Name: "client_key", Type: field.TypeBool, Default: false},
+	}
+	// DeviceProvisionsTable holds the schema information for the "device_provisions" table.
+	DeviceProvisionsTable = &schema.Table{
+		Name:       "device_provisions",
+		Columns:    DeviceProvisionsColumns,
+		PrimaryKey: []*schema.Column{DeviceProvisionsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "deviceprovision_device_id_created_time",
+				Unique:  false,
+				Columns: []*schema.Column{DeviceProvisionsColumns[2], DeviceProvisionsColumns[1]},
+			},
+		},
+	}
+	// EnrollmentKeysColumns holds the columns for the "enrollment_keys" table.
+	EnrollmentKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_time", Type: field.TypeTime},
+		{Name: "updated_time", Type: field.TypeTime},
+		{Name: "name", Type: field.TypeString},
+		{Name: "jti", Type: field.TypeString},
+		{Name: "groups", Type: field.TypeJSON, Nullable: true},
+		{Name: "max_uses", Type: field.TypeInt, Default: 0},
+		{Name: "uses", Type: field.TypeInt, Default: 0},
+		{Name: "expires_time", Type: field.TypeTime},
+		{Name: "revoked", Type: field.TypeBool, Default: false},
+	}
+	// EnrollmentKeysTable holds the schema information for the "enrollment_keys" table.
+	EnrollmentKeysTable = &schema.Table{
+		Name:       "enrollment_keys",
+		Columns:    EnrollmentKeysColumns,
+		PrimaryKey: []*schema.Column{EnrollmentKeysColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "enrollmentkey_name",
+				Unique:  true,
+				Columns: []*schema.Column{EnrollmentKeysColumns[3]},
+			},
+			{
+				Name:    "enrollmentkey_jti",
+				Unique:  true,
+				Columns: []*schema.Column{EnrollmentKeysColumns[4]},
+			},
+		},
+	}
+	// RevokedTokensColumns holds the columns for the "revoked_tokens" table.
+	RevokedTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "created_time", Type: field.TypeTime},
+		{Name: "jti", Type: field.TypeString},
+		{Name: "device_id", Type: field.TypeInt, Nullable: true},
+		{Name: "device_name", Type: field.TypeString, Nullable: true},
+		{Name: "expires_time", Type: field.TypeTime},
+	}
+	// RevokedTokensTable holds the schema information for the "revoked_tokens" table.
+	RevokedTokensTable = &schema.Table{
+		Name:       "revoked_tokens",
+		Columns:    RevokedTokensColumns,
+		PrimaryKey: []*schema.Column{RevokedTokensColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "revokedtoken_jti",
+				Unique:  true,
+				Columns: []*schema.Column{RevokedTokensColumns[2]},
+			},
+		},
+	}
 	// SettingsColumns holds the columns for the "settings" table.
 	SettingsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt, Increment: true},
@@ -54,6 +202,15 @@ var (
 		{Name: "lighthouse_key", Type: field.TypeBytes},
 		{Name: "cidr", Type: field.TypeString},
 		{Name: "port_overlay_ip", Type: field.TypeUint32},
+		{Name: "port_relay", Type: field.TypeBool, Default: true},
+		{Name: "nebula_port", Type: field.TypeInt, Default: 4242},
+		{Name: "http_port", Type: field.TypeInt, Default: 80},
+		{Name: "https_port", Type: field.TypeInt, Default: 443},
+		{Name: "public_host", Type: field.TypeString, Nullable: true},
+		{Name: "public_nebula_port", Type: field.TypeInt, Nullable: true},
+		{Name: "public_api_port", Type: field.TypeInt, Nullable: true},
+		{Name: "require_client_keys", Type: field.TypeBool, Default: false},
+		{Name: "firewall_inbound", Type: field.TypeJSON, Nullable: true},
 		{Name: "letsencrypt_registration", Type: field.TypeBytes, Nullable: true},
 		{Name: "tls_cert", Type: field.TypeBytes, Nullable: true},
 		{Name: "tls_cert_key", Type: field.TypeBytes, Nullable: true},
@@ -66,7 +223,12 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditEventsTable,
+		DNSRecordsTable,
 		DevicesTable,
+		DeviceProvisionsTable,
+		EnrollmentKeysTable,
+		RevokedTokensTable,
 		SettingsTable,
 	}
 )
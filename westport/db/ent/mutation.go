@@ -12,8 +12,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/westport/db/ent/auditevent"
 	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
 	"github.com/sprisa/west/westport/db/ent/predicate"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
 	"github.com/sprisa/west/westport/db/ent/settings"
 	"github.com/sprisa/west/westport/db/helpers"
 )
@@ -27,40 +32,48 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeDevice   = "Device"
-	TypeSettings = "Settings"
+	TypeAuditEvent      = "AuditEvent"
+	TypeDNSRecord       = "DNSRecord"
+	TypeDevice          = "Device"
+	TypeDeviceProvision = "DeviceProvision"
+	TypeEnrollmentKey   = "EnrollmentKey"
+	TypeRevokedToken    = "RevokedToken"
+	TypeSettings        = "Settings"
 )
 
-// DeviceMutation represents an operation that mutates the Device nodes in the graph.
-type DeviceMutation struct {
+// AuditEventMutation represents an operation that mutates the AuditEvent nodes in the graph.
+type AuditEventMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *int
-	created_time        *time.Time
-	updated_time        *time.Time
-	name                *string
-	ip                  *ipconv.IP
-	addip               *ipconv.IP
-	leased_access_token *string
-	token               *helpers.EncryptedBytes
-	clearedFields       map[string]struct{}
-	done                bool
-	oldValue            func(context.Context) (*Device, error)
-	predicates          []predicate.Device
-}
-
-var _ ent.Mutation = (*DeviceMutation)(nil)
-
-// deviceOption allows management of the mutation configuration using functional options.
-type deviceOption func(*DeviceMutation)
-
-// newDeviceMutation creates new mutation for the Device entity.
-func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
-	m := &DeviceMutation{
+	op            Op
+	typ           string
+	id            *int
+	created_time  *time.Time
+	action        *string
+	actor         *string
+	remote_addr   *string
+	device_id     *int
+	adddevice_id  *int
+	device_name   *string
+	success       *bool
+	error         *string
+	details       *map[string]string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditEvent, error)
+	predicates    []predicate.AuditEvent
+}
+
+var _ ent.Mutation = (*AuditEventMutation)(nil)
+
+// auditeventOption allows management of the mutation configuration using functional options.
+type auditeventOption func(*AuditEventMutation)
+
+// newAuditEventMutation creates new mutation for the AuditEvent entity.
+func newAuditEventMutation(c config, op Op, opts ...auditeventOption) *AuditEventMutation {
+	m := &AuditEventMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeDevice,
+		typ:           TypeAuditEvent,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -69,20 +82,20 @@ func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
 	return m
 }
 
-// withDeviceID sets the ID field of the mutation.
-func withDeviceID(id int) deviceOption {
-	return func(m *DeviceMutation) {
+// withAuditEventID sets the ID field of the mutation.
+func withAuditEventID(id int) auditeventOption {
+	return func(m *AuditEventMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Device
+			value *AuditEvent
 		)
-		m.oldValue = func(ctx context.Context) (*Device, error) {
+		m.oldValue = func(ctx context.Context) (*AuditEvent, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Device.Get(ctx, id)
+					value, err = m.Client().AuditEvent.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -91,10 +104,10 @@ func withDeviceID(id int) deviceOption {
 	}
 }
 
-// withDevice sets the old Device of the mutation.
-func withDevice(node *Device) deviceOption {
-	return func(m *DeviceMutation) {
-		m.oldValue = func(context.Context) (*Device, error) {
+// withAuditEvent sets the old AuditEvent of the mutation.
+func withAuditEvent(node *AuditEvent) auditeventOption {
+	return func(m *AuditEventMutation) {
+		m.oldValue = func(context.Context) (*AuditEvent, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -103,7 +116,7 @@ func withDevice(node *Device) deviceOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m DeviceMutation) Client() *Client {
+func (m AuditEventMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -111,7 +124,7 @@ func (m DeviceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m DeviceMutation) Tx() (*Tx, error) {
+func (m AuditEventMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -122,7 +135,7 @@ func (m DeviceMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *DeviceMutation) ID() (id int, exists bool) {
+func (m *AuditEventMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -133,7 +146,7 @@ func (m *DeviceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *DeviceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *AuditEventMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -142,19 +155,19 @@ func (m *DeviceMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Device.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditEvent.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedTime sets the "created_time" field.
-func (m *DeviceMutation) SetCreatedTime(t time.Time) {
+func (m *AuditEventMutation) SetCreatedTime(t time.Time) {
 	m.created_time = &t
 }
 
 // CreatedTime returns the value of the "created_time" field in the mutation.
-func (m *DeviceMutation) CreatedTime() (r time.Time, exists bool) {
+func (m *AuditEventMutation) CreatedTime() (r time.Time, exists bool) {
 	v := m.created_time
 	if v == nil {
 		return
@@ -162,10 +175,10 @@ func (m *DeviceMutation) CreatedTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedTime returns the old "created_time" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedTime returns the old "created_time" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedTime is only allowed on UpdateOne operations")
 	}
@@ -180,232 +193,393 @@ func (m *DeviceMutation) OldCreatedTime(ctx context.Context) (v time.Time, err e
 }
 
 // ResetCreatedTime resets all changes to the "created_time" field.
-func (m *DeviceMutation) ResetCreatedTime() {
+func (m *AuditEventMutation) ResetCreatedTime() {
 	m.created_time = nil
 }
 
-// SetUpdatedTime sets the "updated_time" field.
-func (m *DeviceMutation) SetUpdatedTime(t time.Time) {
-	m.updated_time = &t
+// SetAction sets the "action" field.
+func (m *AuditEventMutation) SetAction(s string) {
+	m.action = &s
 }
 
-// UpdatedTime returns the value of the "updated_time" field in the mutation.
-func (m *DeviceMutation) UpdatedTime() (r time.Time, exists bool) {
-	v := m.updated_time
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditEventMutation) Action() (r string, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedTime returns the old "updated_time" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldUpdatedTime(ctx context.Context) (v time.Time, err error) {
+func (m *AuditEventMutation) OldAction(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedTime requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.UpdatedTime, nil
+	return oldValue.Action, nil
 }
 
-// ResetUpdatedTime resets all changes to the "updated_time" field.
-func (m *DeviceMutation) ResetUpdatedTime() {
-	m.updated_time = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditEventMutation) ResetAction() {
+	m.action = nil
 }
 
-// SetName sets the "name" field.
-func (m *DeviceMutation) SetName(s string) {
-	m.name = &s
+// SetActor sets the "actor" field.
+func (m *AuditEventMutation) SetActor(s string) {
+	m.actor = &s
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *DeviceMutation) Name() (r string, exists bool) {
-	v := m.name
+// Actor returns the value of the "actor" field in the mutation.
+func (m *AuditEventMutation) Actor() (r string, exists bool) {
+	v := m.actor
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldActor returns the old "actor" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *AuditEventMutation) OldActor(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldActor is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldActor requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldActor: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.Actor, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *DeviceMutation) ResetName() {
-	m.name = nil
+// ResetActor resets all changes to the "actor" field.
+func (m *AuditEventMutation) ResetActor() {
+	m.actor = nil
 }
 
-// SetIP sets the "ip" field.
-func (m *DeviceMutation) SetIP(i ipconv.IP) {
-	m.ip = &i
-	m.addip = nil
+// SetRemoteAddr sets the "remote_addr" field.
+func (m *AuditEventMutation) SetRemoteAddr(s string) {
+	m.remote_addr = &s
 }
 
-// IP returns the value of the "ip" field in the mutation.
-func (m *DeviceMutation) IP() (r ipconv.IP, exists bool) {
-	v := m.ip
+// RemoteAddr returns the value of the "remote_addr" field in the mutation.
+func (m *AuditEventMutation) RemoteAddr() (r string, exists bool) {
+	v := m.remote_addr
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIP returns the old "ip" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldRemoteAddr returns the old "remote_addr" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldIP(ctx context.Context) (v ipconv.IP, err error) {
+func (m *AuditEventMutation) OldRemoteAddr(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldRemoteAddr is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIP requires an ID field in the mutation")
+		return v, errors.New("OldRemoteAddr requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+		return v, fmt.Errorf("querying old value for OldRemoteAddr: %w", err)
 	}
-	return oldValue.IP, nil
+	return oldValue.RemoteAddr, nil
 }
 
-// AddIP adds i to the "ip" field.
-func (m *DeviceMutation) AddIP(i ipconv.IP) {
-	if m.addip != nil {
-		*m.addip += i
+// ClearRemoteAddr clears the value of the "remote_addr" field.
+func (m *AuditEventMutation) ClearRemoteAddr() {
+	m.remote_addr = nil
+	m.clearedFields[auditevent.FieldRemoteAddr] = struct{}{}
+}
+
+// RemoteAddrCleared returns if the "remote_addr" field was cleared in this mutation.
+func (m *AuditEventMutation) RemoteAddrCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldRemoteAddr]
+	return ok
+}
+
+// ResetRemoteAddr resets all changes to the "remote_addr" field.
+func (m *AuditEventMutation) ResetRemoteAddr() {
+	m.remote_addr = nil
+	delete(m.clearedFields, auditevent.FieldRemoteAddr)
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *AuditEventMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *AuditEventMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (m *AuditEventMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
 	} else {
-		m.addip = &i
+		m.adddevice_id = &i
 	}
 }
 
-// AddedIP returns the value that was added to the "ip" field in this mutation.
-func (m *DeviceMutation) AddedIP() (r ipconv.IP, exists bool) {
-	v := m.addip
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *AuditEventMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetIP resets all changes to the "ip" field.
-func (m *DeviceMutation) ResetIP() {
-	m.ip = nil
-	m.addip = nil
+// ClearDeviceID clears the value of the "device_id" field.
+func (m *AuditEventMutation) ClearDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+	m.clearedFields[auditevent.FieldDeviceID] = struct{}{}
 }
 
-// SetLeasedAccessToken sets the "leased_access_token" field.
-func (m *DeviceMutation) SetLeasedAccessToken(s string) {
-	m.leased_access_token = &s
+// DeviceIDCleared returns if the "device_id" field was cleared in this mutation.
+func (m *AuditEventMutation) DeviceIDCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDeviceID]
+	return ok
 }
 
-// LeasedAccessToken returns the value of the "leased_access_token" field in the mutation.
-func (m *DeviceMutation) LeasedAccessToken() (r string, exists bool) {
-	v := m.leased_access_token
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *AuditEventMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+	delete(m.clearedFields, auditevent.FieldDeviceID)
+}
+
+// SetDeviceName sets the "device_name" field.
+func (m *AuditEventMutation) SetDeviceName(s string) {
+	m.device_name = &s
+}
+
+// DeviceName returns the value of the "device_name" field in the mutation.
+func (m *AuditEventMutation) DeviceName() (r string, exists bool) {
+	v := m.device_name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLeasedAccessToken returns the old "leased_access_token" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldDeviceName returns the old "device_name" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldLeasedAccessToken(ctx context.Context) (v *string, err error) {
+func (m *AuditEventMutation) OldDeviceName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLeasedAccessToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLeasedAccessToken requires an ID field in the mutation")
+		return v, errors.New("OldDeviceName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLeasedAccessToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
 	}
-	return oldValue.LeasedAccessToken, nil
+	return oldValue.DeviceName, nil
 }
 
-// ClearLeasedAccessToken clears the value of the "leased_access_token" field.
-func (m *DeviceMutation) ClearLeasedAccessToken() {
-	m.leased_access_token = nil
-	m.clearedFields[device.FieldLeasedAccessToken] = struct{}{}
+// ClearDeviceName clears the value of the "device_name" field.
+func (m *AuditEventMutation) ClearDeviceName() {
+	m.device_name = nil
+	m.clearedFields[auditevent.FieldDeviceName] = struct{}{}
 }
 
-// LeasedAccessTokenCleared returns if the "leased_access_token" field was cleared in this mutation.
-func (m *DeviceMutation) LeasedAccessTokenCleared() bool {
-	_, ok := m.clearedFields[device.FieldLeasedAccessToken]
+// DeviceNameCleared returns if the "device_name" field was cleared in this mutation.
+func (m *AuditEventMutation) DeviceNameCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDeviceName]
 	return ok
 }
 
-// ResetLeasedAccessToken resets all changes to the "leased_access_token" field.
-func (m *DeviceMutation) ResetLeasedAccessToken() {
-	m.leased_access_token = nil
-	delete(m.clearedFields, device.FieldLeasedAccessToken)
+// ResetDeviceName resets all changes to the "device_name" field.
+func (m *AuditEventMutation) ResetDeviceName() {
+	m.device_name = nil
+	delete(m.clearedFields, auditevent.FieldDeviceName)
 }
 
-// SetToken sets the "token" field.
-func (m *DeviceMutation) SetToken(hb helpers.EncryptedBytes) {
-	m.token = &hb
+// SetSuccess sets the "success" field.
+func (m *AuditEventMutation) SetSuccess(b bool) {
+	m.success = &b
 }
 
-// Token returns the value of the "token" field in the mutation.
-func (m *DeviceMutation) Token() (r helpers.EncryptedBytes, exists bool) {
-	v := m.token
+// Success returns the value of the "success" field in the mutation.
+func (m *AuditEventMutation) Success() (r bool, exists bool) {
+	v := m.success
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldToken returns the old "token" field's value of the Device entity.
-// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// OldSuccess returns the old "success" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *DeviceMutation) OldToken(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+func (m *AuditEventMutation) OldSuccess(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+		return v, errors.New("OldSuccess is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldToken requires an ID field in the mutation")
+		return v, errors.New("OldSuccess requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+		return v, fmt.Errorf("querying old value for OldSuccess: %w", err)
 	}
-	return oldValue.Token, nil
+	return oldValue.Success, nil
 }
 
-// ResetToken resets all changes to the "token" field.
-func (m *DeviceMutation) ResetToken() {
-	m.token = nil
+// ResetSuccess resets all changes to the "success" field.
+func (m *AuditEventMutation) ResetSuccess() {
+	m.success = nil
 }
 
-// Where appends a list predicates to the DeviceMutation builder.
-func (m *DeviceMutation) Where(ps ...predicate.Device) {
+// SetError sets the "error" field.
+func (m *AuditEventMutation) SetError(s string) {
+	m.error = &s
+}
+
+// Error returns the value of the "error" field in the mutation.
+func (m *AuditEventMutation) Error() (r string, exists bool) {
+	v := m.error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldError returns the old "error" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldError: %w", err)
+	}
+	return oldValue.Error, nil
+}
+
+// ClearError clears the value of the "error" field.
+func (m *AuditEventMutation) ClearError() {
+	m.error = nil
+	m.clearedFields[auditevent.FieldError] = struct{}{}
+}
+
+// ErrorCleared returns if the "error" field was cleared in this mutation.
+func (m *AuditEventMutation) ErrorCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldError]
+	return ok
+}
+
+// ResetError resets all changes to the "error" field.
+func (m *AuditEventMutation) ResetError() {
+	m.error = nil
+	delete(m.clearedFields, auditevent.FieldError)
+}
+
+// SetDetails sets the "details" field.
+func (m *AuditEventMutation) SetDetails(value map[string]string) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *AuditEventMutation) Details() (r map[string]string, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the AuditEvent entity.
+// If the AuditEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditEventMutation) OldDetails(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *AuditEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[auditevent.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *AuditEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[auditevent.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *AuditEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, auditevent.FieldDetails)
+}
+
+// Where appends a list predicates to the AuditEventMutation builder.
+func (m *AuditEventMutation) Where(ps ...predicate.AuditEvent) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the DeviceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the AuditEventMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Device, len(ps))
+func (m *AuditEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditEvent, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -413,42 +587,51 @@ func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *DeviceMutation) Op() Op {
+func (m *AuditEventMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *DeviceMutation) SetOp(op Op) {
+func (m *AuditEventMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Device).
-func (m *DeviceMutation) Type() string {
+// Type returns the node type of this mutation (AuditEvent).
+func (m *AuditEventMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *DeviceMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *AuditEventMutation) Fields() []string {
+	fields := make([]string, 0, 9)
 	if m.created_time != nil {
-		fields = append(fields, device.FieldCreatedTime)
+		fields = append(fields, auditevent.FieldCreatedTime)
 	}
-	if m.updated_time != nil {
-		fields = append(fields, device.FieldUpdatedTime)
+	if m.action != nil {
+		fields = append(fields, auditevent.FieldAction)
 	}
-	if m.name != nil {
-		fields = append(fields, device.FieldName)
+	if m.actor != nil {
+		fields = append(fields, auditevent.FieldActor)
 	}
-	if m.ip != nil {
-		fields = append(fields, device.FieldIP)
+	if m.remote_addr != nil {
+		fields = append(fields, auditevent.FieldRemoteAddr)
 	}
-	if m.leased_access_token != nil {
-		fields = append(fields, device.FieldLeasedAccessToken)
+	if m.device_id != nil {
+		fields = append(fields, auditevent.FieldDeviceID)
 	}
-	if m.token != nil {
-		fields = append(fields, device.FieldToken)
+	if m.device_name != nil {
+		fields = append(fields, auditevent.FieldDeviceName)
+	}
+	if m.success != nil {
+		fields = append(fields, auditevent.FieldSuccess)
+	}
+	if m.error != nil {
+		fields = append(fields, auditevent.FieldError)
+	}
+	if m.details != nil {
+		fields = append(fields, auditevent.FieldDetails)
 	}
 	return fields
 }
@@ -456,20 +639,26 @@ func (m *DeviceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case device.FieldCreatedTime:
+	case auditevent.FieldCreatedTime:
 		return m.CreatedTime()
-	case device.FieldUpdatedTime:
-		return m.UpdatedTime()
-	case device.FieldName:
-		return m.Name()
-	case device.FieldIP:
-		return m.IP()
-	case device.FieldLeasedAccessToken:
-		return m.LeasedAccessToken()
-	case device.FieldToken:
-		return m.Token()
+	case auditevent.FieldAction:
+		return m.Action()
+	case auditevent.FieldActor:
+		return m.Actor()
+	case auditevent.FieldRemoteAddr:
+		return m.RemoteAddr()
+	case auditevent.FieldDeviceID:
+		return m.DeviceID()
+	case auditevent.FieldDeviceName:
+		return m.DeviceName()
+	case auditevent.FieldSuccess:
+		return m.Success()
+	case auditevent.FieldError:
+		return m.Error()
+	case auditevent.FieldDetails:
+		return m.Details()
 	}
 	return nil, false
 }
@@ -477,81 +666,108 @@ func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case device.FieldCreatedTime:
+	case auditevent.FieldCreatedTime:
 		return m.OldCreatedTime(ctx)
-	case device.FieldUpdatedTime:
-		return m.OldUpdatedTime(ctx)
-	case device.FieldName:
-		return m.OldName(ctx)
-	case device.FieldIP:
-		return m.OldIP(ctx)
-	case device.FieldLeasedAccessToken:
-		return m.OldLeasedAccessToken(ctx)
-	case device.FieldToken:
-		return m.OldToken(ctx)
-	}
-	return nil, fmt.Errorf("unknown Device field %s", name)
+	case auditevent.FieldAction:
+		return m.OldAction(ctx)
+	case auditevent.FieldActor:
+		return m.OldActor(ctx)
+	case auditevent.FieldRemoteAddr:
+		return m.OldRemoteAddr(ctx)
+	case auditevent.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case auditevent.FieldDeviceName:
+		return m.OldDeviceName(ctx)
+	case auditevent.FieldSuccess:
+		return m.OldSuccess(ctx)
+	case auditevent.FieldError:
+		return m.OldError(ctx)
+	case auditevent.FieldDetails:
+		return m.OldDetails(ctx)
+	}
+	return nil, fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceMutation) SetField(name string, value ent.Value) error {
+func (m *AuditEventMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case device.FieldCreatedTime:
+	case auditevent.FieldCreatedTime:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedTime(v)
 		return nil
-	case device.FieldUpdatedTime:
-		v, ok := value.(time.Time)
+	case auditevent.FieldAction:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedTime(v)
+		m.SetAction(v)
 		return nil
-	case device.FieldName:
+	case auditevent.FieldActor:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetActor(v)
 		return nil
-	case device.FieldIP:
-		v, ok := value.(ipconv.IP)
+	case auditevent.FieldRemoteAddr:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIP(v)
+		m.SetRemoteAddr(v)
 		return nil
-	case device.FieldLeasedAccessToken:
+	case auditevent.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case auditevent.FieldDeviceName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLeasedAccessToken(v)
+		m.SetDeviceName(v)
 		return nil
-	case device.FieldToken:
-		v, ok := value.(helpers.EncryptedBytes)
+	case auditevent.FieldSuccess:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetToken(v)
+		m.SetSuccess(v)
+		return nil
+	case auditevent.FieldError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetError(v)
+		return nil
+	case auditevent.FieldDetails:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Device field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *DeviceMutation) AddedFields() []string {
+func (m *AuditEventMutation) AddedFields() []string {
 	var fields []string
-	if m.addip != nil {
-		fields = append(fields, device.FieldIP)
+	if m.adddevice_id != nil {
+		fields = append(fields, auditevent.FieldDeviceID)
 	}
 	return fields
 }
@@ -559,10 +775,10 @@ func (m *DeviceMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditEventMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case device.FieldIP:
-		return m.AddedIP()
+	case auditevent.FieldDeviceID:
+		return m.AddedDeviceID()
 	}
 	return nil, false
 }
@@ -570,158 +786,182 @@ func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *DeviceMutation) AddField(name string, value ent.Value) error {
+func (m *AuditEventMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case device.FieldIP:
-		v, ok := value.(ipconv.IP)
+	case auditevent.FieldDeviceID:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddIP(v)
+		m.AddDeviceID(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Device numeric field %s", name)
+	return fmt.Errorf("unknown AuditEvent numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *DeviceMutation) ClearedFields() []string {
+func (m *AuditEventMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(device.FieldLeasedAccessToken) {
-		fields = append(fields, device.FieldLeasedAccessToken)
+	if m.FieldCleared(auditevent.FieldRemoteAddr) {
+		fields = append(fields, auditevent.FieldRemoteAddr)
+	}
+	if m.FieldCleared(auditevent.FieldDeviceID) {
+		fields = append(fields, auditevent.FieldDeviceID)
+	}
+	if m.FieldCleared(auditevent.FieldDeviceName) {
+		fields = append(fields, auditevent.FieldDeviceName)
+	}
+	if m.FieldCleared(auditevent.FieldError) {
+		fields = append(fields, auditevent.FieldError)
+	}
+	if m.FieldCleared(auditevent.FieldDetails) {
+		fields = append(fields, auditevent.FieldDetails)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *DeviceMutation) FieldCleared(name string) bool {
+func (m *AuditEventMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *DeviceMutation) ClearField(name string) error {
+func (m *AuditEventMutation) ClearField(name string) error {
 	switch name {
-	case device.FieldLeasedAccessToken:
-		m.ClearLeasedAccessToken()
+	case auditevent.FieldRemoteAddr:
+		m.ClearRemoteAddr()
+		return nil
+	case auditevent.FieldDeviceID:
+		m.ClearDeviceID()
+		return nil
+	case auditevent.FieldDeviceName:
+		m.ClearDeviceName()
+		return nil
+	case auditevent.FieldError:
+		m.ClearError()
+		return nil
+	case auditevent.FieldDetails:
+		m.ClearDetails()
 		return nil
 	}
-	return fmt.Errorf("unknown Device nullable field %s", name)
+	return fmt.Errorf("unknown AuditEvent nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *DeviceMutation) ResetField(name string) error {
+func (m *AuditEventMutation) ResetField(name string) error {
 	switch name {
-	case device.FieldCreatedTime:
+	case auditevent.FieldCreatedTime:
 		m.ResetCreatedTime()
 		return nil
-	case device.FieldUpdatedTime:
-		m.ResetUpdatedTime()
+	case auditevent.FieldAction:
+		m.ResetAction()
 		return nil
-	case device.FieldName:
-		m.ResetName()
+	case auditevent.FieldActor:
+		m.ResetActor()
 		return nil
-	case device.FieldIP:
-		m.ResetIP()
+	case auditevent.FieldRemoteAddr:
+		m.ResetRemoteAddr()
 		return nil
-	case device.FieldLeasedAccessToken:
-		m.ResetLeasedAccessToken()
+	case auditevent.FieldDeviceID:
+		m.ResetDeviceID()
 		return nil
-	case device.FieldToken:
-		m.ResetToken()
+	case auditevent.FieldDeviceName:
+		m.ResetDeviceName()
+		return nil
+	case auditevent.FieldSuccess:
+		m.ResetSuccess()
+		return nil
+	case auditevent.FieldError:
+		m.ResetError()
+		return nil
+	case auditevent.FieldDetails:
+		m.ResetDetails()
 		return nil
 	}
-	return fmt.Errorf("unknown Device field %s", name)
+	return fmt.Errorf("unknown AuditEvent field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *DeviceMutation) AddedEdges() []string {
+func (m *AuditEventMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *DeviceMutation) RemovedEdges() []string {
+func (m *AuditEventMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
+func (m *AuditEventMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *DeviceMutation) ClearedEdges() []string {
+func (m *AuditEventMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *DeviceMutation) EdgeCleared(name string) bool {
+func (m *AuditEventMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *DeviceMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Device unique edge %s", name)
+func (m *AuditEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *DeviceMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Device edge %s", name)
+func (m *AuditEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditEvent edge %s", name)
 }
 
-// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
-type SettingsMutation struct {
+// DNSRecordMutation represents an operation that mutates the DNSRecord nodes in the graph.
+type DNSRecordMutation struct {
 	config
-	op                       Op
-	typ                      string
-	id                       *int
-	created_time             *time.Time
-	updated_time             *time.Time
-	domain_zone              *string
-	cipher                   *string
-	ca_crt                   *helpers.EncryptedBytes
-	ca_key                   *helpers.EncryptedBytes
-	lighthouse_crt           *helpers.EncryptedBytes
-	lighthouse_key           *helpers.EncryptedBytes
-	cidr                     *helpers.IpCidr
-	port_overlay_ip          *ipconv.IP
-	addport_overlay_ip       *ipconv.IP
-	letsencrypt_registration *helpers.EncryptedBytes
-	tls_cert                 *helpers.EncryptedBytes
-	tls_cert_key             *helpers.EncryptedBytes
-	clearedFields            map[string]struct{}
-	done                     bool
-	oldValue                 func(context.Context) (*Settings, error)
-	predicates               []predicate.Settings
-}
-
-var _ ent.Mutation = (*SettingsMutation)(nil)
-
-// settingsOption allows management of the mutation configuration using functional options.
-type settingsOption func(*SettingsMutation)
-
-// newSettingsMutation creates new mutation for the Settings entity.
-func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
-	m := &SettingsMutation{
+	op            Op
+	typ           string
+	id            *int
+	created_time  *time.Time
+	updated_time  *time.Time
+	name          *string
+	_type         *dnsrecord.Type
+	value         *string
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*DNSRecord, error)
+	predicates    []predicate.DNSRecord
+}
+
+var _ ent.Mutation = (*DNSRecordMutation)(nil)
+
+// dnsrecordOption allows management of the mutation configuration using functional options.
+type dnsrecordOption func(*DNSRecordMutation)
+
+// newDNSRecordMutation creates new mutation for the DNSRecord entity.
+func newDNSRecordMutation(c config, op Op, opts ...dnsrecordOption) *DNSRecordMutation {
+	m := &DNSRecordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSettings,
+		typ:           TypeDNSRecord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -730,20 +970,20 @@ func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutat
 	return m
 }
 
-// withSettingsID sets the ID field of the mutation.
-func withSettingsID(id int) settingsOption {
-	return func(m *SettingsMutation) {
+// withDNSRecordID sets the ID field of the mutation.
+func withDNSRecordID(id int) dnsrecordOption {
+	return func(m *DNSRecordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Settings
+			value *DNSRecord
 		)
-		m.oldValue = func(ctx context.Context) (*Settings, error) {
+		m.oldValue = func(ctx context.Context) (*DNSRecord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Settings.Get(ctx, id)
+					value, err = m.Client().DNSRecord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -752,10 +992,10 @@ func withSettingsID(id int) settingsOption {
 	}
 }
 
-// withSettings sets the old Settings of the mutation.
-func withSettings(node *Settings) settingsOption {
-	return func(m *SettingsMutation) {
-		m.oldValue = func(context.Context) (*Settings, error) {
+// withDNSRecord sets the old DNSRecord of the mutation.
+func withDNSRecord(node *DNSRecord) dnsrecordOption {
+	return func(m *DNSRecordMutation) {
+		m.oldValue = func(context.Context) (*DNSRecord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -764,7 +1004,7 @@ func withSettings(node *Settings) settingsOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SettingsMutation) Client() *Client {
+func (m DNSRecordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -772,7 +1012,7 @@ func (m SettingsMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SettingsMutation) Tx() (*Tx, error) {
+func (m DNSRecordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -783,7 +1023,7 @@ func (m SettingsMutation) Tx() (*Tx, error) {
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SettingsMutation) ID() (id int, exists bool) {
+func (m *DNSRecordMutation) ID() (id int, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -794,7 +1034,7 @@ func (m *SettingsMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *DNSRecordMutation) IDs(ctx context.Context) ([]int, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -803,19 +1043,19 @@ func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().DNSRecord.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetCreatedTime sets the "created_time" field.
-func (m *SettingsMutation) SetCreatedTime(t time.Time) {
+func (m *DNSRecordMutation) SetCreatedTime(t time.Time) {
 	m.created_time = &t
 }
 
 // CreatedTime returns the value of the "created_time" field in the mutation.
-func (m *SettingsMutation) CreatedTime() (r time.Time, exists bool) {
+func (m *DNSRecordMutation) CreatedTime() (r time.Time, exists bool) {
 	v := m.created_time
 	if v == nil {
 		return
@@ -823,10 +1063,10 @@ func (m *SettingsMutation) CreatedTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedTime returns the old "created_time" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedTime returns the old "created_time" field's value of the DNSRecord entity.
+// If the DNSRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
+func (m *DNSRecordMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedTime is only allowed on UpdateOne operations")
 	}
@@ -841,17 +1081,17 @@ func (m *SettingsMutation) OldCreatedTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetCreatedTime resets all changes to the "created_time" field.
-func (m *SettingsMutation) ResetCreatedTime() {
+func (m *DNSRecordMutation) ResetCreatedTime() {
 	m.created_time = nil
 }
 
 // SetUpdatedTime sets the "updated_time" field.
-func (m *SettingsMutation) SetUpdatedTime(t time.Time) {
+func (m *DNSRecordMutation) SetUpdatedTime(t time.Time) {
 	m.updated_time = &t
 }
 
 // UpdatedTime returns the value of the "updated_time" field in the mutation.
-func (m *SettingsMutation) UpdatedTime() (r time.Time, exists bool) {
+func (m *DNSRecordMutation) UpdatedTime() (r time.Time, exists bool) {
 	v := m.updated_time
 	if v == nil {
 		return
@@ -859,10 +1099,10 @@ func (m *SettingsMutation) UpdatedTime() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedTime returns the old "updated_time" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedTime returns the old "updated_time" field's value of the DNSRecord entity.
+// If the DNSRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldUpdatedTime(ctx context.Context) (v time.Time, err error) {
+func (m *DNSRecordMutation) OldUpdatedTime(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedTime is only allowed on UpdateOne operations")
 	}
@@ -877,329 +1117,5201 @@ func (m *SettingsMutation) OldUpdatedTime(ctx context.Context) (v time.Time, err
 }
 
 // ResetUpdatedTime resets all changes to the "updated_time" field.
-func (m *SettingsMutation) ResetUpdatedTime() {
+func (m *DNSRecordMutation) ResetUpdatedTime() {
 	m.updated_time = nil
 }
 
-// SetDomainZone sets the "domain_zone" field.
-func (m *SettingsMutation) SetDomainZone(s string) {
-	m.domain_zone = &s
+// SetName sets the "name" field.
+func (m *DNSRecordMutation) SetName(s string) {
+	m.name = &s
 }
 
-// DomainZone returns the value of the "domain_zone" field in the mutation.
-func (m *SettingsMutation) DomainZone() (r string, exists bool) {
-	v := m.domain_zone
+// Name returns the value of the "name" field in the mutation.
+func (m *DNSRecordMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDomainZone returns the old "domain_zone" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the DNSRecord entity.
+// If the DNSRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldDomainZone(ctx context.Context) (v string, err error) {
+func (m *DNSRecordMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDomainZone is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDomainZone requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDomainZone: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.DomainZone, nil
+	return oldValue.Name, nil
 }
 
-// ClearDomainZone clears the value of the "domain_zone" field.
-func (m *SettingsMutation) ClearDomainZone() {
-	m.domain_zone = nil
-	m.clearedFields[settings.FieldDomainZone] = struct{}{}
+// ResetName resets all changes to the "name" field.
+func (m *DNSRecordMutation) ResetName() {
+	m.name = nil
 }
 
-// DomainZoneCleared returns if the "domain_zone" field was cleared in this mutation.
-func (m *SettingsMutation) DomainZoneCleared() bool {
-	_, ok := m.clearedFields[settings.FieldDomainZone]
-	return ok
+// SetType sets the "type" field.
+func (m *DNSRecordMutation) SetType(d dnsrecord.Type) {
+	m._type = &d
 }
 
-// ResetDomainZone resets all changes to the "domain_zone" field.
-func (m *SettingsMutation) ResetDomainZone() {
-	m.domain_zone = nil
-	delete(m.clearedFields, settings.FieldDomainZone)
+// GetType returns the value of the "type" field in the mutation.
+func (m *DNSRecordMutation) GetType() (r dnsrecord.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCipher sets the "cipher" field.
-func (m *SettingsMutation) SetCipher(s string) {
-	m.cipher = &s
+// OldType returns the old "type" field's value of the DNSRecord entity.
+// If the DNSRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DNSRecordMutation) OldType(ctx context.Context) (v dnsrecord.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *DNSRecordMutation) ResetType() {
+	m._type = nil
+}
+
+// SetValue sets the "value" field.
+func (m *DNSRecordMutation) SetValue(s string) {
+	m.value = &s
+}
+
+// Value returns the value of the "value" field in the mutation.
+func (m *DNSRecordMutation) Value() (r string, exists bool) {
+	v := m.value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValue returns the old "value" field's value of the DNSRecord entity.
+// If the DNSRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DNSRecordMutation) OldValue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValue: %w", err)
+	}
+	return oldValue.Value, nil
+}
+
+// ResetValue resets all changes to the "value" field.
+func (m *DNSRecordMutation) ResetValue() {
+	m.value = nil
+}
+
+// Where appends a list predicates to the DNSRecordMutation builder.
+func (m *DNSRecordMutation) Where(ps ...predicate.DNSRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DNSRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DNSRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DNSRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DNSRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DNSRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DNSRecord).
+func (m *DNSRecordMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DNSRecordMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_time != nil {
+		fields = append(fields, dnsrecord.FieldCreatedTime)
+	}
+	if m.updated_time != nil {
+		fields = append(fields, dnsrecord.FieldUpdatedTime)
+	}
+	if m.name != nil {
+		fields = append(fields, dnsrecord.FieldName)
+	}
+	if m._type != nil {
+		fields = append(fields, dnsrecord.FieldType)
+	}
+	if m.value != nil {
+		fields = append(fields, dnsrecord.FieldValue)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DNSRecordMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case dnsrecord.FieldCreatedTime:
+		return m.CreatedTime()
+	case dnsrecord.FieldUpdatedTime:
+		return m.UpdatedTime()
+	case dnsrecord.FieldName:
+		return m.Name()
+	case dnsrecord.FieldType:
+		return m.GetType()
+	case dnsrecord.FieldValue:
+		return m.Value()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DNSRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case dnsrecord.FieldCreatedTime:
+		return m.OldCreatedTime(ctx)
+	case dnsrecord.FieldUpdatedTime:
+		return m.OldUpdatedTime(ctx)
+	case dnsrecord.FieldName:
+		return m.OldName(ctx)
+	case dnsrecord.FieldType:
+		return m.OldType(ctx)
+	case dnsrecord.FieldValue:
+		return m.OldValue(ctx)
+	}
+	return nil, fmt.Errorf("unknown DNSRecord field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DNSRecordMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case dnsrecord.FieldCreatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedTime(v)
+		return nil
+	case dnsrecord.FieldUpdatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedTime(v)
+		return nil
+	case dnsrecord.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case dnsrecord.FieldType:
+		v, ok := value.(dnsrecord.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case dnsrecord.FieldValue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValue(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DNSRecord field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DNSRecordMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DNSRecordMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DNSRecordMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown DNSRecord numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DNSRecordMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DNSRecordMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DNSRecordMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown DNSRecord nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DNSRecordMutation) ResetField(name string) error {
+	switch name {
+	case dnsrecord.FieldCreatedTime:
+		m.ResetCreatedTime()
+		return nil
+	case dnsrecord.FieldUpdatedTime:
+		m.ResetUpdatedTime()
+		return nil
+	case dnsrecord.FieldName:
+		m.ResetName()
+		return nil
+	case dnsrecord.FieldType:
+		m.ResetType()
+		return nil
+	case dnsrecord.FieldValue:
+		m.ResetValue()
+		return nil
+	}
+	return fmt.Errorf("unknown DNSRecord field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DNSRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DNSRecordMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DNSRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DNSRecordMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DNSRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DNSRecordMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DNSRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DNSRecord unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DNSRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DNSRecord edge %s", name)
+}
+
+// DeviceMutation represents an operation that mutates the Device nodes in the graph.
+type DeviceMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *int
+	created_time           *time.Time
+	updated_time           *time.Time
+	name                   *string
+	ip                     *ipconv.IP
+	addip                  *ipconv.IP
+	leased_access_token    *string
+	token                  *helpers.EncryptedBytes
+	single_use_token       *bool
+	groups                 *[]string
+	appendgroups           []string
+	advertise_routes       *[]string
+	appendadvertise_routes []string
+	exit_node              *bool
+	relay                  *bool
+	lighthouse             *bool
+	public_endpoint        *string
+	last_provision_time    *time.Time
+	last_seen_time         *time.Time
+	underlay_addr          *string
+	client_version         *string
+	os                     *string
+	arch                   *string
+	clearedFields          map[string]struct{}
+	done                   bool
+	oldValue               func(context.Context) (*Device, error)
+	predicates             []predicate.Device
+}
+
+var _ ent.Mutation = (*DeviceMutation)(nil)
+
+// deviceOption allows management of the mutation configuration using functional options.
+type deviceOption func(*DeviceMutation)
+
+// newDeviceMutation creates new mutation for the Device entity.
+func newDeviceMutation(c config, op Op, opts ...deviceOption) *DeviceMutation {
+	m := &DeviceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDevice,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceID sets the ID field of the mutation.
+func withDeviceID(id int) deviceOption {
+	return func(m *DeviceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Device
+		)
+		m.oldValue = func(ctx context.Context) (*Device, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Device.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDevice sets the old Device of the mutation.
+func withDevice(node *Device) deviceOption {
+	return func(m *DeviceMutation) {
+		m.oldValue = func(context.Context) (*Device, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Device.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (m *DeviceMutation) SetCreatedTime(t time.Time) {
+	m.created_time = &t
+}
+
+// CreatedTime returns the value of the "created_time" field in the mutation.
+func (m *DeviceMutation) CreatedTime() (r time.Time, exists bool) {
+	v := m.created_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedTime returns the old "created_time" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedTime: %w", err)
+	}
+	return oldValue.CreatedTime, nil
+}
+
+// ResetCreatedTime resets all changes to the "created_time" field.
+func (m *DeviceMutation) ResetCreatedTime() {
+	m.created_time = nil
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (m *DeviceMutation) SetUpdatedTime(t time.Time) {
+	m.updated_time = &t
+}
+
+// UpdatedTime returns the value of the "updated_time" field in the mutation.
+func (m *DeviceMutation) UpdatedTime() (r time.Time, exists bool) {
+	v := m.updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedTime returns the old "updated_time" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldUpdatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedTime: %w", err)
+	}
+	return oldValue.UpdatedTime, nil
+}
+
+// ResetUpdatedTime resets all changes to the "updated_time" field.
+func (m *DeviceMutation) ResetUpdatedTime() {
+	m.updated_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *DeviceMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *DeviceMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *DeviceMutation) ResetName() {
+	m.name = nil
+}
+
+// SetIP sets the "ip" field.
+func (m *DeviceMutation) SetIP(i ipconv.IP) {
+	m.ip = &i
+	m.addip = nil
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *DeviceMutation) IP() (r ipconv.IP, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldIP(ctx context.Context) (v ipconv.IP, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// AddIP adds i to the "ip" field.
+func (m *DeviceMutation) AddIP(i ipconv.IP) {
+	if m.addip != nil {
+		*m.addip += i
+	} else {
+		m.addip = &i
+	}
+}
+
+// AddedIP returns the value that was added to the "ip" field in this mutation.
+func (m *DeviceMutation) AddedIP() (r ipconv.IP, exists bool) {
+	v := m.addip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *DeviceMutation) ResetIP() {
+	m.ip = nil
+	m.addip = nil
+}
+
+// SetLeasedAccessToken sets the "leased_access_token" field.
+func (m *DeviceMutation) SetLeasedAccessToken(s string) {
+	m.leased_access_token = &s
+}
+
+// LeasedAccessToken returns the value of the "leased_access_token" field in the mutation.
+func (m *DeviceMutation) LeasedAccessToken() (r string, exists bool) {
+	v := m.leased_access_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLeasedAccessToken returns the old "leased_access_token" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldLeasedAccessToken(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLeasedAccessToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLeasedAccessToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLeasedAccessToken: %w", err)
+	}
+	return oldValue.LeasedAccessToken, nil
+}
+
+// ClearLeasedAccessToken clears the value of the "leased_access_token" field.
+func (m *DeviceMutation) ClearLeasedAccessToken() {
+	m.leased_access_token = nil
+	m.clearedFields[device.FieldLeasedAccessToken] = struct{}{}
+}
+
+// LeasedAccessTokenCleared returns if the "leased_access_token" field was cleared in this mutation.
+func (m *DeviceMutation) LeasedAccessTokenCleared() bool {
+	_, ok := m.clearedFields[device.FieldLeasedAccessToken]
+	return ok
+}
+
+// ResetLeasedAccessToken resets all changes to the "leased_access_token" field.
+func (m *DeviceMutation) ResetLeasedAccessToken() {
+	m.leased_access_token = nil
+	delete(m.clearedFields, device.FieldLeasedAccessToken)
+}
+
+// SetToken sets the "token" field.
+func (m *DeviceMutation) SetToken(hb helpers.EncryptedBytes) {
+	m.token = &hb
+}
+
+// Token returns the value of the "token" field in the mutation.
+func (m *DeviceMutation) Token() (r helpers.EncryptedBytes, exists bool) {
+	v := m.token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToken returns the old "token" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldToken(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToken: %w", err)
+	}
+	return oldValue.Token, nil
+}
+
+// ResetToken resets all changes to the "token" field.
+func (m *DeviceMutation) ResetToken() {
+	m.token = nil
+}
+
+// SetSingleUseToken sets the "single_use_token" field.
+func (m *DeviceMutation) SetSingleUseToken(b bool) {
+	m.single_use_token = &b
+}
+
+// SingleUseToken returns the value of the "single_use_token" field in the mutation.
+func (m *DeviceMutation) SingleUseToken() (r bool, exists bool) {
+	v := m.single_use_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSingleUseToken returns the old "single_use_token" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldSingleUseToken(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSingleUseToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSingleUseToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSingleUseToken: %w", err)
+	}
+	return oldValue.SingleUseToken, nil
+}
+
+// ResetSingleUseToken resets all changes to the "single_use_token" field.
+func (m *DeviceMutation) ResetSingleUseToken() {
+	m.single_use_token = nil
+}
+
+// SetGroups sets the "groups" field.
+func (m *DeviceMutation) SetGroups(s []string) {
+	m.groups = &s
+	m.appendgroups = nil
+}
+
+// Groups returns the value of the "groups" field in the mutation.
+func (m *DeviceMutation) Groups() (r []string, exists bool) {
+	v := m.groups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroups returns the old "groups" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldGroups(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroups: %w", err)
+	}
+	return oldValue.Groups, nil
+}
+
+// AppendGroups adds s to the "groups" field.
+func (m *DeviceMutation) AppendGroups(s []string) {
+	m.appendgroups = append(m.appendgroups, s...)
+}
+
+// AppendedGroups returns the list of values that were appended to the "groups" field in this mutation.
+func (m *DeviceMutation) AppendedGroups() ([]string, bool) {
+	if len(m.appendgroups) == 0 {
+		return nil, false
+	}
+	return m.appendgroups, true
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (m *DeviceMutation) ClearGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	m.clearedFields[device.FieldGroups] = struct{}{}
+}
+
+// GroupsCleared returns if the "groups" field was cleared in this mutation.
+func (m *DeviceMutation) GroupsCleared() bool {
+	_, ok := m.clearedFields[device.FieldGroups]
+	return ok
+}
+
+// ResetGroups resets all changes to the "groups" field.
+func (m *DeviceMutation) ResetGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	delete(m.clearedFields, device.FieldGroups)
+}
+
+// SetAdvertiseRoutes sets the "advertise_routes" field.
+func (m *DeviceMutation) SetAdvertiseRoutes(s []string) {
+	m.advertise_routes = &s
+	m.appendadvertise_routes = nil
+}
+
+// AdvertiseRoutes returns the value of the "advertise_routes" field in the mutation.
+func (m *DeviceMutation) AdvertiseRoutes() (r []string, exists bool) {
+	v := m.advertise_routes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAdvertiseRoutes returns the old "advertise_routes" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldAdvertiseRoutes(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAdvertiseRoutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAdvertiseRoutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAdvertiseRoutes: %w", err)
+	}
+	return oldValue.AdvertiseRoutes, nil
+}
+
+// AppendAdvertiseRoutes adds s to the "advertise_routes" field.
+func (m *DeviceMutation) AppendAdvertiseRoutes(s []string) {
+	m.appendadvertise_routes = append(m.appendadvertise_routes, s...)
+}
+
+// AppendedAdvertiseRoutes returns the list of values that were appended to the "advertise_routes" field in this mutation.
+func (m *DeviceMutation) AppendedAdvertiseRoutes() ([]string, bool) {
+	if len(m.appendadvertise_routes) == 0 {
+		return nil, false
+	}
+	return m.appendadvertise_routes, true
+}
+
+// ClearAdvertiseRoutes clears the value of the "advertise_routes" field.
+func (m *DeviceMutation) ClearAdvertiseRoutes() {
+	m.advertise_routes = nil
+	m.appendadvertise_routes = nil
+	m.clearedFields[device.FieldAdvertiseRoutes] = struct{}{}
+}
+
+// AdvertiseRoutesCleared returns if the "advertise_routes" field was cleared in this mutation.
+func (m *DeviceMutation) AdvertiseRoutesCleared() bool {
+	_, ok := m.clearedFields[device.FieldAdvertiseRoutes]
+	return ok
+}
+
+// ResetAdvertiseRoutes resets all changes to the "advertise_routes" field.
+func (m *DeviceMutation) ResetAdvertiseRoutes() {
+	m.advertise_routes = nil
+	m.appendadvertise_routes = nil
+	delete(m.clearedFields, device.FieldAdvertiseRoutes)
+}
+
+// SetExitNode sets the "exit_node" field.
+func (m *DeviceMutation) SetExitNode(b bool) {
+	m.exit_node = &b
+}
+
+// ExitNode returns the value of the "exit_node" field in the mutation.
+func (m *DeviceMutation) ExitNode() (r bool, exists bool) {
+	v := m.exit_node
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitNode returns the old "exit_node" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldExitNode(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitNode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitNode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitNode: %w", err)
+	}
+	return oldValue.ExitNode, nil
+}
+
+// ResetExitNode resets all changes to the "exit_node" field.
+func (m *DeviceMutation) ResetExitNode() {
+	m.exit_node = nil
+}
+
+// SetRelay sets the "relay" field.
+func (m *DeviceMutation) SetRelay(b bool) {
+	m.relay = &b
+}
+
+// Relay returns the value of the "relay" field in the mutation.
+func (m *DeviceMutation) Relay() (r bool, exists bool) {
+	v := m.relay
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRelay returns the old "relay" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldRelay(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRelay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRelay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRelay: %w", err)
+	}
+	return oldValue.Relay, nil
+}
+
+// ResetRelay resets all changes to the "relay" field.
+func (m *DeviceMutation) ResetRelay() {
+	m.relay = nil
+}
+
+// SetLighthouse sets the "lighthouse" field.
+func (m *DeviceMutation) SetLighthouse(b bool) {
+	m.lighthouse = &b
+}
+
+// Lighthouse returns the value of the "lighthouse" field in the mutation.
+func (m *DeviceMutation) Lighthouse() (r bool, exists bool) {
+	v := m.lighthouse
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLighthouse returns the old "lighthouse" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldLighthouse(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLighthouse is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLighthouse requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLighthouse: %w", err)
+	}
+	return oldValue.Lighthouse, nil
+}
+
+// ResetLighthouse resets all changes to the "lighthouse" field.
+func (m *DeviceMutation) ResetLighthouse() {
+	m.lighthouse = nil
+}
+
+// SetPublicEndpoint sets the "public_endpoint" field.
+func (m *DeviceMutation) SetPublicEndpoint(s string) {
+	m.public_endpoint = &s
+}
+
+// PublicEndpoint returns the value of the "public_endpoint" field in the mutation.
+func (m *DeviceMutation) PublicEndpoint() (r string, exists bool) {
+	v := m.public_endpoint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublicEndpoint returns the old "public_endpoint" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldPublicEndpoint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublicEndpoint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublicEndpoint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublicEndpoint: %w", err)
+	}
+	return oldValue.PublicEndpoint, nil
+}
+
+// ClearPublicEndpoint clears the value of the "public_endpoint" field.
+func (m *DeviceMutation) ClearPublicEndpoint() {
+	m.public_endpoint = nil
+	m.clearedFields[device.FieldPublicEndpoint] = struct{}{}
+}
+
+// PublicEndpointCleared returns if the "public_endpoint" field was cleared in this mutation.
+func (m *DeviceMutation) PublicEndpointCleared() bool {
+	_, ok := m.clearedFields[device.FieldPublicEndpoint]
+	return ok
+}
+
+// ResetPublicEndpoint resets all changes to the "public_endpoint" field.
+func (m *DeviceMutation) ResetPublicEndpoint() {
+	m.public_endpoint = nil
+	delete(m.clearedFields, device.FieldPublicEndpoint)
+}
+
+// SetLastProvisionTime sets the "last_provision_time" field.
+func (m *DeviceMutation) SetLastProvisionTime(t time.Time) {
+	m.last_provision_time = &t
+}
+
+// LastProvisionTime returns the value of the "last_provision_time" field in the mutation.
+func (m *DeviceMutation) LastProvisionTime() (r time.Time, exists bool) {
+	v := m.last_provision_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastProvisionTime returns the old "last_provision_time" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldLastProvisionTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastProvisionTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastProvisionTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastProvisionTime: %w", err)
+	}
+	return oldValue.LastProvisionTime, nil
+}
+
+// ClearLastProvisionTime clears the value of the "last_provision_time" field.
+func (m *DeviceMutation) ClearLastProvisionTime() {
+	m.last_provision_time = nil
+	m.clearedFields[device.FieldLastProvisionTime] = struct{}{}
+}
+
+// LastProvisionTimeCleared returns if the "last_provision_time" field was cleared in this mutation.
+func (m *DeviceMutation) LastProvisionTimeCleared() bool {
+	_, ok := m.clearedFields[device.FieldLastProvisionTime]
+	return ok
+}
+
+// ResetLastProvisionTime resets all changes to the "last_provision_time" field.
+func (m *DeviceMutation) ResetLastProvisionTime() {
+	m.last_provision_time = nil
+	delete(m.clearedFields, device.FieldLastProvisionTime)
+}
+
+// SetLastSeenTime sets the "last_seen_time" field.
+func (m *DeviceMutation) SetLastSeenTime(t time.Time) {
+	m.last_seen_time = &t
+}
+
+// LastSeenTime returns the value of the "last_seen_time" field in the mutation.
+func (m *DeviceMutation) LastSeenTime() (r time.Time, exists bool) {
+	v := m.last_seen_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastSeenTime returns the old "last_seen_time" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldLastSeenTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastSeenTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastSeenTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastSeenTime: %w", err)
+	}
+	return oldValue.LastSeenTime, nil
+}
+
+// ClearLastSeenTime clears the value of the "last_seen_time" field.
+func (m *DeviceMutation) ClearLastSeenTime() {
+	m.last_seen_time = nil
+	m.clearedFields[device.FieldLastSeenTime] = struct{}{}
+}
+
+// LastSeenTimeCleared returns if the "last_seen_time" field was cleared in this mutation.
+func (m *DeviceMutation) LastSeenTimeCleared() bool {
+	_, ok := m.clearedFields[device.FieldLastSeenTime]
+	return ok
+}
+
+// ResetLastSeenTime resets all changes to the "last_seen_time" field.
+func (m *DeviceMutation) ResetLastSeenTime() {
+	m.last_seen_time = nil
+	delete(m.clearedFields, device.FieldLastSeenTime)
+}
+
+// SetUnderlayAddr sets the "underlay_addr" field.
+func (m *DeviceMutation) SetUnderlayAddr(s string) {
+	m.underlay_addr = &s
+}
+
+// UnderlayAddr returns the value of the "underlay_addr" field in the mutation.
+func (m *DeviceMutation) UnderlayAddr() (r string, exists bool) {
+	v := m.underlay_addr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnderlayAddr returns the old "underlay_addr" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldUnderlayAddr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnderlayAddr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnderlayAddr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnderlayAddr: %w", err)
+	}
+	return oldValue.UnderlayAddr, nil
+}
+
+// ClearUnderlayAddr clears the value of the "underlay_addr" field.
+func (m *DeviceMutation) ClearUnderlayAddr() {
+	m.underlay_addr = nil
+	m.clearedFields[device.FieldUnderlayAddr] = struct{}{}
+}
+
+// UnderlayAddrCleared returns if the "underlay_addr" field was cleared in this mutation.
+func (m *DeviceMutation) UnderlayAddrCleared() bool {
+	_, ok := m.clearedFields[device.FieldUnderlayAddr]
+	return ok
+}
+
+// ResetUnderlayAddr resets all changes to the "underlay_addr" field.
+func (m *DeviceMutation) ResetUnderlayAddr() {
+	m.underlay_addr = nil
+	delete(m.clearedFields, device.FieldUnderlayAddr)
+}
+
+// SetClientVersion sets the "client_version" field.
+func (m *DeviceMutation) SetClientVersion(s string) {
+	m.client_version = &s
+}
+
+// ClientVersion returns the value of the "client_version" field in the mutation.
+func (m *DeviceMutation) ClientVersion() (r string, exists bool) {
+	v := m.client_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientVersion returns the old "client_version" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldClientVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientVersion: %w", err)
+	}
+	return oldValue.ClientVersion, nil
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (m *DeviceMutation) ClearClientVersion() {
+	m.client_version = nil
+	m.clearedFields[device.FieldClientVersion] = struct{}{}
+}
+
+// ClientVersionCleared returns if the "client_version" field was cleared in this mutation.
+func (m *DeviceMutation) ClientVersionCleared() bool {
+	_, ok := m.clearedFields[device.FieldClientVersion]
+	return ok
+}
+
+// ResetClientVersion resets all changes to the "client_version" field.
+func (m *DeviceMutation) ResetClientVersion() {
+	m.client_version = nil
+	delete(m.clearedFields, device.FieldClientVersion)
+}
+
+// SetOs sets the "os" field.
+func (m *DeviceMutation) SetOs(s string) {
+	m.os = &s
+}
+
+// Os returns the value of the "os" field in the mutation.
+func (m *DeviceMutation) Os() (r string, exists bool) {
+	v := m.os
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOs returns the old "os" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldOs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOs: %w", err)
+	}
+	return oldValue.Os, nil
+}
+
+// ClearOs clears the value of the "os" field.
+func (m *DeviceMutation) ClearOs() {
+	m.os = nil
+	m.clearedFields[device.FieldOs] = struct{}{}
+}
+
+// OsCleared returns if the "os" field was cleared in this mutation.
+func (m *DeviceMutation) OsCleared() bool {
+	_, ok := m.clearedFields[device.FieldOs]
+	return ok
+}
+
+// ResetOs resets all changes to the "os" field.
+func (m *DeviceMutation) ResetOs() {
+	m.os = nil
+	delete(m.clearedFields, device.FieldOs)
+}
+
+// SetArch sets the "arch" field.
+func (m *DeviceMutation) SetArch(s string) {
+	m.arch = &s
+}
+
+// Arch returns the value of the "arch" field in the mutation.
+func (m *DeviceMutation) Arch() (r string, exists bool) {
+	v := m.arch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArch returns the old "arch" field's value of the Device entity.
+// If the Device object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceMutation) OldArch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArch: %w", err)
+	}
+	return oldValue.Arch, nil
+}
+
+// ClearArch clears the value of the "arch" field.
+func (m *DeviceMutation) ClearArch() {
+	m.arch = nil
+	m.clearedFields[device.FieldArch] = struct{}{}
+}
+
+// ArchCleared returns if the "arch" field was cleared in this mutation.
+func (m *DeviceMutation) ArchCleared() bool {
+	_, ok := m.clearedFields[device.FieldArch]
+	return ok
+}
+
+// ResetArch resets all changes to the "arch" field.
+func (m *DeviceMutation) ResetArch() {
+	m.arch = nil
+	delete(m.clearedFields, device.FieldArch)
+}
+
+// Where appends a list predicates to the DeviceMutation builder.
+func (m *DeviceMutation) Where(ps ...predicate.Device) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Device, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Device).
+func (m *DeviceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.created_time != nil {
+		fields = append(fields, device.FieldCreatedTime)
+	}
+	if m.updated_time != nil {
+		fields = append(fields, device.FieldUpdatedTime)
+	}
+	if m.name != nil {
+		fields = append(fields, device.FieldName)
+	}
+	if m.ip != nil {
+		fields = append(fields, device.FieldIP)
+	}
+	if m.leased_access_token != nil {
+		fields = append(fields, device.FieldLeasedAccessToken)
+	}
+	if m.token != nil {
+		fields = append(fields, device.FieldToken)
+	}
+	if m.single_use_token != nil {
+		fields = append(fields, device.FieldSingleUseToken)
+	}
+	if m.groups != nil {
+		fields = append(fields, device.FieldGroups)
+	}
+	if m.advertise_routes != nil {
+		fields = append(fields, device.FieldAdvertiseRoutes)
+	}
+	if m.exit_node != nil {
+		fields = append(fields, device.FieldExitNode)
+	}
+	if m.relay != nil {
+		fields = append(fields, device.FieldRelay)
+	}
+	if m.lighthouse != nil {
+		fields = append(fields, device.FieldLighthouse)
+	}
+	if m.public_endpoint != nil {
+		fields = append(fields, device.FieldPublicEndpoint)
+	}
+	if m.last_provision_time != nil {
+		fields = append(fields, device.FieldLastProvisionTime)
+	}
+	if m.last_seen_time != nil {
+		fields = append(fields, device.FieldLastSeenTime)
+	}
+	if m.underlay_addr != nil {
+		fields = append(fields, device.FieldUnderlayAddr)
+	}
+	if m.client_version != nil {
+		fields = append(fields, device.FieldClientVersion)
+	}
+	if m.os != nil {
+		fields = append(fields, device.FieldOs)
+	}
+	if m.arch != nil {
+		fields = append(fields, device.FieldArch)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case device.FieldCreatedTime:
+		return m.CreatedTime()
+	case device.FieldUpdatedTime:
+		return m.UpdatedTime()
+	case device.FieldName:
+		return m.Name()
+	case device.FieldIP:
+		return m.IP()
+	case device.FieldLeasedAccessToken:
+		return m.LeasedAccessToken()
+	case device.FieldToken:
+		return m.Token()
+	case device.FieldSingleUseToken:
+		return m.SingleUseToken()
+	case device.FieldGroups:
+		return m.Groups()
+	case device.FieldAdvertiseRoutes:
+		return m.AdvertiseRoutes()
+	case device.FieldExitNode:
+		return m.ExitNode()
+	case device.FieldRelay:
+		return m.Relay()
+	case device.FieldLighthouse:
+		return m.Lighthouse()
+	case device.FieldPublicEndpoint:
+		return m.PublicEndpoint()
+	case device.FieldLastProvisionTime:
+		return m.LastProvisionTime()
+	case device.FieldLastSeenTime:
+		return m.LastSeenTime()
+	case device.FieldUnderlayAddr:
+		return m.UnderlayAddr()
+	case device.FieldClientVersion:
+		return m.ClientVersion()
+	case device.FieldOs:
+		return m.Os()
+	case device.FieldArch:
+		return m.Arch()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case device.FieldCreatedTime:
+		return m.OldCreatedTime(ctx)
+	case device.FieldUpdatedTime:
+		return m.OldUpdatedTime(ctx)
+	case device.FieldName:
+		return m.OldName(ctx)
+	case device.FieldIP:
+		return m.OldIP(ctx)
+	case device.FieldLeasedAccessToken:
+		return m.OldLeasedAccessToken(ctx)
+	case device.FieldToken:
+		return m.OldToken(ctx)
+	case device.FieldSingleUseToken:
+		return m.OldSingleUseToken(ctx)
+	case device.FieldGroups:
+		return m.OldGroups(ctx)
+	case device.FieldAdvertiseRoutes:
+		return m.OldAdvertiseRoutes(ctx)
+	case device.FieldExitNode:
+		return m.OldExitNode(ctx)
+	case device.FieldRelay:
+		return m.OldRelay(ctx)
+	case device.FieldLighthouse:
+		return m.OldLighthouse(ctx)
+	case device.FieldPublicEndpoint:
+		return m.OldPublicEndpoint(ctx)
+	case device.FieldLastProvisionTime:
+		return m.OldLastProvisionTime(ctx)
+	case device.FieldLastSeenTime:
+		return m.OldLastSeenTime(ctx)
+	case device.FieldUnderlayAddr:
+		return m.OldUnderlayAddr(ctx)
+	case device.FieldClientVersion:
+		return m.OldClientVersion(ctx)
+	case device.FieldOs:
+		return m.OldOs(ctx)
+	case device.FieldArch:
+		return m.OldArch(ctx)
+	}
+	return nil, fmt.Errorf("unknown Device field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case device.FieldCreatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedTime(v)
+		return nil
+	case device.FieldUpdatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedTime(v)
+		return nil
+	case device.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case device.FieldIP:
+		v, ok := value.(ipconv.IP)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIP(v)
+		return nil
+	case device.FieldLeasedAccessToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLeasedAccessToken(v)
+		return nil
+	case device.FieldToken:
+		v, ok := value.(helpers.EncryptedBytes)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToken(v)
+		return nil
+	case device.FieldSingleUseToken:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSingleUseToken(v)
+		return nil
+	case device.FieldGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroups(v)
+		return nil
+	case device.FieldAdvertiseRoutes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAdvertiseRoutes(v)
+		return nil
+	case device.FieldExitNode:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitNode(v)
+		return nil
+	case device.FieldRelay:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRelay(v)
+		return nil
+	case device.FieldLighthouse:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLighthouse(v)
+		return nil
+	case device.FieldPublicEndpoint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicEndpoint(v)
+		return nil
+	case device.FieldLastProvisionTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastProvisionTime(v)
+		return nil
+	case device.FieldLastSeenTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastSeenTime(v)
+		return nil
+	case device.FieldUnderlayAddr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnderlayAddr(v)
+		return nil
+	case device.FieldClientVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientVersion(v)
+		return nil
+	case device.FieldOs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOs(v)
+		return nil
+	case device.FieldArch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArch(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Device field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceMutation) AddedFields() []string {
+	var fields []string
+	if m.addip != nil {
+		fields = append(fields, device.FieldIP)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case device.FieldIP:
+		return m.AddedIP()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case device.FieldIP:
+		v, ok := value.(ipconv.IP)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddIP(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Device numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(device.FieldLeasedAccessToken) {
+		fields = append(fields, device.FieldLeasedAccessToken)
+	}
+	if m.FieldCleared(device.FieldGroups) {
+		fields = append(fields, device.FieldGroups)
+	}
+	if m.FieldCleared(device.FieldAdvertiseRoutes) {
+		fields = append(fields, device.FieldAdvertiseRoutes)
+	}
+	if m.FieldCleared(device.FieldPublicEndpoint) {
+		fields = append(fields, device.FieldPublicEndpoint)
+	}
+	if m.FieldCleared(device.FieldLastProvisionTime) {
+		fields = append(fields, device.FieldLastProvisionTime)
+	}
+	if m.FieldCleared(device.FieldLastSeenTime) {
+		fields = append(fields, device.FieldLastSeenTime)
+	}
+	if m.FieldCleared(device.FieldUnderlayAddr) {
+		fields = append(fields, device.FieldUnderlayAddr)
+	}
+	if m.FieldCleared(device.FieldClientVersion) {
+		fields = append(fields, device.FieldClientVersion)
+	}
+	if m.FieldCleared(device.FieldOs) {
+		fields = append(fields, device.FieldOs)
+	}
+	if m.FieldCleared(device.FieldArch) {
+		fields = append(fields, device.FieldArch)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceMutation) ClearField(name string) error {
+	switch name {
+	case device.FieldLeasedAccessToken:
+		m.ClearLeasedAccessToken()
+		return nil
+	case device.FieldGroups:
+		m.ClearGroups()
+		return nil
+	case device.FieldAdvertiseRoutes:
+		m.ClearAdvertiseRoutes()
+		return nil
+	case device.FieldPublicEndpoint:
+		m.ClearPublicEndpoint()
+		return nil
+	case device.FieldLastProvisionTime:
+		m.ClearLastProvisionTime()
+		return nil
+	case device.FieldLastSeenTime:
+		m.ClearLastSeenTime()
+		return nil
+	case device.FieldUnderlayAddr:
+		m.ClearUnderlayAddr()
+		return nil
+	case device.FieldClientVersion:
+		m.ClearClientVersion()
+		return nil
+	case device.FieldOs:
+		m.ClearOs()
+		return nil
+	case device.FieldArch:
+		m.ClearArch()
+		return nil
+	}
+	return fmt.Errorf("unknown Device nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceMutation) ResetField(name string) error {
+	switch name {
+	case device.FieldCreatedTime:
+		m.ResetCreatedTime()
+		return nil
+	case device.FieldUpdatedTime:
+		m.ResetUpdatedTime()
+		return nil
+	case device.FieldName:
+		m.ResetName()
+		return nil
+	case device.FieldIP:
+		m.ResetIP()
+		return nil
+	case device.FieldLeasedAccessToken:
+		m.ResetLeasedAccessToken()
+		return nil
+	case device.FieldToken:
+		m.ResetToken()
+		return nil
+	case device.FieldSingleUseToken:
+		m.ResetSingleUseToken()
+		return nil
+	case device.FieldGroups:
+		m.ResetGroups()
+		return nil
+	case device.FieldAdvertiseRoutes:
+		m.ResetAdvertiseRoutes()
+		return nil
+	case device.FieldExitNode:
+		m.ResetExitNode()
+		return nil
+	case device.FieldRelay:
+		m.ResetRelay()
+		return nil
+	case device.FieldLighthouse:
+		m.ResetLighthouse()
+		return nil
+	case device.FieldPublicEndpoint:
+		m.ResetPublicEndpoint()
+		return nil
+	case device.FieldLastProvisionTime:
+		m.ResetLastProvisionTime()
+		return nil
+	case device.FieldLastSeenTime:
+		m.ResetLastSeenTime()
+		return nil
+	case device.FieldUnderlayAddr:
+		m.ResetUnderlayAddr()
+		return nil
+	case device.FieldClientVersion:
+		m.ResetClientVersion()
+		return nil
+	case device.FieldOs:
+		m.ResetOs()
+		return nil
+	case device.FieldArch:
+		m.ResetArch()
+		return nil
+	}
+	return fmt.Errorf("unknown Device field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Device unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Device edge %s", name)
+}
+
+// DeviceProvisionMutation represents an operation that mutates the DeviceProvision nodes in the graph.
+type DeviceProvisionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *int
+	created_time   *time.Time
+	device_id      *int
+	adddevice_id   *int
+	remote_addr    *string
+	client_version *string
+	os             *string
+	arch           *string
+	client_key     *bool
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*DeviceProvision, error)
+	predicates     []predicate.DeviceProvision
+}
+
+var _ ent.Mutation = (*DeviceProvisionMutation)(nil)
+
+// deviceprovisionOption allows management of the mutation configuration using functional options.
+type deviceprovisionOption func(*DeviceProvisionMutation)
+
+// newDeviceProvisionMutation creates new mutation for the DeviceProvision entity.
+func newDeviceProvisionMutation(c config, op Op, opts ...deviceprovisionOption) *DeviceProvisionMutation {
+	m := &DeviceProvisionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeDeviceProvision,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withDeviceProvisionID sets the ID field of the mutation.
+func withDeviceProvisionID(id int) deviceprovisionOption {
+	return func(m *DeviceProvisionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *DeviceProvision
+		)
+		m.oldValue = func(ctx context.Context) (*DeviceProvision, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().DeviceProvision.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withDeviceProvision sets the old DeviceProvision of the mutation.
+func withDeviceProvision(node *DeviceProvision) deviceprovisionOption {
+	return func(m *DeviceProvisionMutation) {
+		m.oldValue = func(context.Context) (*DeviceProvision, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m DeviceProvisionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m DeviceProvisionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *DeviceProvisionMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *DeviceProvisionMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().DeviceProvision.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (m *DeviceProvisionMutation) SetCreatedTime(t time.Time) {
+	m.created_time = &t
+}
+
+// CreatedTime returns the value of the "created_time" field in the mutation.
+func (m *DeviceProvisionMutation) CreatedTime() (r time.Time, exists bool) {
+	v := m.created_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedTime returns the old "created_time" field's value of the DeviceProvision entity.
+// If the DeviceProvision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceProvisionMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedTime: %w", err)
+	}
+	return oldValue.CreatedTime, nil
+}
+
+// ResetCreatedTime resets all changes to the "created_time" field.
+func (m *DeviceProvisionMutation) ResetCreatedTime() {
+	m.created_time = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *DeviceProvisionMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *DeviceProvisionMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the DeviceProvision entity.
+// If the DeviceProvision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceProvisionMutation) OldDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (m *DeviceProvisionMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
+	}
+}
+
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *DeviceProvisionMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *DeviceProvisionMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+}
+
+// SetRemoteAddr sets the "remote_addr" field.
+func (m *DeviceProvisionMutation) SetRemoteAddr(s string) {
+	m.remote_addr = &s
+}
+
+// RemoteAddr returns the value of the "remote_addr" field in the mutation.
+func (m *DeviceProvisionMutation) RemoteAddr() (r string, exists bool) {
+	v := m.remote_addr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemoteAddr returns the old "remote_addr" field's value of the DeviceProvision entity.
+// If the DeviceProvision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceProvisionMutation) OldRemoteAddr(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemoteAddr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemoteAddr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemoteAddr: %w", err)
+	}
+	return oldValue.RemoteAddr, nil
+}
+
+// ClearRemoteAddr clears the value of the "remote_addr" field.
+func (m *DeviceProvisionMutation) ClearRemoteAddr() {
+	m.remote_addr = nil
+	m.clearedFields[deviceprovision.FieldRemoteAddr] = struct{}{}
+}
+
+// RemoteAddrCleared returns if the "remote_addr" field was cleared in this mutation.
+func (m *DeviceProvisionMutation) RemoteAddrCleared() bool {
+	_, ok := m.clearedFields[deviceprovision.FieldRemoteAddr]
+	return ok
+}
+
+// ResetRemoteAddr resets all changes to the "remote_addr" field.
+func (m *DeviceProvisionMutation) ResetRemoteAddr() {
+	m.remote_addr = nil
+	delete(m.clearedFields, deviceprovision.FieldRemoteAddr)
+}
+
+// SetClientVersion sets the "client_version" field.
+func (m *DeviceProvisionMutation) SetClientVersion(s string) {
+	m.client_version = &s
+}
+
+// ClientVersion returns the value of the "client_version" field in the mutation.
+func (m *DeviceProvisionMutation) ClientVersion() (r string, exists bool) {
+	v := m.client_version
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientVersion returns the old "client_version" field's value of the DeviceProvision entity.
+// If the DeviceProvision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceProvisionMutation) OldClientVersion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientVersion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientVersion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientVersion: %w", err)
+	}
+	return oldValue.ClientVersion, nil
+}
+
+// ClearClientVersion clears the value of the "client_version" field.
+func (m *DeviceProvisionMutation) ClearClientVersion() {
+	m.client_version = nil
+	m.clearedFields[deviceprovision.FieldClientVersion] = struct{}{}
+}
+
+// ClientVersionCleared returns if the "client_version" field was cleared in this mutation.
+func (m *DeviceProvisionMutation) ClientVersionCleared() bool {
+	_, ok := m.clearedFields[deviceprovision.FieldClientVersion]
+	return ok
+}
+
+// ResetClientVersion resets all changes to the "client_version" field.
+func (m *DeviceProvisionMutation) ResetClientVersion() {
+	m.client_version = nil
+	delete(m.clearedFields, deviceprovision.FieldClientVersion)
+}
+
+// SetOs sets the "os" field.
+func (m *DeviceProvisionMutation) SetOs(s string) {
+	m.os = &s
+}
+
+// Os returns the value of the "os" field in the mutation.
+func (m *DeviceProvisionMutation) Os() (r string, exists bool) {
+	v := m.os
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOs returns the old "os" field's value of the DeviceProvision entity.
+// If the DeviceProvision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceProvisionMutation) OldOs(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOs: %w", err)
+	}
+	return oldValue.Os, nil
+}
+
+// ClearOs clears the value of the "os" field.
+func (m *DeviceProvisionMutation) ClearOs() {
+	m.os = nil
+	m.clearedFields[deviceprovision.FieldOs] = struct{}{}
+}
+
+// OsCleared returns if the "os" field was cleared in this mutation.
+func (m *DeviceProvisionMutation) OsCleared() bool {
+	_, ok := m.clearedFields[deviceprovision.FieldOs]
+	return ok
+}
+
+// ResetOs resets all changes to the "os" field.
+func (m *DeviceProvisionMutation) ResetOs() {
+	m.os = nil
+	delete(m.clearedFields, deviceprovision.FieldOs)
+}
+
+// SetArch sets the "arch" field.
+func (m *DeviceProvisionMutation) SetArch(s string) {
+	m.arch = &s
+}
+
+// Arch returns the value of the "arch" field in the mutation.
+func (m *DeviceProvisionMutation) Arch() (r string, exists bool) {
+	v := m.arch
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldArch returns the old "arch" field's value of the DeviceProvision entity.
+// If the DeviceProvision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceProvisionMutation) OldArch(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldArch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldArch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldArch: %w", err)
+	}
+	return oldValue.Arch, nil
+}
+
+// ClearArch clears the value of the "arch" field.
+func (m *DeviceProvisionMutation) ClearArch() {
+	m.arch = nil
+	m.clearedFields[deviceprovision.FieldArch] = struct{}{}
+}
+
+// ArchCleared returns if the "arch" field was cleared in this mutation.
+func (m *DeviceProvisionMutation) ArchCleared() bool {
+	_, ok := m.clearedFields[deviceprovision.FieldArch]
+	return ok
+}
+
+// ResetArch resets all changes to the "arch" field.
+func (m *DeviceProvisionMutation) ResetArch() {
+	m.arch = nil
+	delete(m.clearedFields, deviceprovision.FieldArch)
+}
+
+// SetClientKey sets the "client_key" field.
+func (m *DeviceProvisionMutation) SetClientKey(b bool) {
+	m.client_key = &b
+}
+
+// ClientKey returns the value of the "client_key" field in the mutation.
+func (m *DeviceProvisionMutation) ClientKey() (r bool, exists bool) {
+	v := m.client_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientKey returns the old "client_key" field's value of the DeviceProvision entity.
+// If the DeviceProvision object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *DeviceProvisionMutation) OldClientKey(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientKey: %w", err)
+	}
+	return oldValue.ClientKey, nil
+}
+
+// ResetClientKey resets all changes to the "client_key" field.
+func (m *DeviceProvisionMutation) ResetClientKey() {
+	m.client_key = nil
+}
+
+// Where appends a list predicates to the DeviceProvisionMutation builder.
+func (m *DeviceProvisionMutation) Where(ps ...predicate.DeviceProvision) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the DeviceProvisionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *DeviceProvisionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.DeviceProvision, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *DeviceProvisionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *DeviceProvisionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (DeviceProvision).
+func (m *DeviceProvisionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *DeviceProvisionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.created_time != nil {
+		fields = append(fields, deviceprovision.FieldCreatedTime)
+	}
+	if m.device_id != nil {
+		fields = append(fields, deviceprovision.FieldDeviceID)
+	}
+	if m.remote_addr != nil {
+		fields = append(fields, deviceprovision.FieldRemoteAddr)
+	}
+	if m.client_version != nil {
+		fields = append(fields, deviceprovision.FieldClientVersion)
+	}
+	if m.os != nil {
+		fields = append(fields, deviceprovision.FieldOs)
+	}
+	if m.arch != nil {
+		fields = append(fields, deviceprovision.FieldArch)
+	}
+	if m.client_key != nil {
+		fields = append(fields, deviceprovision.FieldClientKey)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *DeviceProvisionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case deviceprovision.FieldCreatedTime:
+		return m.CreatedTime()
+	case deviceprovision.FieldDeviceID:
+		return m.DeviceID()
+	case deviceprovision.FieldRemoteAddr:
+		return m.RemoteAddr()
+	case deviceprovision.FieldClientVersion:
+		return m.ClientVersion()
+	case deviceprovision.FieldOs:
+		return m.Os()
+	case deviceprovision.FieldArch:
+		return m.Arch()
+	case deviceprovision.FieldClientKey:
+		return m.ClientKey()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *DeviceProvisionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case deviceprovision.FieldCreatedTime:
+		return m.OldCreatedTime(ctx)
+	case deviceprovision.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case deviceprovision.FieldRemoteAddr:
+		return m.OldRemoteAddr(ctx)
+	case deviceprovision.FieldClientVersion:
+		return m.OldClientVersion(ctx)
+	case deviceprovision.FieldOs:
+		return m.OldOs(ctx)
+	case deviceprovision.FieldArch:
+		return m.OldArch(ctx)
+	case deviceprovision.FieldClientKey:
+		return m.OldClientKey(ctx)
+	}
+	return nil, fmt.Errorf("unknown DeviceProvision field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceProvisionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case deviceprovision.FieldCreatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedTime(v)
+		return nil
+	case deviceprovision.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case deviceprovision.FieldRemoteAddr:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemoteAddr(v)
+		return nil
+	case deviceprovision.FieldClientVersion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientVersion(v)
+		return nil
+	case deviceprovision.FieldOs:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOs(v)
+		return nil
+	case deviceprovision.FieldArch:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetArch(v)
+		return nil
+	case deviceprovision.FieldClientKey:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientKey(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceProvision field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *DeviceProvisionMutation) AddedFields() []string {
+	var fields []string
+	if m.adddevice_id != nil {
+		fields = append(fields, deviceprovision.FieldDeviceID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *DeviceProvisionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case deviceprovision.FieldDeviceID:
+		return m.AddedDeviceID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *DeviceProvisionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case deviceprovision.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceProvision numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *DeviceProvisionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(deviceprovision.FieldRemoteAddr) {
+		fields = append(fields, deviceprovision.FieldRemoteAddr)
+	}
+	if m.FieldCleared(deviceprovision.FieldClientVersion) {
+		fields = append(fields, deviceprovision.FieldClientVersion)
+	}
+	if m.FieldCleared(deviceprovision.FieldOs) {
+		fields = append(fields, deviceprovision.FieldOs)
+	}
+	if m.FieldCleared(deviceprovision.FieldArch) {
+		fields = append(fields, deviceprovision.FieldArch)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *DeviceProvisionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *DeviceProvisionMutation) ClearField(name string) error {
+	switch name {
+	case deviceprovision.FieldRemoteAddr:
+		m.ClearRemoteAddr()
+		return nil
+	case deviceprovision.FieldClientVersion:
+		m.ClearClientVersion()
+		return nil
+	case deviceprovision.FieldOs:
+		m.ClearOs()
+		return nil
+	case deviceprovision.FieldArch:
+		m.ClearArch()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceProvision nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *DeviceProvisionMutation) ResetField(name string) error {
+	switch name {
+	case deviceprovision.FieldCreatedTime:
+		m.ResetCreatedTime()
+		return nil
+	case deviceprovision.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case deviceprovision.FieldRemoteAddr:
+		m.ResetRemoteAddr()
+		return nil
+	case deviceprovision.FieldClientVersion:
+		m.ResetClientVersion()
+		return nil
+	case deviceprovision.FieldOs:
+		m.ResetOs()
+		return nil
+	case deviceprovision.FieldArch:
+		m.ResetArch()
+		return nil
+	case deviceprovision.FieldClientKey:
+		m.ResetClientKey()
+		return nil
+	}
+	return fmt.Errorf("unknown DeviceProvision field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *DeviceProvisionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *DeviceProvisionMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *DeviceProvisionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *DeviceProvisionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *DeviceProvisionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *DeviceProvisionMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *DeviceProvisionMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown DeviceProvision unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *DeviceProvisionMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown DeviceProvision edge %s", name)
+}
+
+// EnrollmentKeyMutation represents an operation that mutates the EnrollmentKey nodes in the graph.
+type EnrollmentKeyMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_time  *time.Time
+	updated_time  *time.Time
+	name          *string
+	jti           *string
+	groups        *[]string
+	appendgroups  []string
+	max_uses      *int
+	addmax_uses   *int
+	uses          *int
+	adduses       *int
+	expires_time  *time.Time
+	revoked       *bool
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EnrollmentKey, error)
+	predicates    []predicate.EnrollmentKey
+}
+
+var _ ent.Mutation = (*EnrollmentKeyMutation)(nil)
+
+// enrollmentkeyOption allows management of the mutation configuration using functional options.
+type enrollmentkeyOption func(*EnrollmentKeyMutation)
+
+// newEnrollmentKeyMutation creates new mutation for the EnrollmentKey entity.
+func newEnrollmentKeyMutation(c config, op Op, opts ...enrollmentkeyOption) *EnrollmentKeyMutation {
+	m := &EnrollmentKeyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEnrollmentKey,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEnrollmentKeyID sets the ID field of the mutation.
+func withEnrollmentKeyID(id int) enrollmentkeyOption {
+	return func(m *EnrollmentKeyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EnrollmentKey
+		)
+		m.oldValue = func(ctx context.Context) (*EnrollmentKey, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EnrollmentKey.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEnrollmentKey sets the old EnrollmentKey of the mutation.
+func withEnrollmentKey(node *EnrollmentKey) enrollmentkeyOption {
+	return func(m *EnrollmentKeyMutation) {
+		m.oldValue = func(context.Context) (*EnrollmentKey, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EnrollmentKeyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EnrollmentKeyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EnrollmentKeyMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EnrollmentKeyMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EnrollmentKey.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (m *EnrollmentKeyMutation) SetCreatedTime(t time.Time) {
+	m.created_time = &t
+}
+
+// CreatedTime returns the value of the "created_time" field in the mutation.
+func (m *EnrollmentKeyMutation) CreatedTime() (r time.Time, exists bool) {
+	v := m.created_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedTime returns the old "created_time" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedTime: %w", err)
+	}
+	return oldValue.CreatedTime, nil
+}
+
+// ResetCreatedTime resets all changes to the "created_time" field.
+func (m *EnrollmentKeyMutation) ResetCreatedTime() {
+	m.created_time = nil
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (m *EnrollmentKeyMutation) SetUpdatedTime(t time.Time) {
+	m.updated_time = &t
+}
+
+// UpdatedTime returns the value of the "updated_time" field in the mutation.
+func (m *EnrollmentKeyMutation) UpdatedTime() (r time.Time, exists bool) {
+	v := m.updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedTime returns the old "updated_time" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldUpdatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedTime: %w", err)
+	}
+	return oldValue.UpdatedTime, nil
+}
+
+// ResetUpdatedTime resets all changes to the "updated_time" field.
+func (m *EnrollmentKeyMutation) ResetUpdatedTime() {
+	m.updated_time = nil
+}
+
+// SetName sets the "name" field.
+func (m *EnrollmentKeyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *EnrollmentKeyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *EnrollmentKeyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetJti sets the "jti" field.
+func (m *EnrollmentKeyMutation) SetJti(s string) {
+	m.jti = &s
+}
+
+// Jti returns the value of the "jti" field in the mutation.
+func (m *EnrollmentKeyMutation) Jti() (r string, exists bool) {
+	v := m.jti
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJti returns the old "jti" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldJti(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJti is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJti requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJti: %w", err)
+	}
+	return oldValue.Jti, nil
+}
+
+// ResetJti resets all changes to the "jti" field.
+func (m *EnrollmentKeyMutation) ResetJti() {
+	m.jti = nil
+}
+
+// SetGroups sets the "groups" field.
+func (m *EnrollmentKeyMutation) SetGroups(s []string) {
+	m.groups = &s
+	m.appendgroups = nil
+}
+
+// Groups returns the value of the "groups" field in the mutation.
+func (m *EnrollmentKeyMutation) Groups() (r []string, exists bool) {
+	v := m.groups
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGroups returns the old "groups" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldGroups(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGroups is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGroups requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGroups: %w", err)
+	}
+	return oldValue.Groups, nil
+}
+
+// AppendGroups adds s to the "groups" field.
+func (m *EnrollmentKeyMutation) AppendGroups(s []string) {
+	m.appendgroups = append(m.appendgroups, s...)
+}
+
+// AppendedGroups returns the list of values that were appended to the "groups" field in this mutation.
+func (m *EnrollmentKeyMutation) AppendedGroups() ([]string, bool) {
+	if len(m.appendgroups) == 0 {
+		return nil, false
+	}
+	return m.appendgroups, true
+}
+
+// ClearGroups clears the value of the "groups" field.
+func (m *EnrollmentKeyMutation) ClearGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	m.clearedFields[enrollmentkey.FieldGroups] = struct{}{}
+}
+
+// GroupsCleared returns if the "groups" field was cleared in this mutation.
+func (m *EnrollmentKeyMutation) GroupsCleared() bool {
+	_, ok := m.clearedFields[enrollmentkey.FieldGroups]
+	return ok
+}
+
+// ResetGroups resets all changes to the "groups" field.
+func (m *EnrollmentKeyMutation) ResetGroups() {
+	m.groups = nil
+	m.appendgroups = nil
+	delete(m.clearedFields, enrollmentkey.FieldGroups)
+}
+
+// SetMaxUses sets the "max_uses" field.
+func (m *EnrollmentKeyMutation) SetMaxUses(i int) {
+	m.max_uses = &i
+	m.addmax_uses = nil
+}
+
+// MaxUses returns the value of the "max_uses" field in the mutation.
+func (m *EnrollmentKeyMutation) MaxUses() (r int, exists bool) {
+	v := m.max_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxUses returns the old "max_uses" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldMaxUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxUses: %w", err)
+	}
+	return oldValue.MaxUses, nil
+}
+
+// AddMaxUses adds i to the "max_uses" field.
+func (m *EnrollmentKeyMutation) AddMaxUses(i int) {
+	if m.addmax_uses != nil {
+		*m.addmax_uses += i
+	} else {
+		m.addmax_uses = &i
+	}
+}
+
+// AddedMaxUses returns the value that was added to the "max_uses" field in this mutation.
+func (m *EnrollmentKeyMutation) AddedMaxUses() (r int, exists bool) {
+	v := m.addmax_uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxUses resets all changes to the "max_uses" field.
+func (m *EnrollmentKeyMutation) ResetMaxUses() {
+	m.max_uses = nil
+	m.addmax_uses = nil
+}
+
+// SetUses sets the "uses" field.
+func (m *EnrollmentKeyMutation) SetUses(i int) {
+	m.uses = &i
+	m.adduses = nil
+}
+
+// Uses returns the value of the "uses" field in the mutation.
+func (m *EnrollmentKeyMutation) Uses() (r int, exists bool) {
+	v := m.uses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUses returns the old "uses" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldUses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUses: %w", err)
+	}
+	return oldValue.Uses, nil
+}
+
+// AddUses adds i to the "uses" field.
+func (m *EnrollmentKeyMutation) AddUses(i int) {
+	if m.adduses != nil {
+		*m.adduses += i
+	} else {
+		m.adduses = &i
+	}
+}
+
+// AddedUses returns the value that was added to the "uses" field in this mutation.
+func (m *EnrollmentKeyMutation) AddedUses() (r int, exists bool) {
+	v := m.adduses
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetUses resets all changes to the "uses" field.
+func (m *EnrollmentKeyMutation) ResetUses() {
+	m.uses = nil
+	m.adduses = nil
+}
+
+// SetExpiresTime sets the "expires_time" field.
+func (m *EnrollmentKeyMutation) SetExpiresTime(t time.Time) {
+	m.expires_time = &t
+}
+
+// ExpiresTime returns the value of the "expires_time" field in the mutation.
+func (m *EnrollmentKeyMutation) ExpiresTime() (r time.Time, exists bool) {
+	v := m.expires_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresTime returns the old "expires_time" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldExpiresTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresTime: %w", err)
+	}
+	return oldValue.ExpiresTime, nil
+}
+
+// ResetExpiresTime resets all changes to the "expires_time" field.
+func (m *EnrollmentKeyMutation) ResetExpiresTime() {
+	m.expires_time = nil
+}
+
+// SetRevoked sets the "revoked" field.
+func (m *EnrollmentKeyMutation) SetRevoked(b bool) {
+	m.revoked = &b
+}
+
+// Revoked returns the value of the "revoked" field in the mutation.
+func (m *EnrollmentKeyMutation) Revoked() (r bool, exists bool) {
+	v := m.revoked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRevoked returns the old "revoked" field's value of the EnrollmentKey entity.
+// If the EnrollmentKey object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EnrollmentKeyMutation) OldRevoked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRevoked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRevoked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRevoked: %w", err)
+	}
+	return oldValue.Revoked, nil
+}
+
+// ResetRevoked resets all changes to the "revoked" field.
+func (m *EnrollmentKeyMutation) ResetRevoked() {
+	m.revoked = nil
+}
+
+// Where appends a list predicates to the EnrollmentKeyMutation builder.
+func (m *EnrollmentKeyMutation) Where(ps ...predicate.EnrollmentKey) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EnrollmentKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EnrollmentKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EnrollmentKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EnrollmentKeyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EnrollmentKeyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EnrollmentKey).
+func (m *EnrollmentKeyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EnrollmentKeyMutation) Fields() []string {
+	fields := make([]string, 0, 9)
+	if m.created_time != nil {
+		fields = append(fields, enrollmentkey.FieldCreatedTime)
+	}
+	if m.updated_time != nil {
+		fields = append(fields, enrollmentkey.FieldUpdatedTime)
+	}
+	if m.name != nil {
+		fields = append(fields, enrollmentkey.FieldName)
+	}
+	if m.jti != nil {
+		fields = append(fields, enrollmentkey.FieldJti)
+	}
+	if m.groups != nil {
+		fields = append(fields, enrollmentkey.FieldGroups)
+	}
+	if m.max_uses != nil {
+		fields = append(fields, enrollmentkey.FieldMaxUses)
+	}
+	if m.uses != nil {
+		fields = append(fields, enrollmentkey.FieldUses)
+	}
+	if m.expires_time != nil {
+		fields = append(fields, enrollmentkey.FieldExpiresTime)
+	}
+	if m.revoked != nil {
+		fields = append(fields, enrollmentkey.FieldRevoked)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EnrollmentKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case enrollmentkey.FieldCreatedTime:
+		return m.CreatedTime()
+	case enrollmentkey.FieldUpdatedTime:
+		return m.UpdatedTime()
+	case enrollmentkey.FieldName:
+		return m.Name()
+	case enrollmentkey.FieldJti:
+		return m.Jti()
+	case enrollmentkey.FieldGroups:
+		return m.Groups()
+	case enrollmentkey.FieldMaxUses:
+		return m.MaxUses()
+	case enrollmentkey.FieldUses:
+		return m.Uses()
+	case enrollmentkey.FieldExpiresTime:
+		return m.ExpiresTime()
+	case enrollmentkey.FieldRevoked:
+		return m.Revoked()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EnrollmentKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case enrollmentkey.FieldCreatedTime:
+		return m.OldCreatedTime(ctx)
+	case enrollmentkey.FieldUpdatedTime:
+		return m.OldUpdatedTime(ctx)
+	case enrollmentkey.FieldName:
+		return m.OldName(ctx)
+	case enrollmentkey.FieldJti:
+		return m.OldJti(ctx)
+	case enrollmentkey.FieldGroups:
+		return m.OldGroups(ctx)
+	case enrollmentkey.FieldMaxUses:
+		return m.OldMaxUses(ctx)
+	case enrollmentkey.FieldUses:
+		return m.OldUses(ctx)
+	case enrollmentkey.FieldExpiresTime:
+		return m.OldExpiresTime(ctx)
+	case enrollmentkey.FieldRevoked:
+		return m.OldRevoked(ctx)
+	}
+	return nil, fmt.Errorf("unknown EnrollmentKey field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrollmentKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case enrollmentkey.FieldCreatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedTime(v)
+		return nil
+	case enrollmentkey.FieldUpdatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedTime(v)
+		return nil
+	case enrollmentkey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case enrollmentkey.FieldJti:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJti(v)
+		return nil
+	case enrollmentkey.FieldGroups:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGroups(v)
+		return nil
+	case enrollmentkey.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxUses(v)
+		return nil
+	case enrollmentkey.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUses(v)
+		return nil
+	case enrollmentkey.FieldExpiresTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresTime(v)
+		return nil
+	case enrollmentkey.FieldRevoked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevoked(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentKey field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EnrollmentKeyMutation) AddedFields() []string {
+	var fields []string
+	if m.addmax_uses != nil {
+		fields = append(fields, enrollmentkey.FieldMaxUses)
+	}
+	if m.adduses != nil {
+		fields = append(fields, enrollmentkey.FieldUses)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EnrollmentKeyMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case enrollmentkey.FieldMaxUses:
+		return m.AddedMaxUses()
+	case enrollmentkey.FieldUses:
+		return m.AddedUses()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EnrollmentKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case enrollmentkey.FieldMaxUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxUses(v)
+		return nil
+	case enrollmentkey.FieldUses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentKey numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EnrollmentKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(enrollmentkey.FieldGroups) {
+		fields = append(fields, enrollmentkey.FieldGroups)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EnrollmentKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EnrollmentKeyMutation) ClearField(name string) error {
+	switch name {
+	case enrollmentkey.FieldGroups:
+		m.ClearGroups()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentKey nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EnrollmentKeyMutation) ResetField(name string) error {
+	switch name {
+	case enrollmentkey.FieldCreatedTime:
+		m.ResetCreatedTime()
+		return nil
+	case enrollmentkey.FieldUpdatedTime:
+		m.ResetUpdatedTime()
+		return nil
+	case enrollmentkey.FieldName:
+		m.ResetName()
+		return nil
+	case enrollmentkey.FieldJti:
+		m.ResetJti()
+		return nil
+	case enrollmentkey.FieldGroups:
+		m.ResetGroups()
+		return nil
+	case enrollmentkey.FieldMaxUses:
+		m.ResetMaxUses()
+		return nil
+	case enrollmentkey.FieldUses:
+		m.ResetUses()
+		return nil
+	case enrollmentkey.FieldExpiresTime:
+		m.ResetExpiresTime()
+		return nil
+	case enrollmentkey.FieldRevoked:
+		m.ResetRevoked()
+		return nil
+	}
+	return fmt.Errorf("unknown EnrollmentKey field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EnrollmentKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EnrollmentKeyMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EnrollmentKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EnrollmentKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EnrollmentKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EnrollmentKeyMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EnrollmentKeyMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EnrollmentKey unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EnrollmentKeyMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EnrollmentKey edge %s", name)
+}
+
+// RevokedTokenMutation represents an operation that mutates the RevokedToken nodes in the graph.
+type RevokedTokenMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *int
+	created_time  *time.Time
+	jti           *string
+	device_id     *int
+	adddevice_id  *int
+	device_name   *string
+	expires_time  *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*RevokedToken, error)
+	predicates    []predicate.RevokedToken
+}
+
+var _ ent.Mutation = (*RevokedTokenMutation)(nil)
+
+// revokedtokenOption allows management of the mutation configuration using functional options.
+type revokedtokenOption func(*RevokedTokenMutation)
+
+// newRevokedTokenMutation creates new mutation for the RevokedToken entity.
+func newRevokedTokenMutation(c config, op Op, opts ...revokedtokenOption) *RevokedTokenMutation {
+	m := &RevokedTokenMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRevokedToken,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRevokedTokenID sets the ID field of the mutation.
+func withRevokedTokenID(id int) revokedtokenOption {
+	return func(m *RevokedTokenMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RevokedToken
+		)
+		m.oldValue = func(ctx context.Context) (*RevokedToken, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RevokedToken.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRevokedToken sets the old RevokedToken of the mutation.
+func withRevokedToken(node *RevokedToken) revokedtokenOption {
+	return func(m *RevokedTokenMutation) {
+		m.oldValue = func(context.Context) (*RevokedToken, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RevokedTokenMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RevokedTokenMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RevokedTokenMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RevokedTokenMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RevokedToken.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (m *RevokedTokenMutation) SetCreatedTime(t time.Time) {
+	m.created_time = &t
+}
+
+// CreatedTime returns the value of the "created_time" field in the mutation.
+func (m *RevokedTokenMutation) CreatedTime() (r time.Time, exists bool) {
+	v := m.created_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedTime returns the old "created_time" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedTime: %w", err)
+	}
+	return oldValue.CreatedTime, nil
+}
+
+// ResetCreatedTime resets all changes to the "created_time" field.
+func (m *RevokedTokenMutation) ResetCreatedTime() {
+	m.created_time = nil
+}
+
+// SetJti sets the "jti" field.
+func (m *RevokedTokenMutation) SetJti(s string) {
+	m.jti = &s
+}
+
+// Jti returns the value of the "jti" field in the mutation.
+func (m *RevokedTokenMutation) Jti() (r string, exists bool) {
+	v := m.jti
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJti returns the old "jti" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldJti(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJti is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJti requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJti: %w", err)
+	}
+	return oldValue.Jti, nil
+}
+
+// ResetJti resets all changes to the "jti" field.
+func (m *RevokedTokenMutation) ResetJti() {
+	m.jti = nil
+}
+
+// SetDeviceID sets the "device_id" field.
+func (m *RevokedTokenMutation) SetDeviceID(i int) {
+	m.device_id = &i
+	m.adddevice_id = nil
+}
+
+// DeviceID returns the value of the "device_id" field in the mutation.
+func (m *RevokedTokenMutation) DeviceID() (r int, exists bool) {
+	v := m.device_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceID returns the old "device_id" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldDeviceID(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceID: %w", err)
+	}
+	return oldValue.DeviceID, nil
+}
+
+// AddDeviceID adds i to the "device_id" field.
+func (m *RevokedTokenMutation) AddDeviceID(i int) {
+	if m.adddevice_id != nil {
+		*m.adddevice_id += i
+	} else {
+		m.adddevice_id = &i
+	}
+}
+
+// AddedDeviceID returns the value that was added to the "device_id" field in this mutation.
+func (m *RevokedTokenMutation) AddedDeviceID() (r int, exists bool) {
+	v := m.adddevice_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearDeviceID clears the value of the "device_id" field.
+func (m *RevokedTokenMutation) ClearDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+	m.clearedFields[revokedtoken.FieldDeviceID] = struct{}{}
+}
+
+// DeviceIDCleared returns if the "device_id" field was cleared in this mutation.
+func (m *RevokedTokenMutation) DeviceIDCleared() bool {
+	_, ok := m.clearedFields[revokedtoken.FieldDeviceID]
+	return ok
+}
+
+// ResetDeviceID resets all changes to the "device_id" field.
+func (m *RevokedTokenMutation) ResetDeviceID() {
+	m.device_id = nil
+	m.adddevice_id = nil
+	delete(m.clearedFields, revokedtoken.FieldDeviceID)
+}
+
+// SetDeviceName sets the "device_name" field.
+func (m *RevokedTokenMutation) SetDeviceName(s string) {
+	m.device_name = &s
+}
+
+// DeviceName returns the value of the "device_name" field in the mutation.
+func (m *RevokedTokenMutation) DeviceName() (r string, exists bool) {
+	v := m.device_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeviceName returns the old "device_name" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldDeviceName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeviceName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeviceName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeviceName: %w", err)
+	}
+	return oldValue.DeviceName, nil
+}
+
+// ClearDeviceName clears the value of the "device_name" field.
+func (m *RevokedTokenMutation) ClearDeviceName() {
+	m.device_name = nil
+	m.clearedFields[revokedtoken.FieldDeviceName] = struct{}{}
+}
+
+// DeviceNameCleared returns if the "device_name" field was cleared in this mutation.
+func (m *RevokedTokenMutation) DeviceNameCleared() bool {
+	_, ok := m.clearedFields[revokedtoken.FieldDeviceName]
+	return ok
+}
+
+// ResetDeviceName resets all changes to the "device_name" field.
+func (m *RevokedTokenMutation) ResetDeviceName() {
+	m.device_name = nil
+	delete(m.clearedFields, revokedtoken.FieldDeviceName)
+}
+
+// SetExpiresTime sets the "expires_time" field.
+func (m *RevokedTokenMutation) SetExpiresTime(t time.Time) {
+	m.expires_time = &t
+}
+
+// ExpiresTime returns the value of the "expires_time" field in the mutation.
+func (m *RevokedTokenMutation) ExpiresTime() (r time.Time, exists bool) {
+	v := m.expires_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresTime returns the old "expires_time" field's value of the RevokedToken entity.
+// If the RevokedToken object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RevokedTokenMutation) OldExpiresTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresTime: %w", err)
+	}
+	return oldValue.ExpiresTime, nil
+}
+
+// ResetExpiresTime resets all changes to the "expires_time" field.
+func (m *RevokedTokenMutation) ResetExpiresTime() {
+	m.expires_time = nil
+}
+
+// Where appends a list predicates to the RevokedTokenMutation builder.
+func (m *RevokedTokenMutation) Where(ps ...predicate.RevokedToken) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RevokedTokenMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RevokedTokenMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RevokedToken, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RevokedTokenMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RevokedTokenMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RevokedToken).
+func (m *RevokedTokenMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RevokedTokenMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.created_time != nil {
+		fields = append(fields, revokedtoken.FieldCreatedTime)
+	}
+	if m.jti != nil {
+		fields = append(fields, revokedtoken.FieldJti)
+	}
+	if m.device_id != nil {
+		fields = append(fields, revokedtoken.FieldDeviceID)
+	}
+	if m.device_name != nil {
+		fields = append(fields, revokedtoken.FieldDeviceName)
+	}
+	if m.expires_time != nil {
+		fields = append(fields, revokedtoken.FieldExpiresTime)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RevokedTokenMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case revokedtoken.FieldCreatedTime:
+		return m.CreatedTime()
+	case revokedtoken.FieldJti:
+		return m.Jti()
+	case revokedtoken.FieldDeviceID:
+		return m.DeviceID()
+	case revokedtoken.FieldDeviceName:
+		return m.DeviceName()
+	case revokedtoken.FieldExpiresTime:
+		return m.ExpiresTime()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RevokedTokenMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case revokedtoken.FieldCreatedTime:
+		return m.OldCreatedTime(ctx)
+	case revokedtoken.FieldJti:
+		return m.OldJti(ctx)
+	case revokedtoken.FieldDeviceID:
+		return m.OldDeviceID(ctx)
+	case revokedtoken.FieldDeviceName:
+		return m.OldDeviceName(ctx)
+	case revokedtoken.FieldExpiresTime:
+		return m.OldExpiresTime(ctx)
+	}
+	return nil, fmt.Errorf("unknown RevokedToken field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevokedTokenMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case revokedtoken.FieldCreatedTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedTime(v)
+		return nil
+	case revokedtoken.FieldJti:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJti(v)
+		return nil
+	case revokedtoken.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceID(v)
+		return nil
+	case revokedtoken.FieldDeviceName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeviceName(v)
+		return nil
+	case revokedtoken.FieldExpiresTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresTime(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RevokedTokenMutation) AddedFields() []string {
+	var fields []string
+	if m.adddevice_id != nil {
+		fields = append(fields, revokedtoken.FieldDeviceID)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RevokedTokenMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case revokedtoken.FieldDeviceID:
+		return m.AddedDeviceID()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RevokedTokenMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case revokedtoken.FieldDeviceID:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddDeviceID(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RevokedTokenMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(revokedtoken.FieldDeviceID) {
+		fields = append(fields, revokedtoken.FieldDeviceID)
+	}
+	if m.FieldCleared(revokedtoken.FieldDeviceName) {
+		fields = append(fields, revokedtoken.FieldDeviceName)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RevokedTokenMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RevokedTokenMutation) ClearField(name string) error {
+	switch name {
+	case revokedtoken.FieldDeviceID:
+		m.ClearDeviceID()
+		return nil
+	case revokedtoken.FieldDeviceName:
+		m.ClearDeviceName()
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RevokedTokenMutation) ResetField(name string) error {
+	switch name {
+	case revokedtoken.FieldCreatedTime:
+		m.ResetCreatedTime()
+		return nil
+	case revokedtoken.FieldJti:
+		m.ResetJti()
+		return nil
+	case revokedtoken.FieldDeviceID:
+		m.ResetDeviceID()
+		return nil
+	case revokedtoken.FieldDeviceName:
+		m.ResetDeviceName()
+		return nil
+	case revokedtoken.FieldExpiresTime:
+		m.ResetExpiresTime()
+		return nil
+	}
+	return fmt.Errorf("unknown RevokedToken field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RevokedTokenMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RevokedTokenMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RevokedTokenMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RevokedTokenMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RevokedTokenMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RevokedTokenMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RevokedTokenMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RevokedToken unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RevokedTokenMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RevokedToken edge %s", name)
+}
+
+// SettingsMutation represents an operation that mutates the Settings nodes in the graph.
+type SettingsMutation struct {
+	config
+	op                       Op
+	typ                      string
+	id                       *int
+	created_time             *time.Time
+	updated_time             *time.Time
+	domain_zone              *string
+	cipher                   *string
+	ca_crt                   *helpers.EncryptedBytes
+	ca_key                   *helpers.EncryptedBytes
+	lighthouse_crt           *helpers.EncryptedBytes
+	lighthouse_key           *helpers.EncryptedBytes
+	cidr                     *helpers.IpCidr
+	port_overlay_ip          *ipconv.IP
+	addport_overlay_ip       *ipconv.IP
+	port_relay               *bool
+	nebula_port              *int
+	addnebula_port           *int
+	http_port                *int
+	addhttp_port             *int
+	https_port               *int
+	addhttps_port            *int
+	public_host              *string
+	public_nebula_port       *int
+	addpublic_nebula_port    *int
+	public_api_port          *int
+	addpublic_api_port       *int
+	require_client_keys      *bool
+	firewall_inbound         *[]helpers.FirewallRule
+	appendfirewall_inbound   []helpers.FirewallRule
+	letsencrypt_registration *helpers.EncryptedBytes
+	tls_cert                 *helpers.EncryptedBytes
+	tls_cert_key             *helpers.EncryptedBytes
+	clearedFields            map[string]struct{}
+	done                     bool
+	oldValue                 func(context.Context) (*Settings, error)
+	predicates               []predicate.Settings
+}
+
+var _ ent.Mutation = (*SettingsMutation)(nil)
+
+// settingsOption allows management of the mutation configuration using functional options.
+type settingsOption func(*SettingsMutation)
+
+// newSettingsMutation creates new mutation for the Settings entity.
+func newSettingsMutation(c config, op Op, opts ...settingsOption) *SettingsMutation {
+	m := &SettingsMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSettings,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSettingsID sets the ID field of the mutation.
+func withSettingsID(id int) settingsOption {
+	return func(m *SettingsMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Settings
+		)
+		m.oldValue = func(ctx context.Context) (*Settings, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Settings.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSettings sets the old Settings of the mutation.
+func withSettings(node *Settings) settingsOption {
+	return func(m *SettingsMutation) {
+		m.oldValue = func(context.Context) (*Settings, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SettingsMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SettingsMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SettingsMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SettingsMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Settings.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (m *SettingsMutation) SetCreatedTime(t time.Time) {
+	m.created_time = &t
+}
+
+// CreatedTime returns the value of the "created_time" field in the mutation.
+func (m *SettingsMutation) CreatedTime() (r time.Time, exists bool) {
+	v := m.created_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedTime returns the old "created_time" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCreatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedTime: %w", err)
+	}
+	return oldValue.CreatedTime, nil
+}
+
+// ResetCreatedTime resets all changes to the "created_time" field.
+func (m *SettingsMutation) ResetCreatedTime() {
+	m.created_time = nil
+}
+
+// SetUpdatedTime sets the "updated_time" field.
+func (m *SettingsMutation) SetUpdatedTime(t time.Time) {
+	m.updated_time = &t
+}
+
+// UpdatedTime returns the value of the "updated_time" field in the mutation.
+func (m *SettingsMutation) UpdatedTime() (r time.Time, exists bool) {
+	v := m.updated_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedTime returns the old "updated_time" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldUpdatedTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedTime: %w", err)
+	}
+	return oldValue.UpdatedTime, nil
+}
+
+// ResetUpdatedTime resets all changes to the "updated_time" field.
+func (m *SettingsMutation) ResetUpdatedTime() {
+	m.updated_time = nil
+}
+
+// SetDomainZone sets the "domain_zone" field.
+func (m *SettingsMutation) SetDomainZone(s string) {
+	m.domain_zone = &s
+}
+
+// DomainZone returns the value of the "domain_zone" field in the mutation.
+func (m *SettingsMutation) DomainZone() (r string, exists bool) {
+	v := m.domain_zone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDomainZone returns the old "domain_zone" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldDomainZone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDomainZone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDomainZone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDomainZone: %w", err)
+	}
+	return oldValue.DomainZone, nil
+}
+
+// ClearDomainZone clears the value of the "domain_zone" field.
+func (m *SettingsMutation) ClearDomainZone() {
+	m.domain_zone = nil
+	m.clearedFields[settings.FieldDomainZone] = struct{}{}
+}
+
+// DomainZoneCleared returns if the "domain_zone" field was cleared in this mutation.
+func (m *SettingsMutation) DomainZoneCleared() bool {
+	_, ok := m.clearedFields[settings.FieldDomainZone]
+	return ok
+}
+
+// ResetDomainZone resets all changes to the "domain_zone" field.
+func (m *SettingsMutation) ResetDomainZone() {
+	m.domain_zone = nil
+	delete(m.clearedFields, settings.FieldDomainZone)
+}
+
+// SetCipher sets the "cipher" field.
+func (m *SettingsMutation) SetCipher(s string) {
+	m.cipher = &s
+}
+
+// Cipher returns the value of the "cipher" field in the mutation.
+func (m *SettingsMutation) Cipher() (r string, exists bool) {
+	v := m.cipher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCipher returns the old "cipher" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCipher(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCipher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCipher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCipher: %w", err)
+	}
+	return oldValue.Cipher, nil
+}
+
+// ResetCipher resets all changes to the "cipher" field.
+func (m *SettingsMutation) ResetCipher() {
+	m.cipher = nil
+}
+
+// SetCaCrt sets the "ca_crt" field.
+func (m *SettingsMutation) SetCaCrt(hb helpers.EncryptedBytes) {
+	m.ca_crt = &hb
+}
+
+// CaCrt returns the value of the "ca_crt" field in the mutation.
+func (m *SettingsMutation) CaCrt() (r helpers.EncryptedBytes, exists bool) {
+	v := m.ca_crt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaCrt returns the old "ca_crt" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCaCrt(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaCrt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaCrt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaCrt: %w", err)
+	}
+	return oldValue.CaCrt, nil
+}
+
+// ResetCaCrt resets all changes to the "ca_crt" field.
+func (m *SettingsMutation) ResetCaCrt() {
+	m.ca_crt = nil
+}
+
+// SetCaKey sets the "ca_key" field.
+func (m *SettingsMutation) SetCaKey(hb helpers.EncryptedBytes) {
+	m.ca_key = &hb
+}
+
+// CaKey returns the value of the "ca_key" field in the mutation.
+func (m *SettingsMutation) CaKey() (r helpers.EncryptedBytes, exists bool) {
+	v := m.ca_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCaKey returns the old "ca_key" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCaKey(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCaKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCaKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCaKey: %w", err)
+	}
+	return oldValue.CaKey, nil
+}
+
+// ResetCaKey resets all changes to the "ca_key" field.
+func (m *SettingsMutation) ResetCaKey() {
+	m.ca_key = nil
+}
+
+// SetLighthouseCrt sets the "lighthouse_crt" field.
+func (m *SettingsMutation) SetLighthouseCrt(hb helpers.EncryptedBytes) {
+	m.lighthouse_crt = &hb
+}
+
+// LighthouseCrt returns the value of the "lighthouse_crt" field in the mutation.
+func (m *SettingsMutation) LighthouseCrt() (r helpers.EncryptedBytes, exists bool) {
+	v := m.lighthouse_crt
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLighthouseCrt returns the old "lighthouse_crt" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldLighthouseCrt(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLighthouseCrt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLighthouseCrt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLighthouseCrt: %w", err)
+	}
+	return oldValue.LighthouseCrt, nil
+}
+
+// ResetLighthouseCrt resets all changes to the "lighthouse_crt" field.
+func (m *SettingsMutation) ResetLighthouseCrt() {
+	m.lighthouse_crt = nil
+}
+
+// SetLighthouseKey sets the "lighthouse_key" field.
+func (m *SettingsMutation) SetLighthouseKey(hb helpers.EncryptedBytes) {
+	m.lighthouse_key = &hb
+}
+
+// LighthouseKey returns the value of the "lighthouse_key" field in the mutation.
+func (m *SettingsMutation) LighthouseKey() (r helpers.EncryptedBytes, exists bool) {
+	v := m.lighthouse_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLighthouseKey returns the old "lighthouse_key" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldLighthouseKey(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLighthouseKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLighthouseKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLighthouseKey: %w", err)
+	}
+	return oldValue.LighthouseKey, nil
+}
+
+// ResetLighthouseKey resets all changes to the "lighthouse_key" field.
+func (m *SettingsMutation) ResetLighthouseKey() {
+	m.lighthouse_key = nil
+}
+
+// SetCidr sets the "cidr" field.
+func (m *SettingsMutation) SetCidr(hc helpers.IpCidr) {
+	m.cidr = &hc
+}
+
+// Cidr returns the value of the "cidr" field in the mutation.
+func (m *SettingsMutation) Cidr() (r helpers.IpCidr, exists bool) {
+	v := m.cidr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCidr returns the old "cidr" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldCidr(ctx context.Context) (v helpers.IpCidr, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCidr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCidr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCidr: %w", err)
+	}
+	return oldValue.Cidr, nil
+}
+
+// ResetCidr resets all changes to the "cidr" field.
+func (m *SettingsMutation) ResetCidr() {
+	m.cidr = nil
+}
+
+// SetPortOverlayIP sets the "port_overlay_ip" field.
+func (m *SettingsMutation) SetPortOverlayIP(i ipconv.IP) {
+	m.port_overlay_ip = &i
+	m.addport_overlay_ip = nil
+}
+
+// PortOverlayIP returns the value of the "port_overlay_ip" field in the mutation.
+func (m *SettingsMutation) PortOverlayIP() (r ipconv.IP, exists bool) {
+	v := m.port_overlay_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortOverlayIP returns the old "port_overlay_ip" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldPortOverlayIP(ctx context.Context) (v ipconv.IP, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortOverlayIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortOverlayIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortOverlayIP: %w", err)
+	}
+	return oldValue.PortOverlayIP, nil
+}
+
+// AddPortOverlayIP adds i to the "port_overlay_ip" field.
+func (m *SettingsMutation) AddPortOverlayIP(i ipconv.IP) {
+	if m.addport_overlay_ip != nil {
+		*m.addport_overlay_ip += i
+	} else {
+		m.addport_overlay_ip = &i
+	}
+}
+
+// AddedPortOverlayIP returns the value that was added to the "port_overlay_ip" field in this mutation.
+func (m *SettingsMutation) AddedPortOverlayIP() (r ipconv.IP, exists bool) {
+	v := m.addport_overlay_ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPortOverlayIP resets all changes to the "port_overlay_ip" field.
+func (m *SettingsMutation) ResetPortOverlayIP() {
+	m.port_overlay_ip = nil
+	m.addport_overlay_ip = nil
+}
+
+// SetPortRelay sets the "port_relay" field.
+func (m *SettingsMutation) SetPortRelay(b bool) {
+	m.port_relay = &b
+}
+
+// PortRelay returns the value of the "port_relay" field in the mutation.
+func (m *SettingsMutation) PortRelay() (r bool, exists bool) {
+	v := m.port_relay
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPortRelay returns the old "port_relay" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldPortRelay(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPortRelay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPortRelay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPortRelay: %w", err)
+	}
+	return oldValue.PortRelay, nil
+}
+
+// ResetPortRelay resets all changes to the "port_relay" field.
+func (m *SettingsMutation) ResetPortRelay() {
+	m.port_relay = nil
+}
+
+// SetNebulaPort sets the "nebula_port" field.
+func (m *SettingsMutation) SetNebulaPort(i int) {
+	m.nebula_port = &i
+	m.addnebula_port = nil
+}
+
+// NebulaPort returns the value of the "nebula_port" field in the mutation.
+func (m *SettingsMutation) NebulaPort() (r int, exists bool) {
+	v := m.nebula_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNebulaPort returns the old "nebula_port" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldNebulaPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNebulaPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNebulaPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNebulaPort: %w", err)
+	}
+	return oldValue.NebulaPort, nil
+}
+
+// AddNebulaPort adds i to the "nebula_port" field.
+func (m *SettingsMutation) AddNebulaPort(i int) {
+	if m.addnebula_port != nil {
+		*m.addnebula_port += i
+	} else {
+		m.addnebula_port = &i
+	}
+}
+
+// AddedNebulaPort returns the value that was added to the "nebula_port" field in this mutation.
+func (m *SettingsMutation) AddedNebulaPort() (r int, exists bool) {
+	v := m.addnebula_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNebulaPort resets all changes to the "nebula_port" field.
+func (m *SettingsMutation) ResetNebulaPort() {
+	m.nebula_port = nil
+	m.addnebula_port = nil
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (m *SettingsMutation) SetHTTPPort(i int) {
+	m.http_port = &i
+	m.addhttp_port = nil
+}
+
+// HTTPPort returns the value of the "http_port" field in the mutation.
+func (m *SettingsMutation) HTTPPort() (r int, exists bool) {
+	v := m.http_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHTTPPort returns the old "http_port" field's value of the Settings entity.
+// If the Settings object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SettingsMutation) OldHTTPPort(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHTTPPort is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHTTPPort requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHTTPPort: %w", err)
+	}
+	return oldValue.HTTPPort, nil
+}
+
+// AddHTTPPort adds i to the "http_port" field.
+func (m *SettingsMutation) AddHTTPPort(i int) {
+	if m.addhttp_port != nil {
+		*m.addhttp_port += i
+	} else {
+		m.addhttp_port = &i
+	}
+}
+
+// AddedHTTPPort returns the value that was added to the "http_port" field in this mutation.
+func (m *SettingsMutation) AddedHTTPPort() (r int, exists bool) {
+	v := m.addhttp_port
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetHTTPPort resets all changes to the "http_port" field.
+func (m *SettingsMutation) ResetHTTPPort() {
+	m.http_port = nil
+	m.addhttp_port = nil
+}
+
+// SetHTTPSPort sets the "https_port" field.
+func (m *SettingsMutation) SetHTTPSPort(i int) {
+	m.https_port = &i
+	m.addhttps_port = nil
 }
 
-// Cipher returns the value of the "cipher" field in the mutation.
-func (m *SettingsMutation) Cipher() (r string, exists bool) {
-	v := m.cipher
+// HTTPSPort returns the value of the "https_port" field in the mutation.
+func (m *SettingsMutation) HTTPSPort() (r int, exists bool) {
+	v := m.https_port
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCipher returns the old "cipher" field's value of the Settings entity.
+// OldHTTPSPort returns the old "https_port" field's value of the Settings entity.
 // If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCipher(ctx context.Context) (v string, err error) {
+func (m *SettingsMutation) OldHTTPSPort(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCipher is only allowed on UpdateOne operations")
+		return v, errors.New("OldHTTPSPort is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCipher requires an ID field in the mutation")
+		return v, errors.New("OldHTTPSPort requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCipher: %w", err)
+		return v, fmt.Errorf("querying old value for OldHTTPSPort: %w", err)
 	}
-	return oldValue.Cipher, nil
+	return oldValue.HTTPSPort, nil
 }
 
-// ResetCipher resets all changes to the "cipher" field.
-func (m *SettingsMutation) ResetCipher() {
-	m.cipher = nil
+// AddHTTPSPort adds i to the "https_port" field.
+func (m *SettingsMutation) AddHTTPSPort(i int) {
+	if m.addhttps_port != nil {
+		*m.addhttps_port += i
+	} else {
+		m.addhttps_port = &i
+	}
 }
 
-// SetCaCrt sets the "ca_crt" field.
-func (m *SettingsMutation) SetCaCrt(hb helpers.EncryptedBytes) {
-	m.ca_crt = &hb
+// AddedHTTPSPort returns the value that was added to the "https_port" field in this mutation.
+func (m *SettingsMutation) AddedHTTPSPort() (r int, exists bool) {
+	v := m.addhttps_port
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// CaCrt returns the value of the "ca_crt" field in the mutation.
-func (m *SettingsMutation) CaCrt() (r helpers.EncryptedBytes, exists bool) {
-	v := m.ca_crt
+// ResetHTTPSPort resets all changes to the "https_port" field.
+func (m *SettingsMutation) ResetHTTPSPort() {
+	m.https_port = nil
+	m.addhttps_port = nil
+}
+
+// SetPublicHost sets the "public_host" field.
+func (m *SettingsMutation) SetPublicHost(s string) {
+	m.public_host = &s
+}
+
+// PublicHost returns the value of the "public_host" field in the mutation.
+func (m *SettingsMutation) PublicHost() (r string, exists bool) {
+	v := m.public_host
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaCrt returns the old "ca_crt" field's value of the Settings entity.
+// OldPublicHost returns the old "public_host" field's value of the Settings entity.
 // If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCaCrt(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+func (m *SettingsMutation) OldPublicHost(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaCrt is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicHost is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaCrt requires an ID field in the mutation")
+		return v, errors.New("OldPublicHost requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaCrt: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicHost: %w", err)
 	}
-	return oldValue.CaCrt, nil
+	return oldValue.PublicHost, nil
 }
 
-// ResetCaCrt resets all changes to the "ca_crt" field.
-func (m *SettingsMutation) ResetCaCrt() {
-	m.ca_crt = nil
+// ClearPublicHost clears the value of the "public_host" field.
+func (m *SettingsMutation) ClearPublicHost() {
+	m.public_host = nil
+	m.clearedFields[settings.FieldPublicHost] = struct{}{}
 }
 
-// SetCaKey sets the "ca_key" field.
-func (m *SettingsMutation) SetCaKey(hb helpers.EncryptedBytes) {
-	m.ca_key = &hb
+// PublicHostCleared returns if the "public_host" field was cleared in this mutation.
+func (m *SettingsMutation) PublicHostCleared() bool {
+	_, ok := m.clearedFields[settings.FieldPublicHost]
+	return ok
 }
 
-// CaKey returns the value of the "ca_key" field in the mutation.
-func (m *SettingsMutation) CaKey() (r helpers.EncryptedBytes, exists bool) {
-	v := m.ca_key
+// ResetPublicHost resets all changes to the "public_host" field.
+func (m *SettingsMutation) ResetPublicHost() {
+	m.public_host = nil
+	delete(m.clearedFields, settings.FieldPublicHost)
+}
+
+// SetPublicNebulaPort sets the "public_nebula_port" field.
+func (m *SettingsMutation) SetPublicNebulaPort(i int) {
+	m.public_nebula_port = &i
+	m.addpublic_nebula_port = nil
+}
+
+// PublicNebulaPort returns the value of the "public_nebula_port" field in the mutation.
+func (m *SettingsMutation) PublicNebulaPort() (r int, exists bool) {
+	v := m.public_nebula_port
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCaKey returns the old "ca_key" field's value of the Settings entity.
+// OldPublicNebulaPort returns the old "public_nebula_port" field's value of the Settings entity.
 // If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCaKey(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+func (m *SettingsMutation) OldPublicNebulaPort(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCaKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicNebulaPort is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCaKey requires an ID field in the mutation")
+		return v, errors.New("OldPublicNebulaPort requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCaKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicNebulaPort: %w", err)
 	}
-	return oldValue.CaKey, nil
-}
-
-// ResetCaKey resets all changes to the "ca_key" field.
-func (m *SettingsMutation) ResetCaKey() {
-	m.ca_key = nil
+	return oldValue.PublicNebulaPort, nil
 }
 
-// SetLighthouseCrt sets the "lighthouse_crt" field.
-func (m *SettingsMutation) SetLighthouseCrt(hb helpers.EncryptedBytes) {
-	m.lighthouse_crt = &hb
+// AddPublicNebulaPort adds i to the "public_nebula_port" field.
+func (m *SettingsMutation) AddPublicNebulaPort(i int) {
+	if m.addpublic_nebula_port != nil {
+		*m.addpublic_nebula_port += i
+	} else {
+		m.addpublic_nebula_port = &i
+	}
 }
 
-// LighthouseCrt returns the value of the "lighthouse_crt" field in the mutation.
-func (m *SettingsMutation) LighthouseCrt() (r helpers.EncryptedBytes, exists bool) {
-	v := m.lighthouse_crt
+// AddedPublicNebulaPort returns the value that was added to the "public_nebula_port" field in this mutation.
+func (m *SettingsMutation) AddedPublicNebulaPort() (r int, exists bool) {
+	v := m.addpublic_nebula_port
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLighthouseCrt returns the old "lighthouse_crt" field's value of the Settings entity.
-// If the Settings object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldLighthouseCrt(ctx context.Context) (v helpers.EncryptedBytes, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLighthouseCrt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLighthouseCrt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLighthouseCrt: %w", err)
-	}
-	return oldValue.LighthouseCrt, nil
+// ClearPublicNebulaPort clears the value of the "public_nebula_port" field.
+func (m *SettingsMutation) ClearPublicNebulaPort() {
+	m.public_nebula_port = nil
+	m.addpublic_nebula_port = nil
+	m.clearedFields[settings.FieldPublicNebulaPort] = struct{}{}
 }
 
-// ResetLighthouseCrt resets all changes to the "lighthouse_crt" field.
-func (m *SettingsMutation) ResetLighthouseCrt() {
-	m.lighthouse_crt = nil
+// PublicNebulaPortCleared returns if the "public_nebula_port" field was cleared in this mutation.
+func (m *SettingsMutation) PublicNebulaPortCleared() bool {
+	_, ok := m.clearedFields[settings.FieldPublicNebulaPort]
+	return ok
 }
 
-// SetLighthouseKey sets the "lighthouse_key" field.
-func (m *SettingsMutation) SetLighthouseKey(hb helpers.EncryptedBytes) {
-	m.lighthouse_key = &hb
+// ResetPublicNebulaPort resets all changes to the "public_nebula_port" field.
+func (m *SettingsMutation) ResetPublicNebulaPort() {
+	m.public_nebula_port = nil
+	m.addpublic_nebula_port = nil
+	delete(m.clearedFields, settings.FieldPublicNebulaPort)
 }
 
-// LighthouseKey returns the value of the "lighthouse_key" field in the mutation.
-func (m *SettingsMutation) LighthouseKey() (r helpers.EncryptedBytes, exists bool) {
-	v := m.lighthouse_key
+// SetPublicAPIPort sets the "public_api_port" field.
+func (m *SettingsMutation) SetPublicAPIPort(i int) {
+	m.public_api_port = &i
+	m.addpublic_api_port = nil
+}
+
+// PublicAPIPort returns the value of the "public_api_port" field in the mutation.
+func (m *SettingsMutation) PublicAPIPort() (r int, exists bool) {
+	v := m.public_api_port
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLighthouseKey returns the old "lighthouse_key" field's value of the Settings entity.
+// OldPublicAPIPort returns the old "public_api_port" field's value of the Settings entity.
 // If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldLighthouseKey(ctx context.Context) (v helpers.EncryptedBytes, err error) {
+func (m *SettingsMutation) OldPublicAPIPort(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLighthouseKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldPublicAPIPort is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLighthouseKey requires an ID field in the mutation")
+		return v, errors.New("OldPublicAPIPort requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLighthouseKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldPublicAPIPort: %w", err)
 	}
-	return oldValue.LighthouseKey, nil
+	return oldValue.PublicAPIPort, nil
 }
 
-// ResetLighthouseKey resets all changes to the "lighthouse_key" field.
-func (m *SettingsMutation) ResetLighthouseKey() {
-	m.lighthouse_key = nil
+// AddPublicAPIPort adds i to the "public_api_port" field.
+func (m *SettingsMutation) AddPublicAPIPort(i int) {
+	if m.addpublic_api_port != nil {
+		*m.addpublic_api_port += i
+	} else {
+		m.addpublic_api_port = &i
+	}
 }
 
-// SetCidr sets the "cidr" field.
-func (m *SettingsMutation) SetCidr(hc helpers.IpCidr) {
-	m.cidr = &hc
+// AddedPublicAPIPort returns the value that was added to the "public_api_port" field in this mutation.
+func (m *SettingsMutation) AddedPublicAPIPort() (r int, exists bool) {
+	v := m.addpublic_api_port
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Cidr returns the value of the "cidr" field in the mutation.
-func (m *SettingsMutation) Cidr() (r helpers.IpCidr, exists bool) {
-	v := m.cidr
+// ClearPublicAPIPort clears the value of the "public_api_port" field.
+func (m *SettingsMutation) ClearPublicAPIPort() {
+	m.public_api_port = nil
+	m.addpublic_api_port = nil
+	m.clearedFields[settings.FieldPublicAPIPort] = struct{}{}
+}
+
+// PublicAPIPortCleared returns if the "public_api_port" field was cleared in this mutation.
+func (m *SettingsMutation) PublicAPIPortCleared() bool {
+	_, ok := m.clearedFields[settings.FieldPublicAPIPort]
+	return ok
+}
+
+// ResetPublicAPIPort resets all changes to the "public_api_port" field.
+func (m *SettingsMutation) ResetPublicAPIPort() {
+	m.public_api_port = nil
+	m.addpublic_api_port = nil
+	delete(m.clearedFields, settings.FieldPublicAPIPort)
+}
+
+// SetRequireClientKeys sets the "require_client_keys" field.
+func (m *SettingsMutation) SetRequireClientKeys(b bool) {
+	m.require_client_keys = &b
+}
+
+// RequireClientKeys returns the value of the "require_client_keys" field in the mutation.
+func (m *SettingsMutation) RequireClientKeys() (r bool, exists bool) {
+	v := m.require_client_keys
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCidr returns the old "cidr" field's value of the Settings entity.
+// OldRequireClientKeys returns the old "require_client_keys" field's value of the Settings entity.
 // If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldCidr(ctx context.Context) (v helpers.IpCidr, err error) {
+func (m *SettingsMutation) OldRequireClientKeys(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCidr is only allowed on UpdateOne operations")
+		return v, errors.New("OldRequireClientKeys is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCidr requires an ID field in the mutation")
+		return v, errors.New("OldRequireClientKeys requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCidr: %w", err)
+		return v, fmt.Errorf("querying old value for OldRequireClientKeys: %w", err)
 	}
-	return oldValue.Cidr, nil
+	return oldValue.RequireClientKeys, nil
 }
 
-// ResetCidr resets all changes to the "cidr" field.
-func (m *SettingsMutation) ResetCidr() {
-	m.cidr = nil
+// ResetRequireClientKeys resets all changes to the "require_client_keys" field.
+func (m *SettingsMutation) ResetRequireClientKeys() {
+	m.require_client_keys = nil
 }
 
-// SetPortOverlayIP sets the "port_overlay_ip" field.
-func (m *SettingsMutation) SetPortOverlayIP(i ipconv.IP) {
-	m.port_overlay_ip = &i
-	m.addport_overlay_ip = nil
+// SetFirewallInbound sets the "firewall_inbound" field.
+func (m *SettingsMutation) SetFirewallInbound(hr []helpers.FirewallRule) {
+	m.firewall_inbound = &hr
+	m.appendfirewall_inbound = nil
 }
 
-// PortOverlayIP returns the value of the "port_overlay_ip" field in the mutation.
-func (m *SettingsMutation) PortOverlayIP() (r ipconv.IP, exists bool) {
-	v := m.port_overlay_ip
+// FirewallInbound returns the value of the "firewall_inbound" field in the mutation.
+func (m *SettingsMutation) FirewallInbound() (r []helpers.FirewallRule, exists bool) {
+	v := m.firewall_inbound
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPortOverlayIP returns the old "port_overlay_ip" field's value of the Settings entity.
+// OldFirewallInbound returns the old "firewall_inbound" field's value of the Settings entity.
 // If the Settings object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SettingsMutation) OldPortOverlayIP(ctx context.Context) (v ipconv.IP, err error) {
+func (m *SettingsMutation) OldFirewallInbound(ctx context.Context) (v []helpers.FirewallRule, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPortOverlayIP is only allowed on UpdateOne operations")
+		return v, errors.New("OldFirewallInbound is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPortOverlayIP requires an ID field in the mutation")
+		return v, errors.New("OldFirewallInbound requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPortOverlayIP: %w", err)
+		return v, fmt.Errorf("querying old value for OldFirewallInbound: %w", err)
 	}
-	return oldValue.PortOverlayIP, nil
+	return oldValue.FirewallInbound, nil
 }
 
-// AddPortOverlayIP adds i to the "port_overlay_ip" field.
-func (m *SettingsMutation) AddPortOverlayIP(i ipconv.IP) {
-	if m.addport_overlay_ip != nil {
-		*m.addport_overlay_ip += i
-	} else {
-		m.addport_overlay_ip = &i
-	}
+// AppendFirewallInbound adds hr to the "firewall_inbound" field.
+func (m *SettingsMutation) AppendFirewallInbound(hr []helpers.FirewallRule) {
+	m.appendfirewall_inbound = append(m.appendfirewall_inbound, hr...)
 }
 
-// AddedPortOverlayIP returns the value that was added to the "port_overlay_ip" field in this mutation.
-func (m *SettingsMutation) AddedPortOverlayIP() (r ipconv.IP, exists bool) {
-	v := m.addport_overlay_ip
-	if v == nil {
-		return
+// AppendedFirewallInbound returns the list of values that were appended to the "firewall_inbound" field in this mutation.
+func (m *SettingsMutation) AppendedFirewallInbound() ([]helpers.FirewallRule, bool) {
+	if len(m.appendfirewall_inbound) == 0 {
+		return nil, false
 	}
-	return *v, true
+	return m.appendfirewall_inbound, true
 }
 
-// ResetPortOverlayIP resets all changes to the "port_overlay_ip" field.
-func (m *SettingsMutation) ResetPortOverlayIP() {
-	m.port_overlay_ip = nil
-	m.addport_overlay_ip = nil
+// ClearFirewallInbound clears the value of the "firewall_inbound" field.
+func (m *SettingsMutation) ClearFirewallInbound() {
+	m.firewall_inbound = nil
+	m.appendfirewall_inbound = nil
+	m.clearedFields[settings.FieldFirewallInbound] = struct{}{}
+}
+
+// FirewallInboundCleared returns if the "firewall_inbound" field was cleared in this mutation.
+func (m *SettingsMutation) FirewallInboundCleared() bool {
+	_, ok := m.clearedFields[settings.FieldFirewallInbound]
+	return ok
+}
+
+// ResetFirewallInbound resets all changes to the "firewall_inbound" field.
+func (m *SettingsMutation) ResetFirewallInbound() {
+	m.firewall_inbound = nil
+	m.appendfirewall_inbound = nil
+	delete(m.clearedFields, settings.FieldFirewallInbound)
 }
 
 // SetLetsencryptRegistration sets the "letsencrypt_registration" field.
@@ -1383,7 +6495,7 @@ func (m *SettingsMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *SettingsMutation) Fields() []string {
-	fields := make([]string, 0, 13)
+	fields := make([]string, 0, 22)
 	if m.created_time != nil {
 		fields = append(fields, settings.FieldCreatedTime)
 	}
@@ -1414,6 +6526,33 @@ func (m *SettingsMutation) Fields() []string {
 	if m.port_overlay_ip != nil {
 		fields = append(fields, settings.FieldPortOverlayIP)
 	}
+	if m.port_relay != nil {
+		fields = append(fields, settings.FieldPortRelay)
+	}
+	if m.nebula_port != nil {
+		fields = append(fields, settings.FieldNebulaPort)
+	}
+	if m.http_port != nil {
+		fields = append(fields, settings.FieldHTTPPort)
+	}
+	if m.https_port != nil {
+		fields = append(fields, settings.FieldHTTPSPort)
+	}
+	if m.public_host != nil {
+		fields = append(fields, settings.FieldPublicHost)
+	}
+	if m.public_nebula_port != nil {
+		fields = append(fields, settings.FieldPublicNebulaPort)
+	}
+	if m.public_api_port != nil {
+		fields = append(fields, settings.FieldPublicAPIPort)
+	}
+	if m.require_client_keys != nil {
+		fields = append(fields, settings.FieldRequireClientKeys)
+	}
+	if m.firewall_inbound != nil {
+		fields = append(fields, settings.FieldFirewallInbound)
+	}
 	if m.letsencrypt_registration != nil {
 		fields = append(fields, settings.FieldLetsencryptRegistration)
 	}
@@ -1451,6 +6590,24 @@ func (m *SettingsMutation) Field(name string) (ent.Value, bool) {
 		return m.Cidr()
 	case settings.FieldPortOverlayIP:
 		return m.PortOverlayIP()
+	case settings.FieldPortRelay:
+		return m.PortRelay()
+	case settings.FieldNebulaPort:
+		return m.NebulaPort()
+	case settings.FieldHTTPPort:
+		return m.HTTPPort()
+	case settings.FieldHTTPSPort:
+		return m.HTTPSPort()
+	case settings.FieldPublicHost:
+		return m.PublicHost()
+	case settings.FieldPublicNebulaPort:
+		return m.PublicNebulaPort()
+	case settings.FieldPublicAPIPort:
+		return m.PublicAPIPort()
+	case settings.FieldRequireClientKeys:
+		return m.RequireClientKeys()
+	case settings.FieldFirewallInbound:
+		return m.FirewallInbound()
 	case settings.FieldLetsencryptRegistration:
 		return m.LetsencryptRegistration()
 	case settings.FieldTLSCert:
@@ -1486,6 +6643,24 @@ func (m *SettingsMutation) OldField(ctx context.Context, name string) (ent.Value
 		return m.OldCidr(ctx)
 	case settings.FieldPortOverlayIP:
 		return m.OldPortOverlayIP(ctx)
+	case settings.FieldPortRelay:
+		return m.OldPortRelay(ctx)
+	case settings.FieldNebulaPort:
+		return m.OldNebulaPort(ctx)
+	case settings.FieldHTTPPort:
+		return m.OldHTTPPort(ctx)
+	case settings.FieldHTTPSPort:
+		return m.OldHTTPSPort(ctx)
+	case settings.FieldPublicHost:
+		return m.OldPublicHost(ctx)
+	case settings.FieldPublicNebulaPort:
+		return m.OldPublicNebulaPort(ctx)
+	case settings.FieldPublicAPIPort:
+		return m.OldPublicAPIPort(ctx)
+	case settings.FieldRequireClientKeys:
+		return m.OldRequireClientKeys(ctx)
+	case settings.FieldFirewallInbound:
+		return m.OldFirewallInbound(ctx)
 	case settings.FieldLetsencryptRegistration:
 		return m.OldLetsencryptRegistration(ctx)
 	case settings.FieldTLSCert:
@@ -1571,6 +6746,69 @@ func (m *SettingsMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetPortOverlayIP(v)
 		return nil
+	case settings.FieldPortRelay:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPortRelay(v)
+		return nil
+	case settings.FieldNebulaPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNebulaPort(v)
+		return nil
+	case settings.FieldHTTPPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHTTPPort(v)
+		return nil
+	case settings.FieldHTTPSPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHTTPSPort(v)
+		return nil
+	case settings.FieldPublicHost:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicHost(v)
+		return nil
+	case settings.FieldPublicNebulaPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicNebulaPort(v)
+		return nil
+	case settings.FieldPublicAPIPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublicAPIPort(v)
+		return nil
+	case settings.FieldRequireClientKeys:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequireClientKeys(v)
+		return nil
+	case settings.FieldFirewallInbound:
+		v, ok := value.([]helpers.FirewallRule)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirewallInbound(v)
+		return nil
 	case settings.FieldLetsencryptRegistration:
 		v, ok := value.(helpers.EncryptedBytes)
 		if !ok {
@@ -1603,6 +6841,21 @@ func (m *SettingsMutation) AddedFields() []string {
 	if m.addport_overlay_ip != nil {
 		fields = append(fields, settings.FieldPortOverlayIP)
 	}
+	if m.addnebula_port != nil {
+		fields = append(fields, settings.FieldNebulaPort)
+	}
+	if m.addhttp_port != nil {
+		fields = append(fields, settings.FieldHTTPPort)
+	}
+	if m.addhttps_port != nil {
+		fields = append(fields, settings.FieldHTTPSPort)
+	}
+	if m.addpublic_nebula_port != nil {
+		fields = append(fields, settings.FieldPublicNebulaPort)
+	}
+	if m.addpublic_api_port != nil {
+		fields = append(fields, settings.FieldPublicAPIPort)
+	}
 	return fields
 }
 
@@ -1613,6 +6866,16 @@ func (m *SettingsMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
 	case settings.FieldPortOverlayIP:
 		return m.AddedPortOverlayIP()
+	case settings.FieldNebulaPort:
+		return m.AddedNebulaPort()
+	case settings.FieldHTTPPort:
+		return m.AddedHTTPPort()
+	case settings.FieldHTTPSPort:
+		return m.AddedHTTPSPort()
+	case settings.FieldPublicNebulaPort:
+		return m.AddedPublicNebulaPort()
+	case settings.FieldPublicAPIPort:
+		return m.AddedPublicAPIPort()
 	}
 	return nil, false
 }
@@ -1629,6 +6892,41 @@ func (m *SettingsMutation) AddField(name string, value ent.Value) error {
 		}
 		m.AddPortOverlayIP(v)
 		return nil
+	case settings.FieldNebulaPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNebulaPort(v)
+		return nil
+	case settings.FieldHTTPPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHTTPPort(v)
+		return nil
+	case settings.FieldHTTPSPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddHTTPSPort(v)
+		return nil
+	case settings.FieldPublicNebulaPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPublicNebulaPort(v)
+		return nil
+	case settings.FieldPublicAPIPort:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPublicAPIPort(v)
+		return nil
 	}
 	return fmt.Errorf("unknown Settings numeric field %s", name)
 }
@@ -1640,6 +6938,18 @@ func (m *SettingsMutation) ClearedFields() []string {
 	if m.FieldCleared(settings.FieldDomainZone) {
 		fields = append(fields, settings.FieldDomainZone)
 	}
+	if m.FieldCleared(settings.FieldPublicHost) {
+		fields = append(fields, settings.FieldPublicHost)
+	}
+	if m.FieldCleared(settings.FieldPublicNebulaPort) {
+		fields = append(fields, settings.FieldPublicNebulaPort)
+	}
+	if m.FieldCleared(settings.FieldPublicAPIPort) {
+		fields = append(fields, settings.FieldPublicAPIPort)
+	}
+	if m.FieldCleared(settings.FieldFirewallInbound) {
+		fields = append(fields, settings.FieldFirewallInbound)
+	}
 	if m.FieldCleared(settings.FieldLetsencryptRegistration) {
 		fields = append(fields, settings.FieldLetsencryptRegistration)
 	}
@@ -1666,6 +6976,18 @@ func (m *SettingsMutation) ClearField(name string) error {
 	case settings.FieldDomainZone:
 		m.ClearDomainZone()
 		return nil
+	case settings.FieldPublicHost:
+		m.ClearPublicHost()
+		return nil
+	case settings.FieldPublicNebulaPort:
+		m.ClearPublicNebulaPort()
+		return nil
+	case settings.FieldPublicAPIPort:
+		m.ClearPublicAPIPort()
+		return nil
+	case settings.FieldFirewallInbound:
+		m.ClearFirewallInbound()
+		return nil
 	case settings.FieldLetsencryptRegistration:
 		m.ClearLetsencryptRegistration()
 		return nil
@@ -1713,6 +7035,33 @@ func (m *SettingsMutation) ResetField(name string) error {
 	case settings.FieldPortOverlayIP:
 		m.ResetPortOverlayIP()
 		return nil
+	case settings.FieldPortRelay:
+		m.ResetPortRelay()
+		return nil
+	case settings.FieldNebulaPort:
+		m.ResetNebulaPort()
+		return nil
+	case settings.FieldHTTPPort:
+		m.ResetHTTPPort()
+		return nil
+	case settings.FieldHTTPSPort:
+		m.ResetHTTPSPort()
+		return nil
+	case settings.FieldPublicHost:
+		m.ResetPublicHost()
+		return nil
+	case settings.FieldPublicNebulaPort:
+		m.ResetPublicNebulaPort()
+		return nil
+	case settings.FieldPublicAPIPort:
+		m.ResetPublicAPIPort()
+		return nil
+	case settings.FieldRequireClientKeys:
+		m.ResetRequireClientKeys()
+		return nil
+	case settings.FieldFirewallInbound:
+		m.ResetFirewallInbound()
+		return nil
 	case settings.FieldLetsencryptRegistration:
 		m.ResetLetsencryptRegistration()
 		return nil
@@ -6,8 +6,23 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AuditEvent is the predicate function for auditevent builders.
+type AuditEvent func(*sql.Selector)
+
+// DNSRecord is the predicate function for dnsrecord builders.
+type DNSRecord func(*sql.Selector)
+
 // Device is the predicate function for device builders.
 type Device func(*sql.Selector)
 
+// DeviceProvision is the predicate function for deviceprovision builders.
+type DeviceProvision func(*sql.Selector)
+
+// EnrollmentKey is the predicate function for enrollmentkey builders.
+type EnrollmentKey func(*sql.Selector)
+
+// RevokedToken is the predicate function for revokedtoken builders.
+type RevokedToken func(*sql.Selector)
+
 // Settings is the predicate function for settings builders.
 type Settings func(*sql.Selector)
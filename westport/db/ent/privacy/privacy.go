@@ -111,6 +111,54 @@ func DenyMutationOperationRule(op ent.Op) MutationRule {
 	return OnMutationOperation(rule, op)
 }
 
+// The AuditEventQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type AuditEventQueryRuleFunc func(context.Context, *ent.AuditEventQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f AuditEventQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.AuditEventQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.AuditEventQuery", q)
+}
+
+// The AuditEventMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type AuditEventMutationRuleFunc func(context.Context, *ent.AuditEventMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f AuditEventMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.AuditEventMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.AuditEventMutation", m)
+}
+
+// The DNSRecordQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DNSRecordQueryRuleFunc func(context.Context, *ent.DNSRecordQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DNSRecordQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DNSRecordQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DNSRecordQuery", q)
+}
+
+// The DNSRecordMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DNSRecordMutationRuleFunc func(context.Context, *ent.DNSRecordMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DNSRecordMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DNSRecordMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DNSRecordMutation", m)
+}
+
 // The DeviceQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type DeviceQueryRuleFunc func(context.Context, *ent.DeviceQuery) error
@@ -135,6 +183,78 @@ func (f DeviceMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation
 	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeviceMutation", m)
 }
 
+// The DeviceProvisionQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type DeviceProvisionQueryRuleFunc func(context.Context, *ent.DeviceProvisionQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f DeviceProvisionQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.DeviceProvisionQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.DeviceProvisionQuery", q)
+}
+
+// The DeviceProvisionMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type DeviceProvisionMutationRuleFunc func(context.Context, *ent.DeviceProvisionMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f DeviceProvisionMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.DeviceProvisionMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.DeviceProvisionMutation", m)
+}
+
+// The EnrollmentKeyQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type EnrollmentKeyQueryRuleFunc func(context.Context, *ent.EnrollmentKeyQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f EnrollmentKeyQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.EnrollmentKeyQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.EnrollmentKeyQuery", q)
+}
+
+// The EnrollmentKeyMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type EnrollmentKeyMutationRuleFunc func(context.Context, *ent.EnrollmentKeyMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f EnrollmentKeyMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.EnrollmentKeyMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.EnrollmentKeyMutation", m)
+}
+
+// The RevokedTokenQueryRuleFunc type is an adapter to allow the use of ordinary
+// functions as a query rule.
+type RevokedTokenQueryRuleFunc func(context.Context, *ent.RevokedTokenQuery) error
+
+// EvalQuery return f(ctx, q).
+func (f RevokedTokenQueryRuleFunc) EvalQuery(ctx context.Context, q ent.Query) error {
+	if q, ok := q.(*ent.RevokedTokenQuery); ok {
+		return f(ctx, q)
+	}
+	return Denyf("ent/privacy: unexpected query type %T, expect *ent.RevokedTokenQuery", q)
+}
+
+// The RevokedTokenMutationRuleFunc type is an adapter to allow the use of ordinary
+// functions as a mutation rule.
+type RevokedTokenMutationRuleFunc func(context.Context, *ent.RevokedTokenMutation) error
+
+// EvalMutation calls f(ctx, m).
+func (f RevokedTokenMutationRuleFunc) EvalMutation(ctx context.Context, m ent.Mutation) error {
+	if m, ok := m.(*ent.RevokedTokenMutation); ok {
+		return f(ctx, m)
+	}
+	return Denyf("ent/privacy: unexpected mutation type %T, expect *ent.RevokedTokenMutation", m)
+}
+
 // The SettingsQueryRuleFunc type is an adapter to allow the use of ordinary
 // functions as a query rule.
 type SettingsQueryRuleFunc func(context.Context, *ent.SettingsQuery) error
@@ -194,8 +314,18 @@ var _ QueryMutationRule = FilterFunc(nil)
 
 func queryFilter(q ent.Query) (Filter, error) {
 	switch q := q.(type) {
+	case *ent.AuditEventQuery:
+		return q.Filter(), nil
+	case *ent.DNSRecordQuery:
+		return q.Filter(), nil
 	case *ent.DeviceQuery:
 		return q.Filter(), nil
+	case *ent.DeviceProvisionQuery:
+		return q.Filter(), nil
+	case *ent.EnrollmentKeyQuery:
+		return q.Filter(), nil
+	case *ent.RevokedTokenQuery:
+		return q.Filter(), nil
 	case *ent.SettingsQuery:
 		return q.Filter(), nil
 	default:
@@ -205,8 +335,18 @@ func queryFilter(q ent.Query) (Filter, error) {
 
 func mutationFilter(m ent.Mutation) (Filter, error) {
 	switch m := m.(type) {
+	case *ent.AuditEventMutation:
+		return m.Filter(), nil
+	case *ent.DNSRecordMutation:
+		return m.Filter(), nil
 	case *ent.DeviceMutation:
 		return m.Filter(), nil
+	case *ent.DeviceProvisionMutation:
+		return m.Filter(), nil
+	case *ent.EnrollmentKeyMutation:
+		return m.Filter(), nil
+	case *ent.RevokedTokenMutation:
+		return m.Filter(), nil
 	case *ent.SettingsMutation:
 		return m.Filter(), nil
 	default:
@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
+)
+
+// RevokedTokenCreate is the builder for creating a RevokedToken entity.
+type RevokedTokenCreate struct {
+	config
+	mutation *RevokedTokenMutation
+	hooks    []Hook
+}
+
+// SetCreatedTime sets the "created_time" field.
+func (_c *RevokedTokenCreate) SetCreatedTime(v time.Time) *RevokedTokenCreate {
+	_c.mutation.SetCreatedTime(v)
+	return _c
+}
+
+// SetNillableCreatedTime sets the "created_time" field if the given value is not nil.
+func (_c *RevokedTokenCreate) SetNillableCreatedTime(v *time.Time) *RevokedTokenCreate {
+	if v != nil {
+		_c.SetCreatedTime(*v)
+	}
+	return _c
+}
+
+// SetJti sets the "jti" field.
+func (_c *RevokedTokenCreate) SetJti(v string) *RevokedTokenCreate {
+	_c.mutation.SetJti(v)
+	return _c
+}
+
+// SetDeviceID sets the "device_id" field.
+func (_c *RevokedTokenCreate) SetDeviceID(v int) *RevokedTokenCreate {
+	_c.mutation.SetDeviceID(v)
+	return _c
+}
+
+// SetNillableDeviceID sets the "device_id" field if the given value is not nil.
+func (_c *RevokedTokenCreate) SetNillableDeviceID(v *int) *RevokedTokenCreate {
+	if v != nil {
+		_c.SetDeviceID(*v)
+	}
+	return _c
+}
+
+// SetDeviceName sets the "device_name" field.
+func (_c *RevokedTokenCreate) SetDeviceName(v string) *RevokedTokenCreate {
+	_c.mutation.SetDeviceName(v)
+	return _c
+}
+
+// SetNillableDeviceName sets the "device_name" field if the given value is not nil.
+func (_c *RevokedTokenCreate) SetNillableDeviceName(v *string) *RevokedTokenCreate {
+	if v != nil {
+		_c.SetDeviceName(*v)
+	}
+	return _c
+}
+
+// SetExpiresTime sets the "expires_time" field.
+func (_c *RevokedTokenCreate) SetExpiresTime(v time.Time) *RevokedTokenCreate {
+	_c.mutation.SetExpiresTime(v)
+	return _c
+}
+
+// Mutation returns the RevokedTokenMutation object of the builder.
+func (_c *RevokedTokenCreate) Mutation() *RevokedTokenMutation {
+	return _c.mutation
+}
+
+// Save creates the RevokedToken in the database.
+func (_c *RevokedTokenCreate) Save(ctx context.Context) (*RevokedToken, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RevokedTokenCreate) SaveX(ctx context.Context) *RevokedToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevokedTokenCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevokedTokenCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RevokedTokenCreate) defaults() {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		v := revokedtoken.DefaultCreatedTime()
+		_c.mutation.SetCreatedTime(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RevokedTokenCreate) check() error {
+	if _, ok := _c.mutation.CreatedTime(); !ok {
+		return &ValidationError{Name: "created_time", err: errors.New(`ent: missing required field "RevokedToken.created_time"`)}
+	}
+	if _, ok := _c.mutation.Jti(); !ok {
+		return &ValidationError{Name: "jti", err: errors.New(`ent: missing required field "RevokedToken.jti"`)}
+	}
+	if _, ok := _c.mutation.ExpiresTime(); !ok {
+		return &ValidationError{Name: "expires_time", err: errors.New(`ent: missing required field "RevokedToken.expires_time"`)}
+	}
+	return nil
+}
+
+func (_c *RevokedTokenCreate) sqlSave(ctx context.Context) (*RevokedToken, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RevokedTokenCreate) createSpec() (*RevokedToken, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RevokedToken{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(revokedtoken.Table, sqlgraph.NewFieldSpec(revokedtoken.FieldID, field.TypeInt))
+	)
+	if value, ok := _c.mutation.CreatedTime(); ok {
+		_spec.SetField(revokedtoken.FieldCreatedTime, field.TypeTime, value)
+		_node.CreatedTime = value
+	}
+	if value, ok := _c.mutation.Jti(); ok {
+		_spec.SetField(revokedtoken.FieldJti, field.TypeString, value)
+		_node.Jti = value
+	}
+	if value, ok := _c.mutation.DeviceID(); ok {
+		_spec.SetField(revokedtoken.FieldDeviceID, field.TypeInt, value)
+		_node.DeviceID = value
+	}
+	if value, ok := _c.mutation.DeviceName(); ok {
+		_spec.SetField(revokedtoken.FieldDeviceName, field.TypeString, value)
+		_node.DeviceName = value
+	}
+	if value, ok := _c.mutation.ExpiresTime(); ok {
+		_spec.SetField(revokedtoken.FieldExpiresTime, field.TypeTime, value)
+		_node.ExpiresTime = value
+	}
+	return _node, _spec
+}
+
+// RevokedTokenCreateBulk is the builder for creating many RevokedToken entities in bulk.
+type RevokedTokenCreateBulk struct {
+	config
+	err      error
+	builders []*RevokedTokenCreate
+}
+
+// Save creates the RevokedToken entities in the database.
+func (_c *RevokedTokenCreateBulk) Save(ctx context.Context) ([]*RevokedToken, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RevokedToken, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RevokedTokenMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RevokedTokenCreateBulk) SaveX(ctx context.Context) []*RevokedToken {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RevokedTokenCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RevokedTokenCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -2,7 +2,201 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/sprisa/west/westport/db/ent/runtime.go
+import (
+	"context"
+	"time"
+
+	"github.com/sprisa/west/westport/db/ent/auditevent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
+	"github.com/sprisa/west/westport/db/ent/settings"
+	"github.com/sprisa/west/westport/db/schema"
+
+	"entgo.io/ent"
+	"entgo.io/ent/privacy"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	auditeventMixin := schema.AuditEvent{}.Mixin()
+	auditevent.Policy = privacy.NewPolicies(schema.AuditEvent{})
+	auditevent.Hooks[0] = func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			if err := auditevent.Policy.EvalMutation(ctx, m); err != nil {
+				return nil, err
+			}
+			return next.Mutate(ctx, m)
+		})
+	}
+	auditeventMixinFields0 := auditeventMixin[0].Fields()
+	_ = auditeventMixinFields0
+	auditeventFields := schema.AuditEvent{}.Fields()
+	_ = auditeventFields
+	// auditeventDescCreatedTime is the schema descriptor for created_time field.
+	auditeventDescCreatedTime := auditeventMixinFields0[0].Descriptor()
+	// auditevent.DefaultCreatedTime holds the default value on creation for the created_time field.
+	auditevent.DefaultCreatedTime = auditeventDescCreatedTime.Default.(func() time.Time)
+	// auditeventDescSuccess is the schema descriptor for success field.
+	auditeventDescSuccess := auditeventFields[5].Descriptor()
+	// auditevent.DefaultSuccess holds the default value on creation for the success field.
+	auditevent.DefaultSuccess = auditeventDescSuccess.Default.(bool)
+	dnsrecordMixin := schema.DNSRecord{}.Mixin()
+	dnsrecordMixinFields0 := dnsrecordMixin[0].Fields()
+	_ = dnsrecordMixinFields0
+	dnsrecordFields := schema.DNSRecord{}.Fields()
+	_ = dnsrecordFields
+	// dnsrecordDescCreatedTime is the schema descriptor for created_time field.
+	dnsrecordDescCreatedTime := dnsrecordMixinFields0[0].Descriptor()
+	// dnsrecord.DefaultCreatedTime holds the default value on creation for the created_time field.
+	dnsrecord.DefaultCreatedTime = dnsrecordDescCreatedTime.Default.(func() time.Time)
+	// dnsrecordDescUpdatedTime is the schema descriptor for updated_time field.
+	dnsrecordDescUpdatedTime := dnsrecordMixinFields0[1].Descriptor()
+	// dnsrecord.DefaultUpdatedTime holds the default value on creation for the updated_time field.
+	dnsrecord.DefaultUpdatedTime = dnsrecordDescUpdatedTime.Default.(func() time.Time)
+	// dnsrecord.UpdateDefaultUpdatedTime holds the default value on update for the updated_time field.
+	dnsrecord.UpdateDefaultUpdatedTime = dnsrecordDescUpdatedTime.UpdateDefault.(func() time.Time)
+	deviceMixin := schema.Device{}.Mixin()
+	deviceHooks := schema.Device{}.Hooks()
+	device.Hooks[0] = deviceHooks[0]
+	device.Hooks[1] = deviceHooks[1]
+	deviceMixinFields0 := deviceMixin[0].Fields()
+	_ = deviceMixinFields0
+	deviceFields := schema.Device{}.Fields()
+	_ = deviceFields
+	// deviceDescCreatedTime is the schema descriptor for created_time field.
+	deviceDescCreatedTime := deviceMixinFields0[0].Descriptor()
+	// device.DefaultCreatedTime holds the default value on creation for the created_time field.
+	device.DefaultCreatedTime = deviceDescCreatedTime.Default.(func() time.Time)
+	// deviceDescUpdatedTime is the schema descriptor for updated_time field.
+	deviceDescUpdatedTime := deviceMixinFields0[1].Descriptor()
+	// device.DefaultUpdatedTime holds the default value on creation for the updated_time field.
+	device.DefaultUpdatedTime = deviceDescUpdatedTime.Default.(func() time.Time)
+	// device.UpdateDefaultUpdatedTime holds the default value on update for the updated_time field.
+	device.UpdateDefaultUpdatedTime = deviceDescUpdatedTime.UpdateDefault.(func() time.Time)
+	// deviceDescName is the schema descriptor for name field.
+	deviceDescName := deviceFields[0].Descriptor()
+	// device.DefaultName holds the default value on creation for the name field.
+	device.DefaultName = deviceDescName.Default.(func() string)
+	// deviceDescIP is the schema descriptor for ip field.
+	deviceDescIP := deviceFields[1].Descriptor()
+	// device.IPValidator is a validator for the "ip" field. It is called by the builders before save.
+	device.IPValidator = deviceDescIP.Validators[0].(func(uint32) error)
+	// deviceDescSingleUseToken is the schema descriptor for single_use_token field.
+	deviceDescSingleUseToken := deviceFields[4].Descriptor()
+	// device.DefaultSingleUseToken holds the default value on creation for the single_use_token field.
+	device.DefaultSingleUseToken = deviceDescSingleUseToken.Default.(bool)
+	// deviceDescExitNode is the schema descriptor for exit_node field.
+	deviceDescExitNode := deviceFields[7].Descriptor()
+	// device.DefaultExitNode holds the default value on creation for the exit_node field.
+	device.DefaultExitNode = deviceDescExitNode.Default.(bool)
+	// deviceDescRelay is the schema descriptor for relay field.
+	deviceDescRelay := deviceFields[8].Descriptor()
+	// device.DefaultRelay holds the default value on creation for the relay field.
+	device.DefaultRelay = deviceDescRelay.Default.(bool)
+	// deviceDescLighthouse is the schema descriptor for lighthouse field.
+	deviceDescLighthouse := deviceFields[9].Descriptor()
+	// device.DefaultLighthouse holds the default value on creation for the lighthouse field.
+	device.DefaultLighthouse = deviceDescLighthouse.Default.(bool)
+	deviceprovisionMixin := schema.DeviceProvision{}.Mixin()
+	deviceprovisionMixinFields0 := deviceprovisionMixin[0].Fields()
+	_ = deviceprovisionMixinFields0
+	deviceprovisionFields := schema.DeviceProvision{}.Fields()
+	_ = deviceprovisionFields
+	// deviceprovisionDescCreatedTime is the schema descriptor for created_time field.
+	deviceprovisionDescCreatedTime := deviceprovisionMixinFields0[0].Descriptor()
+	// deviceprovision.DefaultCreatedTime holds the default value on creation for the created_time field.
+	deviceprovision.DefaultCreatedTime = deviceprovisionDescCreatedTime.Default.(func() time.Time)
+	// deviceprovisionDescClientKey is the schema descriptor for client_key field.
+	deviceprovisionDescClientKey := deviceprovisionFields[5].Descriptor()
+	// deviceprovision.DefaultClientKey holds the default value on creation for the client_key field.
+	deviceprovision.DefaultClientKey = deviceprovisionDescClientKey.Default.(bool)
+	enrollmentkeyMixin := schema.EnrollmentKey{}.Mixin()
+	enrollmentkeyMixinFields0 := enrollmentkeyMixin[0].Fields()
+	_ = enrollmentkeyMixinFields0
+	enrollmentkeyFields := schema.EnrollmentKey{}.Fields()
+	_ = enrollmentkeyFields
+	// enrollmentkeyDescCreatedTime is the schema descriptor for created_time field.
+	enrollmentkeyDescCreatedTime := enrollmentkeyMixinFields0[0].Descriptor()
+	// enrollmentkey.DefaultCreatedTime holds the default value on creation for the created_time field.
+	enrollmentkey.DefaultCreatedTime = enrollmentkeyDescCreatedTime.Default.(func() time.Time)
+	// enrollmentkeyDescUpdatedTime is the schema descriptor for updated_time field.
+	enrollmentkeyDescUpdatedTime := enrollmentkeyMixinFields0[1].Descriptor()
+	// enrollmentkey.DefaultUpdatedTime holds the default value on creation for the updated_time field.
+	enrollmentkey.DefaultUpdatedTime = enrollmentkeyDescUpdatedTime.Default.(func() time.Time)
+	// enrollmentkey.UpdateDefaultUpdatedTime holds the default value on update for the updated_time field.
+	enrollmentkey.UpdateDefaultUpdatedTime = enrollmentkeyDescUpdatedTime.UpdateDefault.(func() time.Time)
+	// enrollmentkeyDescMaxUses is the schema descriptor for max_uses field.
+	enrollmentkeyDescMaxUses := enrollmentkeyFields[3].Descriptor()
+	// enrollmentkey.DefaultMaxUses holds the default value on creation for the max_uses field.
+	enrollmentkey.DefaultMaxUses = enrollmentkeyDescMaxUses.Default.(int)
+	// enrollmentkey.MaxUsesValidator is a validator for the "max_uses" field. It is called by the builders before save.
+	enrollmentkey.MaxUsesValidator = enrollmentkeyDescMaxUses.Validators[0].(func(int) error)
+	// enrollmentkeyDescUses is the schema descriptor for uses field.
+	enrollmentkeyDescUses := enrollmentkeyFields[4].Descriptor()
+	// enrollmentkey.DefaultUses holds the default value on creation for the uses field.
+	enrollmentkey.DefaultUses = enrollmentkeyDescUses.Default.(int)
+	// enrollmentkey.UsesValidator is a validator for the "uses" field. It is called by the builders before save.
+	enrollmentkey.UsesValidator = enrollmentkeyDescUses.Validators[0].(func(int) error)
+	// enrollmentkeyDescRevoked is the schema descriptor for revoked field.
+	enrollmentkeyDescRevoked := enrollmentkeyFields[6].Descriptor()
+	// enrollmentkey.DefaultRevoked holds the default value on creation for the revoked field.
+	enrollmentkey.DefaultRevoked = enrollmentkeyDescRevoked.Default.(bool)
+	revokedtokenMixin := schema.RevokedToken{}.Mixin()
+	revokedtokenMixinFields0 := revokedtokenMixin[0].Fields()
+	_ = revokedtokenMixinFields0
+	revokedtokenFields := schema.RevokedToken{}.Fields()
+	_ = revokedtokenFields
+	// revokedtokenDescCreatedTime is the schema descriptor for created_time field.
+	revokedtokenDescCreatedTime := revokedtokenMixinFields0[0].Descriptor()
+	// revokedtoken.DefaultCreatedTime holds the default value on creation for the created_time field.
+	revokedtoken.DefaultCreatedTime = revokedtokenDescCreatedTime.Default.(func() time.Time)
+	settingsMixin := schema.Settings{}.Mixin()
+	settingsHooks := schema.Settings{}.Hooks()
+	settings.Hooks[0] = settingsHooks[0]
+	settingsMixinFields0 := settingsMixin[0].Fields()
+	_ = settingsMixinFields0
+	settingsFields := schema.Settings{}.Fields()
+	_ = settingsFields
+	// settingsDescCreatedTime is the schema descriptor for created_time field.
+	settingsDescCreatedTime := settingsMixinFields0[0].Descriptor()
+	// settings.DefaultCreatedTime holds the default value on creation for the created_time field.
+	settings.DefaultCreatedTime = settingsDescCreatedTime.Default.(func() time.Time)
+	// settingsDescUpdatedTime is the schema descriptor for updated_time field.
+	settingsDescUpdatedTime := settingsMixinFields0[1].Descriptor()
+	// settings.DefaultUpdatedTime holds the default value on creation for the updated_time field.
+	settings.DefaultUpdatedTime = settingsDescUpdatedTime.Default.(func() time.Time)
+	// settings.UpdateDefaultUpdatedTime holds the default value on update for the updated_time field.
+	settings.UpdateDefaultUpdatedTime = settingsDescUpdatedTime.UpdateDefault.(func() time.Time)
+	// settingsDescCipher is the schema descriptor for cipher field.
+	settingsDescCipher := settingsFields[1].Descriptor()
+	// settings.DefaultCipher holds the default value on creation for the cipher field.
+	settings.DefaultCipher = settingsDescCipher.Default.(string)
+	// settingsDescPortRelay is the schema descriptor for port_relay field.
+	settingsDescPortRelay := settingsFields[8].Descriptor()
+	// settings.DefaultPortRelay holds the default value on creation for the port_relay field.
+	settings.DefaultPortRelay = settingsDescPortRelay.Default.(bool)
+	// settingsDescNebulaPort is the schema descriptor for nebula_port field.
+	settingsDescNebulaPort := settingsFields[9].Descriptor()
+	// settings.DefaultNebulaPort holds the default value on creation for the nebula_port field.
+	settings.DefaultNebulaPort = settingsDescNebulaPort.Default.(int)
+	// settingsDescHTTPPort is the schema descriptor for http_port field.
+	settingsDescHTTPPort := settingsFields[10].Descriptor()
+	// settings.DefaultHTTPPort holds the default value on creation for the http_port field.
+	settings.DefaultHTTPPort = settingsDescHTTPPort.Default.(int)
+	// settingsDescHTTPSPort is the schema descriptor for https_port field.
+	settingsDescHTTPSPort := settingsFields[11].Descriptor()
+	// settings.DefaultHTTPSPort holds the default value on creation for the https_port field.
+	settings.DefaultHTTPSPort = settingsDescHTTPSPort.Default.(int)
+	// settingsDescRequireClientKeys is the schema descriptor for require_client_keys field.
+	settingsDescRequireClientKeys := settingsFields[15].Descriptor()
+	// settings.DefaultRequireClientKeys holds the default value on creation for the require_client_keys field.
+	settings.DefaultRequireClientKeys = settingsDescRequireClientKeys.Default.(bool)
+}
 
 const (
 	Version = "v0.14.5"                                         // Version of ent codegen.
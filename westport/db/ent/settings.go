@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -39,6 +40,24 @@ type Settings struct {
 	Cidr helpers.IpCidr `json:"cidr,omitempty"`
 	// Network cidr range
 	PortOverlayIP ipconv.IP `json:"port_overlay_ip,omitempty"`
+	// West port relays traffic for devices that cannot hole punch
+	PortRelay bool `json:"port_relay,omitempty"`
+	// UDP port nebula listens on
+	NebulaPort int `json:"nebula_port,omitempty"`
+	// Port the API listens on for HTTP
+	HTTPPort int `json:"http_port,omitempty"`
+	// Port the API listens on for HTTPS
+	HTTPSPort int `json:"https_port,omitempty"`
+	// Public host or IP devices use to reach west port. Discovered when empty
+	PublicHost string `json:"public_host,omitempty"`
+	// Public nebula port when remapped by a load balancer. Defaults to nebula_port
+	PublicNebulaPort int `json:"public_nebula_port,omitempty"`
+	// Public API port when remapped by a load balancer. Defaults to http_port or https_port
+	PublicAPIPort int `json:"public_api_port,omitempty"`
+	// Only provision devices that generate their own key pair. Rejects provision_device, which returns a port generated private key
+	RequireClientKeys bool `json:"require_client_keys,omitempty"`
+	// Inbound firewall rules pushed to devices. Devices allow all inbound traffic when unset
+	FirewallInbound []helpers.FirewallRule `json:"firewall_inbound,omitempty"`
 	// LetsencryptRegistration holds the value of the "letsencrypt_registration" field.
 	LetsencryptRegistration helpers.EncryptedBytes `json:"-"`
 	// TLSCert holds the value of the "tls_cert" field.
@@ -55,13 +74,17 @@ func (*Settings) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case settings.FieldTLSCert, settings.FieldTLSCertKey:
 			values[i] = &sql.NullScanner{S: new(helpers.EncryptedBytes)}
+		case settings.FieldFirewallInbound:
+			values[i] = new([]byte)
 		case settings.FieldCaCrt, settings.FieldCaKey, settings.FieldLighthouseCrt, settings.FieldLighthouseKey, settings.FieldLetsencryptRegistration:
 			values[i] = new(helpers.EncryptedBytes)
 		case settings.FieldCidr:
 			values[i] = new(helpers.IpCidr)
-		case settings.FieldID, settings.FieldPortOverlayIP:
+		case settings.FieldPortRelay, settings.FieldRequireClientKeys:
+			values[i] = new(sql.NullBool)
+		case settings.FieldID, settings.FieldPortOverlayIP, settings.FieldNebulaPort, settings.FieldHTTPPort, settings.FieldHTTPSPort, settings.FieldPublicNebulaPort, settings.FieldPublicAPIPort:
 			values[i] = new(sql.NullInt64)
-		case settings.FieldDomainZone, settings.FieldCipher:
+		case settings.FieldDomainZone, settings.FieldCipher, settings.FieldPublicHost:
 			values[i] = new(sql.NullString)
 		case settings.FieldCreatedTime, settings.FieldUpdatedTime:
 			values[i] = new(sql.NullTime)
@@ -146,6 +169,62 @@ func (_m *Settings) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.PortOverlayIP = ipconv.IP(value.Int64)
 			}
+		case settings.FieldPortRelay:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field port_relay", values[i])
+			} else if value.Valid {
+				_m.PortRelay = value.Bool
+			}
+		case settings.FieldNebulaPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field nebula_port", values[i])
+			} else if value.Valid {
+				_m.NebulaPort = int(value.Int64)
+			}
+		case settings.FieldHTTPPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field http_port", values[i])
+			} else if value.Valid {
+				_m.HTTPPort = int(value.Int64)
+			}
+		case settings.FieldHTTPSPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field https_port", values[i])
+			} else if value.Valid {
+				_m.HTTPSPort = int(value.Int64)
+			}
+		case settings.FieldPublicHost:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field public_host", values[i])
+			} else if value.Valid {
+				_m.PublicHost = value.String
+			}
+		case settings.FieldPublicNebulaPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field public_nebula_port", values[i])
+			} else if value.Valid {
+				_m.PublicNebulaPort = int(value.Int64)
+			}
+		case settings.FieldPublicAPIPort:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field public_api_port", values[i])
+			} else if value.Valid {
+				_m.PublicAPIPort = int(value.Int64)
+			}
+		case settings.FieldRequireClientKeys:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field require_client_keys", values[i])
+			} else if value.Valid {
+				_m.RequireClientKeys = value.Bool
+			}
+		case settings.FieldFirewallInbound:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field firewall_inbound", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.FirewallInbound); err != nil {
+					return fmt.Errorf("unmarshal field firewall_inbound: %w", err)
+				}
+			}
 		case settings.FieldLetsencryptRegistration:
 			if value, ok := values[i].(*helpers.EncryptedBytes); !ok {
 				return fmt.Errorf("unexpected type %T for field letsencrypt_registration", values[i])
@@ -229,6 +308,33 @@ func (_m *Settings) String() string {
 	builder.WriteString("port_overlay_ip=")
 	builder.WriteString(fmt.Sprintf("%v", _m.PortOverlayIP))
 	builder.WriteString(", ")
+	builder.WriteString("port_relay=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PortRelay))
+	builder.WriteString(", ")
+	builder.WriteString("nebula_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NebulaPort))
+	builder.WriteString(", ")
+	builder.WriteString("http_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HTTPPort))
+	builder.WriteString(", ")
+	builder.WriteString("https_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.HTTPSPort))
+	builder.WriteString(", ")
+	builder.WriteString("public_host=")
+	builder.WriteString(_m.PublicHost)
+	builder.WriteString(", ")
+	builder.WriteString("public_nebula_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicNebulaPort))
+	builder.WriteString(", ")
+	builder.WriteString("public_api_port=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PublicAPIPort))
+	builder.WriteString(", ")
+	builder.WriteString("require_client_keys=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequireClientKeys))
+	builder.WriteString(", ")
+	builder.WriteString("firewall_inbound=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FirewallInbound))
+	builder.WriteString(", ")
 	builder.WriteString("letsencrypt_registration=<sensitive>")
 	builder.WriteString(", ")
 	builder.WriteString("tls_cert=<sensitive>")
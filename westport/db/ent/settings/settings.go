@@ -5,6 +5,7 @@ package settings
 import (
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 )
 
@@ -33,6 +34,24 @@ const (
 	FieldCidr = "cidr"
 	// FieldPortOverlayIP holds the string denoting the port_overlay_ip field in the database.
 	FieldPortOverlayIP = "port_overlay_ip"
+	// FieldPortRelay holds the string denoting the port_relay field in the database.
+	FieldPortRelay = "port_relay"
+	// FieldNebulaPort holds the string denoting the nebula_port field in the database.
+	FieldNebulaPort = "nebula_port"
+	// FieldHTTPPort holds the string denoting the http_port field in the database.
+	FieldHTTPPort = "http_port"
+	// FieldHTTPSPort holds the string denoting the https_port field in the database.
+	FieldHTTPSPort = "https_port"
+	// FieldPublicHost holds the string denoting the public_host field in the database.
+	FieldPublicHost = "public_host"
+	// FieldPublicNebulaPort holds the string denoting the public_nebula_port field in the database.
+	FieldPublicNebulaPort = "public_nebula_port"
+	// FieldPublicAPIPort holds the string denoting the public_api_port field in the database.
+	FieldPublicAPIPort = "public_api_port"
+	// FieldRequireClientKeys holds the string denoting the require_client_keys field in the database.
+	FieldRequireClientKeys = "require_client_keys"
+	// FieldFirewallInbound holds the string denoting the firewall_inbound field in the database.
+	FieldFirewallInbound = "firewall_inbound"
 	// FieldLetsencryptRegistration holds the string denoting the letsencrypt_registration field in the database.
 	FieldLetsencryptRegistration = "letsencrypt_registration"
 	// FieldTLSCert holds the string denoting the tls_cert field in the database.
@@ -56,6 +75,15 @@ var Columns = []string{
 	FieldLighthouseKey,
 	FieldCidr,
 	FieldPortOverlayIP,
+	FieldPortRelay,
+	FieldNebulaPort,
+	FieldHTTPPort,
+	FieldHTTPSPort,
+	FieldPublicHost,
+	FieldPublicNebulaPort,
+	FieldPublicAPIPort,
+	FieldRequireClientKeys,
+	FieldFirewallInbound,
 	FieldLetsencryptRegistration,
 	FieldTLSCert,
 	FieldTLSCertKey,
@@ -71,7 +99,13 @@ func ValidColumn(column string) bool {
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/sprisa/west/westport/db/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// DefaultCreatedTime holds the default value on creation for the "created_time" field.
 	DefaultCreatedTime func() time.Time
 	// DefaultUpdatedTime holds the default value on creation for the "updated_time" field.
@@ -80,6 +114,16 @@ var (
 	UpdateDefaultUpdatedTime func() time.Time
 	// DefaultCipher holds the default value on creation for the "cipher" field.
 	DefaultCipher string
+	// DefaultPortRelay holds the default value on creation for the "port_relay" field.
+	DefaultPortRelay bool
+	// DefaultNebulaPort holds the default value on creation for the "nebula_port" field.
+	DefaultNebulaPort int
+	// DefaultHTTPPort holds the default value on creation for the "http_port" field.
+	DefaultHTTPPort int
+	// DefaultHTTPSPort holds the default value on creation for the "https_port" field.
+	DefaultHTTPSPort int
+	// DefaultRequireClientKeys holds the default value on creation for the "require_client_keys" field.
+	DefaultRequireClientKeys bool
 )
 
 // OrderOption defines the ordering options for the Settings queries.
@@ -119,3 +163,43 @@ func ByCidr(opts ...sql.OrderTermOption) OrderOption {
 func ByPortOverlayIP(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPortOverlayIP, opts...).ToFunc()
 }
+
+// ByPortRelay orders the results by the port_relay field.
+func ByPortRelay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPortRelay, opts...).ToFunc()
+}
+
+// ByNebulaPort orders the results by the nebula_port field.
+func ByNebulaPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNebulaPort, opts...).ToFunc()
+}
+
+// ByHTTPPort orders the results by the http_port field.
+func ByHTTPPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTTPPort, opts...).ToFunc()
+}
+
+// ByHTTPSPort orders the results by the https_port field.
+func ByHTTPSPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHTTPSPort, opts...).ToFunc()
+}
+
+// ByPublicHost orders the results by the public_host field.
+func ByPublicHost(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicHost, opts...).ToFunc()
+}
+
+// ByPublicNebulaPort orders the results by the public_nebula_port field.
+func ByPublicNebulaPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicNebulaPort, opts...).ToFunc()
+}
+
+// ByPublicAPIPort orders the results by the public_api_port field.
+func ByPublicAPIPort(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublicAPIPort, opts...).ToFunc()
+}
+
+// ByRequireClientKeys orders the results by the require_client_keys field.
+func ByRequireClientKeys(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequireClientKeys, opts...).ToFunc()
+}
@@ -107,6 +107,46 @@ func PortOverlayIP(v ipconv.IP) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldPortOverlayIP, vc))
 }
 
+// PortRelay applies equality check predicate on the "port_relay" field. It's identical to PortRelayEQ.
+func PortRelay(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPortRelay, v))
+}
+
+// NebulaPort applies equality check predicate on the "nebula_port" field. It's identical to NebulaPortEQ.
+func NebulaPort(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldNebulaPort, v))
+}
+
+// HTTPPort applies equality check predicate on the "http_port" field. It's identical to HTTPPortEQ.
+func HTTPPort(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldHTTPPort, v))
+}
+
+// HTTPSPort applies equality check predicate on the "https_port" field. It's identical to HTTPSPortEQ.
+func HTTPSPort(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldHTTPSPort, v))
+}
+
+// PublicHost applies equality check predicate on the "public_host" field. It's identical to PublicHostEQ.
+func PublicHost(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPublicHost, v))
+}
+
+// PublicNebulaPort applies equality check predicate on the "public_nebula_port" field. It's identical to PublicNebulaPortEQ.
+func PublicNebulaPort(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPublicNebulaPort, v))
+}
+
+// PublicAPIPort applies equality check predicate on the "public_api_port" field. It's identical to PublicAPIPortEQ.
+func PublicAPIPort(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPublicAPIPort, v))
+}
+
+// RequireClientKeys applies equality check predicate on the "require_client_keys" field. It's identical to RequireClientKeysEQ.
+func RequireClientKeys(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldRequireClientKeys, v))
+}
+
 // LetsencryptRegistration applies equality check predicate on the "letsencrypt_registration" field. It's identical to LetsencryptRegistrationEQ.
 func LetsencryptRegistration(v helpers.EncryptedBytes) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldLetsencryptRegistration, v))
@@ -626,6 +666,331 @@ func PortOverlayIPLTE(v ipconv.IP) predicate.Settings {
 	return predicate.Settings(sql.FieldLTE(FieldPortOverlayIP, vc))
 }
 
+// PortRelayEQ applies the EQ predicate on the "port_relay" field.
+func PortRelayEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPortRelay, v))
+}
+
+// PortRelayNEQ applies the NEQ predicate on the "port_relay" field.
+func PortRelayNEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldPortRelay, v))
+}
+
+// NebulaPortEQ applies the EQ predicate on the "nebula_port" field.
+func NebulaPortEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldNebulaPort, v))
+}
+
+// NebulaPortNEQ applies the NEQ predicate on the "nebula_port" field.
+func NebulaPortNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldNebulaPort, v))
+}
+
+// NebulaPortIn applies the In predicate on the "nebula_port" field.
+func NebulaPortIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldNebulaPort, vs...))
+}
+
+// NebulaPortNotIn applies the NotIn predicate on the "nebula_port" field.
+func NebulaPortNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldNebulaPort, vs...))
+}
+
+// NebulaPortGT applies the GT predicate on the "nebula_port" field.
+func NebulaPortGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldNebulaPort, v))
+}
+
+// NebulaPortGTE applies the GTE predicate on the "nebula_port" field.
+func NebulaPortGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldNebulaPort, v))
+}
+
+// NebulaPortLT applies the LT predicate on the "nebula_port" field.
+func NebulaPortLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldNebulaPort, v))
+}
+
+// NebulaPortLTE applies the LTE predicate on the "nebula_port" field.
+func NebulaPortLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldNebulaPort, v))
+}
+
+// HTTPPortEQ applies the EQ predicate on the "http_port" field.
+func HTTPPortEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldHTTPPort, v))
+}
+
+// HTTPPortNEQ applies the NEQ predicate on the "http_port" field.
+func HTTPPortNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldHTTPPort, v))
+}
+
+// HTTPPortIn applies the In predicate on the "http_port" field.
+func HTTPPortIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldHTTPPort, vs...))
+}
+
+// HTTPPortNotIn applies the NotIn predicate on the "http_port" field.
+func HTTPPortNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldHTTPPort, vs...))
+}
+
+// HTTPPortGT applies the GT predicate on the "http_port" field.
+func HTTPPortGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldHTTPPort, v))
+}
+
+// HTTPPortGTE applies the GTE predicate on the "http_port" field.
+func HTTPPortGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldHTTPPort, v))
+}
+
+// HTTPPortLT applies the LT predicate on the "http_port" field.
+func HTTPPortLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldHTTPPort, v))
+}
+
+// HTTPPortLTE applies the LTE predicate on the "http_port" field.
+func HTTPPortLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldHTTPPort, v))
+}
+
+// HTTPSPortEQ applies the EQ predicate on the "https_port" field.
+func HTTPSPortEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldHTTPSPort, v))
+}
+
+// HTTPSPortNEQ applies the NEQ predicate on the "https_port" field.
+func HTTPSPortNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldHTTPSPort, v))
+}
+
+// HTTPSPortIn applies the In predicate on the "https_port" field.
+func HTTPSPortIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldHTTPSPort, vs...))
+}
+
+// HTTPSPortNotIn applies the NotIn predicate on the "https_port" field.
+func HTTPSPortNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldHTTPSPort, vs...))
+}
+
+// HTTPSPortGT applies the GT predicate on the "https_port" field.
+func HTTPSPortGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldHTTPSPort, v))
+}
+
+// HTTPSPortGTE applies the GTE predicate on the "https_port" field.
+func HTTPSPortGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldHTTPSPort, v))
+}
+
+// HTTPSPortLT applies the LT predicate on the "https_port" field.
+func HTTPSPortLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldHTTPSPort, v))
+}
+
+// HTTPSPortLTE applies the LTE predicate on the "https_port" field.
+func HTTPSPortLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldHTTPSPort, v))
+}
+
+// PublicHostEQ applies the EQ predicate on the "public_host" field.
+func PublicHostEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPublicHost, v))
+}
+
+// PublicHostNEQ applies the NEQ predicate on the "public_host" field.
+func PublicHostNEQ(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldPublicHost, v))
+}
+
+// PublicHostIn applies the In predicate on the "public_host" field.
+func PublicHostIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldPublicHost, vs...))
+}
+
+// PublicHostNotIn applies the NotIn predicate on the "public_host" field.
+func PublicHostNotIn(vs ...string) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldPublicHost, vs...))
+}
+
+// PublicHostGT applies the GT predicate on the "public_host" field.
+func PublicHostGT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldPublicHost, v))
+}
+
+// PublicHostGTE applies the GTE predicate on the "public_host" field.
+func PublicHostGTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldPublicHost, v))
+}
+
+// PublicHostLT applies the LT predicate on the "public_host" field.
+func PublicHostLT(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldPublicHost, v))
+}
+
+// PublicHostLTE applies the LTE predicate on the "public_host" field.
+func PublicHostLTE(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldPublicHost, v))
+}
+
+// PublicHostContains applies the Contains predicate on the "public_host" field.
+func PublicHostContains(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContains(FieldPublicHost, v))
+}
+
+// PublicHostHasPrefix applies the HasPrefix predicate on the "public_host" field.
+func PublicHostHasPrefix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasPrefix(FieldPublicHost, v))
+}
+
+// PublicHostHasSuffix applies the HasSuffix predicate on the "public_host" field.
+func PublicHostHasSuffix(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldHasSuffix(FieldPublicHost, v))
+}
+
+// PublicHostIsNil applies the IsNil predicate on the "public_host" field.
+func PublicHostIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldPublicHost))
+}
+
+// PublicHostNotNil applies the NotNil predicate on the "public_host" field.
+func PublicHostNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldPublicHost))
+}
+
+// PublicHostEqualFold applies the EqualFold predicate on the "public_host" field.
+func PublicHostEqualFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldEqualFold(FieldPublicHost, v))
+}
+
+// PublicHostContainsFold applies the ContainsFold predicate on the "public_host" field.
+func PublicHostContainsFold(v string) predicate.Settings {
+	return predicate.Settings(sql.FieldContainsFold(FieldPublicHost, v))
+}
+
+// PublicNebulaPortEQ applies the EQ predicate on the "public_nebula_port" field.
+func PublicNebulaPortEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPublicNebulaPort, v))
+}
+
+// PublicNebulaPortNEQ applies the NEQ predicate on the "public_nebula_port" field.
+func PublicNebulaPortNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldPublicNebulaPort, v))
+}
+
+// PublicNebulaPortIn applies the In predicate on the "public_nebula_port" field.
+func PublicNebulaPortIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldPublicNebulaPort, vs...))
+}
+
+// PublicNebulaPortNotIn applies the NotIn predicate on the "public_nebula_port" field.
+func PublicNebulaPortNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldPublicNebulaPort, vs...))
+}
+
+// PublicNebulaPortGT applies the GT predicate on the "public_nebula_port" field.
+func PublicNebulaPortGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldPublicNebulaPort, v))
+}
+
+// PublicNebulaPortGTE applies the GTE predicate on the "public_nebula_port" field.
+func PublicNebulaPortGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldPublicNebulaPort, v))
+}
+
+// PublicNebulaPortLT applies the LT predicate on the "public_nebula_port" field.
+func PublicNebulaPortLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldPublicNebulaPort, v))
+}
+
+// PublicNebulaPortLTE applies the LTE predicate on the "public_nebula_port" field.
+func PublicNebulaPortLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldPublicNebulaPort, v))
+}
+
+// PublicNebulaPortIsNil applies the IsNil predicate on the "public_nebula_port" field.
+func PublicNebulaPortIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldPublicNebulaPort))
+}
+
+// PublicNebulaPortNotNil applies the NotNil predicate on the "public_nebula_port" field.
+func PublicNebulaPortNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldPublicNebulaPort))
+}
+
+// PublicAPIPortEQ applies the EQ predicate on the "public_api_port" field.
+func PublicAPIPortEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldPublicAPIPort, v))
+}
+
+// PublicAPIPortNEQ applies the NEQ predicate on the "public_api_port" field.
+func PublicAPIPortNEQ(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldPublicAPIPort, v))
+}
+
+// PublicAPIPortIn applies the In predicate on the "public_api_port" field.
+func PublicAPIPortIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldIn(FieldPublicAPIPort, vs...))
+}
+
+// PublicAPIPortNotIn applies the NotIn predicate on the "public_api_port" field.
+func PublicAPIPortNotIn(vs ...int) predicate.Settings {
+	return predicate.Settings(sql.FieldNotIn(FieldPublicAPIPort, vs...))
+}
+
+// PublicAPIPortGT applies the GT predicate on the "public_api_port" field.
+func PublicAPIPortGT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGT(FieldPublicAPIPort, v))
+}
+
+// PublicAPIPortGTE applies the GTE predicate on the "public_api_port" field.
+func PublicAPIPortGTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldGTE(FieldPublicAPIPort, v))
+}
+
+// PublicAPIPortLT applies the LT predicate on the "public_api_port" field.
+func PublicAPIPortLT(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLT(FieldPublicAPIPort, v))
+}
+
+// PublicAPIPortLTE applies the LTE predicate on the "public_api_port" field.
+func PublicAPIPortLTE(v int) predicate.Settings {
+	return predicate.Settings(sql.FieldLTE(FieldPublicAPIPort, v))
+}
+
+// PublicAPIPortIsNil applies the IsNil predicate on the "public_api_port" field.
+func PublicAPIPortIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldPublicAPIPort))
+}
+
+// PublicAPIPortNotNil applies the NotNil predicate on the "public_api_port" field.
+func PublicAPIPortNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldPublicAPIPort))
+}
+
+// RequireClientKeysEQ applies the EQ predicate on the "require_client_keys" field.
+func RequireClientKeysEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldEQ(FieldRequireClientKeys, v))
+}
+
+// RequireClientKeysNEQ applies the NEQ predicate on the "require_client_keys" field.
+func RequireClientKeysNEQ(v bool) predicate.Settings {
+	return predicate.Settings(sql.FieldNEQ(FieldRequireClientKeys, v))
+}
+
+// FirewallInboundIsNil applies the IsNil predicate on the "firewall_inbound" field.
+func FirewallInboundIsNil() predicate.Settings {
+	return predicate.Settings(sql.FieldIsNull(FieldFirewallInbound))
+}
+
+// FirewallInboundNotNil applies the NotNil predicate on the "firewall_inbound" field.
+func FirewallInboundNotNil() predicate.Settings {
+	return predicate.Settings(sql.FieldNotNull(FieldFirewallInbound))
+}
+
 // LetsencryptRegistrationEQ applies the EQ predicate on the "letsencrypt_registration" field.
 func LetsencryptRegistrationEQ(v helpers.EncryptedBytes) predicate.Settings {
 	return predicate.Settings(sql.FieldEQ(FieldLetsencryptRegistration, v))
@@ -114,6 +114,124 @@ func (_c *SettingsCreate) SetPortOverlayIP(v ipconv.IP) *SettingsCreate {
 	return _c
 }
 
+// SetPortRelay sets the "port_relay" field.
+func (_c *SettingsCreate) SetPortRelay(v bool) *SettingsCreate {
+	_c.mutation.SetPortRelay(v)
+	return _c
+}
+
+// SetNillablePortRelay sets the "port_relay" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillablePortRelay(v *bool) *SettingsCreate {
+	if v != nil {
+		_c.SetPortRelay(*v)
+	}
+	return _c
+}
+
+// SetNebulaPort sets the "nebula_port" field.
+func (_c *SettingsCreate) SetNebulaPort(v int) *SettingsCreate {
+	_c.mutation.SetNebulaPort(v)
+	return _c
+}
+
+// SetNillableNebulaPort sets the "nebula_port" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableNebulaPort(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetNebulaPort(*v)
+	}
+	return _c
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (_c *SettingsCreate) SetHTTPPort(v int) *SettingsCreate {
+	_c.mutation.SetHTTPPort(v)
+	return _c
+}
+
+// SetNillableHTTPPort sets the "http_port" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableHTTPPort(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetHTTPPort(*v)
+	}
+	return _c
+}
+
+// SetHTTPSPort sets the "https_port" field.
+func (_c *SettingsCreate) SetHTTPSPort(v int) *SettingsCreate {
+	_c.mutation.SetHTTPSPort(v)
+	return _c
+}
+
+// SetNillableHTTPSPort sets the "https_port" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableHTTPSPort(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetHTTPSPort(*v)
+	}
+	return _c
+}
+
+// SetPublicHost sets the "public_host" field.
+func (_c *SettingsCreate) SetPublicHost(v string) *SettingsCreate {
+	_c.mutation.SetPublicHost(v)
+	return _c
+}
+
+// SetNillablePublicHost sets the "public_host" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillablePublicHost(v *string) *SettingsCreate {
+	if v != nil {
+		_c.SetPublicHost(*v)
+	}
+	return _c
+}
+
+// SetPublicNebulaPort sets the "public_nebula_port" field.
+func (_c *SettingsCreate) SetPublicNebulaPort(v int) *SettingsCreate {
+	_c.mutation.SetPublicNebulaPort(v)
+	return _c
+}
+
+// SetNillablePublicNebulaPort sets the "public_nebula_port" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillablePublicNebulaPort(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetPublicNebulaPort(*v)
+	}
+	return _c
+}
+
+// SetPublicAPIPort sets the "public_api_port" field.
+func (_c *SettingsCreate) SetPublicAPIPort(v int) *SettingsCreate {
+	_c.mutation.SetPublicAPIPort(v)
+	return _c
+}
+
+// SetNillablePublicAPIPort sets the "public_api_port" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillablePublicAPIPort(v *int) *SettingsCreate {
+	if v != nil {
+		_c.SetPublicAPIPort(*v)
+	}
+	return _c
+}
+
+// SetRequireClientKeys sets the "require_client_keys" field.
+func (_c *SettingsCreate) SetRequireClientKeys(v bool) *SettingsCreate {
+	_c.mutation.SetRequireClientKeys(v)
+	return _c
+}
+
+// SetNillableRequireClientKeys sets the "require_client_keys" field if the given value is not nil.
+func (_c *SettingsCreate) SetNillableRequireClientKeys(v *bool) *SettingsCreate {
+	if v != nil {
+		_c.SetRequireClientKeys(*v)
+	}
+	return _c
+}
+
+// SetFirewallInbound sets the "firewall_inbound" field.
+func (_c *SettingsCreate) SetFirewallInbound(v []helpers.FirewallRule) *SettingsCreate {
+	_c.mutation.SetFirewallInbound(v)
+	return _c
+}
+
 // SetLetsencryptRegistration sets the "letsencrypt_registration" field.
 func (_c *SettingsCreate) SetLetsencryptRegistration(v helpers.EncryptedBytes) *SettingsCreate {
 	_c.mutation.SetLetsencryptRegistration(v)
@@ -139,7 +257,9 @@ func (_c *SettingsCreate) Mutation() *SettingsMutation {
 
 // Save creates the Settings in the database.
 func (_c *SettingsCreate) Save(ctx context.Context) (*Settings, error) {
-	_c.defaults()
+	if err := _c.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
 }
 
@@ -166,12 +286,18 @@ func (_c *SettingsCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_c *SettingsCreate) defaults() {
+func (_c *SettingsCreate) defaults() error {
 	if _, ok := _c.mutation.CreatedTime(); !ok {
+		if settings.DefaultCreatedTime == nil {
+			return fmt.Errorf("ent: uninitialized settings.DefaultCreatedTime (forgotten import ent/runtime?)")
+		}
 		v := settings.DefaultCreatedTime()
 		_c.mutation.SetCreatedTime(v)
 	}
 	if _, ok := _c.mutation.UpdatedTime(); !ok {
+		if settings.DefaultUpdatedTime == nil {
+			return fmt.Errorf("ent: uninitialized settings.DefaultUpdatedTime (forgotten import ent/runtime?)")
+		}
 		v := settings.DefaultUpdatedTime()
 		_c.mutation.SetUpdatedTime(v)
 	}
@@ -179,6 +305,27 @@ func (_c *SettingsCreate) defaults() {
 		v := settings.DefaultCipher
 		_c.mutation.SetCipher(v)
 	}
+	if _, ok := _c.mutation.PortRelay(); !ok {
+		v := settings.DefaultPortRelay
+		_c.mutation.SetPortRelay(v)
+	}
+	if _, ok := _c.mutation.NebulaPort(); !ok {
+		v := settings.DefaultNebulaPort
+		_c.mutation.SetNebulaPort(v)
+	}
+	if _, ok := _c.mutation.HTTPPort(); !ok {
+		v := settings.DefaultHTTPPort
+		_c.mutation.SetHTTPPort(v)
+	}
+	if _, ok := _c.mutation.HTTPSPort(); !ok {
+		v := settings.DefaultHTTPSPort
+		_c.mutation.SetHTTPSPort(v)
+	}
+	if _, ok := _c.mutation.RequireClientKeys(); !ok {
+		v := settings.DefaultRequireClientKeys
+		_c.mutation.SetRequireClientKeys(v)
+	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -210,6 +357,21 @@ func (_c *SettingsCreate) check() error {
 	if _, ok := _c.mutation.PortOverlayIP(); !ok {
 		return &ValidationError{Name: "port_overlay_ip", err: errors.New(`ent: missing required field "Settings.port_overlay_ip"`)}
 	}
+	if _, ok := _c.mutation.PortRelay(); !ok {
+		return &ValidationError{Name: "port_relay", err: errors.New(`ent: missing required field "Settings.port_relay"`)}
+	}
+	if _, ok := _c.mutation.NebulaPort(); !ok {
+		return &ValidationError{Name: "nebula_port", err: errors.New(`ent: missing required field "Settings.nebula_port"`)}
+	}
+	if _, ok := _c.mutation.HTTPPort(); !ok {
+		return &ValidationError{Name: "http_port", err: errors.New(`ent: missing required field "Settings.http_port"`)}
+	}
+	if _, ok := _c.mutation.HTTPSPort(); !ok {
+		return &ValidationError{Name: "https_port", err: errors.New(`ent: missing required field "Settings.https_port"`)}
+	}
+	if _, ok := _c.mutation.RequireClientKeys(); !ok {
+		return &ValidationError{Name: "require_client_keys", err: errors.New(`ent: missing required field "Settings.require_client_keys"`)}
+	}
 	return nil
 }
 
@@ -276,6 +438,42 @@ func (_c *SettingsCreate) createSpec() (*Settings, *sqlgraph.CreateSpec) {
 		_spec.SetField(settings.FieldPortOverlayIP, field.TypeUint32, value)
 		_node.PortOverlayIP = value
 	}
+	if value, ok := _c.mutation.PortRelay(); ok {
+		_spec.SetField(settings.FieldPortRelay, field.TypeBool, value)
+		_node.PortRelay = value
+	}
+	if value, ok := _c.mutation.NebulaPort(); ok {
+		_spec.SetField(settings.FieldNebulaPort, field.TypeInt, value)
+		_node.NebulaPort = value
+	}
+	if value, ok := _c.mutation.HTTPPort(); ok {
+		_spec.SetField(settings.FieldHTTPPort, field.TypeInt, value)
+		_node.HTTPPort = value
+	}
+	if value, ok := _c.mutation.HTTPSPort(); ok {
+		_spec.SetField(settings.FieldHTTPSPort, field.TypeInt, value)
+		_node.HTTPSPort = value
+	}
+	if value, ok := _c.mutation.PublicHost(); ok {
+		_spec.SetField(settings.FieldPublicHost, field.TypeString, value)
+		_node.PublicHost = value
+	}
+	if value, ok := _c.mutation.PublicNebulaPort(); ok {
+		_spec.SetField(settings.FieldPublicNebulaPort, field.TypeInt, value)
+		_node.PublicNebulaPort = value
+	}
+	if value, ok := _c.mutation.PublicAPIPort(); ok {
+		_spec.SetField(settings.FieldPublicAPIPort, field.TypeInt, value)
+		_node.PublicAPIPort = value
+	}
+	if value, ok := _c.mutation.RequireClientKeys(); ok {
+		_spec.SetField(settings.FieldRequireClientKeys, field.TypeBool, value)
+		_node.RequireClientKeys = value
+	}
+	if value, ok := _c.mutation.FirewallInbound(); ok {
+		_spec.SetField(settings.FieldFirewallInbound, field.TypeJSON, value)
+		_node.FirewallInbound = value
+	}
 	if value, ok := _c.mutation.LetsencryptRegistration(); ok {
 		_spec.SetField(settings.FieldLetsencryptRegistration, field.TypeBytes, value)
 		_node.LetsencryptRegistration = value
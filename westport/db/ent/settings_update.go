@@ -10,6 +10,7 @@ import (
 
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/sprisa/west/util/ipconv"
 	"github.com/sprisa/west/westport/db/ent/predicate"
@@ -129,6 +130,189 @@ func (_u *SettingsUpdate) AddPortOverlayIP(v ipconv.IP) *SettingsUpdate {
 	return _u
 }
 
+// SetPortRelay sets the "port_relay" field.
+func (_u *SettingsUpdate) SetPortRelay(v bool) *SettingsUpdate {
+	_u.mutation.SetPortRelay(v)
+	return _u
+}
+
+// SetNillablePortRelay sets the "port_relay" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillablePortRelay(v *bool) *SettingsUpdate {
+	if v != nil {
+		_u.SetPortRelay(*v)
+	}
+	return _u
+}
+
+// SetNebulaPort sets the "nebula_port" field.
+func (_u *SettingsUpdate) SetNebulaPort(v int) *SettingsUpdate {
+	_u.mutation.ResetNebulaPort()
+	_u.mutation.SetNebulaPort(v)
+	return _u
+}
+
+// SetNillableNebulaPort sets the "nebula_port" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableNebulaPort(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetNebulaPort(*v)
+	}
+	return _u
+}
+
+// AddNebulaPort adds value to the "nebula_port" field.
+func (_u *SettingsUpdate) AddNebulaPort(v int) *SettingsUpdate {
+	_u.mutation.AddNebulaPort(v)
+	return _u
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (_u *SettingsUpdate) SetHTTPPort(v int) *SettingsUpdate {
+	_u.mutation.ResetHTTPPort()
+	_u.mutation.SetHTTPPort(v)
+	return _u
+}
+
+// SetNillableHTTPPort sets the "http_port" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableHTTPPort(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetHTTPPort(*v)
+	}
+	return _u
+}
+
+// AddHTTPPort adds value to the "http_port" field.
+func (_u *SettingsUpdate) AddHTTPPort(v int) *SettingsUpdate {
+	_u.mutation.AddHTTPPort(v)
+	return _u
+}
+
+// SetHTTPSPort sets the "https_port" field.
+func (_u *SettingsUpdate) SetHTTPSPort(v int) *SettingsUpdate {
+	_u.mutation.ResetHTTPSPort()
+	_u.mutation.SetHTTPSPort(v)
+	return _u
+}
+
+// SetNillableHTTPSPort sets the "https_port" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableHTTPSPort(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetHTTPSPort(*v)
+	}
+	return _u
+}
+
+// AddHTTPSPort adds value to the "https_port" field.
+func (_u *SettingsUpdate) AddHTTPSPort(v int) *SettingsUpdate {
+	_u.mutation.AddHTTPSPort(v)
+	return _u
+}
+
+// SetPublicHost sets the "public_host" field.
+func (_u *SettingsUpdate) SetPublicHost(v string) *SettingsUpdate {
+	_u.mutation.SetPublicHost(v)
+	return _u
+}
+
+// SetNillablePublicHost sets the "public_host" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillablePublicHost(v *string) *SettingsUpdate {
+	if v != nil {
+		_u.SetPublicHost(*v)
+	}
+	return _u
+}
+
+// ClearPublicHost clears the value of the "public_host" field.
+func (_u *SettingsUpdate) ClearPublicHost() *SettingsUpdate {
+	_u.mutation.ClearPublicHost()
+	return _u
+}
+
+// SetPublicNebulaPort sets the "public_nebula_port" field.
+func (_u *SettingsUpdate) SetPublicNebulaPort(v int) *SettingsUpdate {
+	_u.mutation.ResetPublicNebulaPort()
+	_u.mutation.SetPublicNebulaPort(v)
+	return _u
+}
+
+// SetNillablePublicNebulaPort sets the "public_nebula_port" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillablePublicNebulaPort(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetPublicNebulaPort(*v)
+	}
+	return _u
+}
+
+// AddPublicNebulaPort adds value to the "public_nebula_port" field.
+func (_u *SettingsUpdate) AddPublicNebulaPort(v int) *SettingsUpdate {
+	_u.mutation.AddPublicNebulaPort(v)
+	return _u
+}
+
+// ClearPublicNebulaPort clears the value of the "public_nebula_port" field.
+func (_u *SettingsUpdate) ClearPublicNebulaPort() *SettingsUpdate {
+	_u.mutation.ClearPublicNebulaPort()
+	return _u
+}
+
+// SetPublicAPIPort sets the "public_api_port" field.
+func (_u *SettingsUpdate) SetPublicAPIPort(v int) *SettingsUpdate {
+	_u.mutation.ResetPublicAPIPort()
+	_u.mutation.SetPublicAPIPort(v)
+	return _u
+}
+
+// SetNillablePublicAPIPort sets the "public_api_port" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillablePublicAPIPort(v *int) *SettingsUpdate {
+	if v != nil {
+		_u.SetPublicAPIPort(*v)
+	}
+	return _u
+}
+
+// AddPublicAPIPort adds value to the "public_api_port" field.
+func (_u *SettingsUpdate) AddPublicAPIPort(v int) *SettingsUpdate {
+	_u.mutation.AddPublicAPIPort(v)
+	return _u
+}
+
+// ClearPublicAPIPort clears the value of the "public_api_port" field.
+func (_u *SettingsUpdate) ClearPublicAPIPort() *SettingsUpdate {
+	_u.mutation.ClearPublicAPIPort()
+	return _u
+}
+
+// SetRequireClientKeys sets the "require_client_keys" field.
+func (_u *SettingsUpdate) SetRequireClientKeys(v bool) *SettingsUpdate {
+	_u.mutation.SetRequireClientKeys(v)
+	return _u
+}
+
+// SetNillableRequireClientKeys sets the "require_client_keys" field if the given value is not nil.
+func (_u *SettingsUpdate) SetNillableRequireClientKeys(v *bool) *SettingsUpdate {
+	if v != nil {
+		_u.SetRequireClientKeys(*v)
+	}
+	return _u
+}
+
+// SetFirewallInbound sets the "firewall_inbound" field.
+func (_u *SettingsUpdate) SetFirewallInbound(v []helpers.FirewallRule) *SettingsUpdate {
+	_u.mutation.SetFirewallInbound(v)
+	return _u
+}
+
+// AppendFirewallInbound appends value to the "firewall_inbound" field.
+func (_u *SettingsUpdate) AppendFirewallInbound(v []helpers.FirewallRule) *SettingsUpdate {
+	_u.mutation.AppendFirewallInbound(v)
+	return _u
+}
+
+// ClearFirewallInbound clears the value of the "firewall_inbound" field.
+func (_u *SettingsUpdate) ClearFirewallInbound() *SettingsUpdate {
+	_u.mutation.ClearFirewallInbound()
+	return _u
+}
+
 // SetLetsencryptRegistration sets the "letsencrypt_registration" field.
 func (_u *SettingsUpdate) SetLetsencryptRegistration(v helpers.EncryptedBytes) *SettingsUpdate {
 	_u.mutation.SetLetsencryptRegistration(v)
@@ -172,7 +356,9 @@ func (_u *SettingsUpdate) Mutation() *SettingsMutation {
 
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *SettingsUpdate) Save(ctx context.Context) (int, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return 0, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -199,11 +385,15 @@ func (_u *SettingsUpdate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *SettingsUpdate) defaults() {
+func (_u *SettingsUpdate) defaults() error {
 	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		if settings.UpdateDefaultUpdatedTime == nil {
+			return fmt.Errorf("ent: uninitialized settings.UpdateDefaultUpdatedTime (forgotten import ent/runtime?)")
+		}
 		v := settings.UpdateDefaultUpdatedTime()
 		_u.mutation.SetUpdatedTime(v)
 	}
+	return nil
 }
 
 func (_u *SettingsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
@@ -248,6 +438,65 @@ func (_u *SettingsUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.AddedPortOverlayIP(); ok {
 		_spec.AddField(settings.FieldPortOverlayIP, field.TypeUint32, value)
 	}
+	if value, ok := _u.mutation.PortRelay(); ok {
+		_spec.SetField(settings.FieldPortRelay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NebulaPort(); ok {
+		_spec.SetField(settings.FieldNebulaPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNebulaPort(); ok {
+		_spec.AddField(settings.FieldNebulaPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HTTPPort(); ok {
+		_spec.SetField(settings.FieldHTTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHTTPPort(); ok {
+		_spec.AddField(settings.FieldHTTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HTTPSPort(); ok {
+		_spec.SetField(settings.FieldHTTPSPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHTTPSPort(); ok {
+		_spec.AddField(settings.FieldHTTPSPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PublicHost(); ok {
+		_spec.SetField(settings.FieldPublicHost, field.TypeString, value)
+	}
+	if _u.mutation.PublicHostCleared() {
+		_spec.ClearField(settings.FieldPublicHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicNebulaPort(); ok {
+		_spec.SetField(settings.FieldPublicNebulaPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPublicNebulaPort(); ok {
+		_spec.AddField(settings.FieldPublicNebulaPort, field.TypeInt, value)
+	}
+	if _u.mutation.PublicNebulaPortCleared() {
+		_spec.ClearField(settings.FieldPublicNebulaPort, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PublicAPIPort(); ok {
+		_spec.SetField(settings.FieldPublicAPIPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPublicAPIPort(); ok {
+		_spec.AddField(settings.FieldPublicAPIPort, field.TypeInt, value)
+	}
+	if _u.mutation.PublicAPIPortCleared() {
+		_spec.ClearField(settings.FieldPublicAPIPort, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RequireClientKeys(); ok {
+		_spec.SetField(settings.FieldRequireClientKeys, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FirewallInbound(); ok {
+		_spec.SetField(settings.FieldFirewallInbound, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFirewallInbound(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, settings.FieldFirewallInbound, value)
+		})
+	}
+	if _u.mutation.FirewallInboundCleared() {
+		_spec.ClearField(settings.FieldFirewallInbound, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.LetsencryptRegistration(); ok {
 		_spec.SetField(settings.FieldLetsencryptRegistration, field.TypeBytes, value)
 	}
@@ -385,6 +634,189 @@ func (_u *SettingsUpdateOne) AddPortOverlayIP(v ipconv.IP) *SettingsUpdateOne {
 	return _u
 }
 
+// SetPortRelay sets the "port_relay" field.
+func (_u *SettingsUpdateOne) SetPortRelay(v bool) *SettingsUpdateOne {
+	_u.mutation.SetPortRelay(v)
+	return _u
+}
+
+// SetNillablePortRelay sets the "port_relay" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillablePortRelay(v *bool) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetPortRelay(*v)
+	}
+	return _u
+}
+
+// SetNebulaPort sets the "nebula_port" field.
+func (_u *SettingsUpdateOne) SetNebulaPort(v int) *SettingsUpdateOne {
+	_u.mutation.ResetNebulaPort()
+	_u.mutation.SetNebulaPort(v)
+	return _u
+}
+
+// SetNillableNebulaPort sets the "nebula_port" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableNebulaPort(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetNebulaPort(*v)
+	}
+	return _u
+}
+
+// AddNebulaPort adds value to the "nebula_port" field.
+func (_u *SettingsUpdateOne) AddNebulaPort(v int) *SettingsUpdateOne {
+	_u.mutation.AddNebulaPort(v)
+	return _u
+}
+
+// SetHTTPPort sets the "http_port" field.
+func (_u *SettingsUpdateOne) SetHTTPPort(v int) *SettingsUpdateOne {
+	_u.mutation.ResetHTTPPort()
+	_u.mutation.SetHTTPPort(v)
+	return _u
+}
+
+// SetNillableHTTPPort sets the "http_port" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableHTTPPort(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetHTTPPort(*v)
+	}
+	return _u
+}
+
+// AddHTTPPort adds value to the "http_port" field.
+func (_u *SettingsUpdateOne) AddHTTPPort(v int) *SettingsUpdateOne {
+	_u.mutation.AddHTTPPort(v)
+	return _u
+}
+
+// SetHTTPSPort sets the "https_port" field.
+func (_u *SettingsUpdateOne) SetHTTPSPort(v int) *SettingsUpdateOne {
+	_u.mutation.ResetHTTPSPort()
+	_u.mutation.SetHTTPSPort(v)
+	return _u
+}
+
+// SetNillableHTTPSPort sets the "https_port" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableHTTPSPort(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetHTTPSPort(*v)
+	}
+	return _u
+}
+
+// AddHTTPSPort adds value to the "https_port" field.
+func (_u *SettingsUpdateOne) AddHTTPSPort(v int) *SettingsUpdateOne {
+	_u.mutation.AddHTTPSPort(v)
+	return _u
+}
+
+// SetPublicHost sets the "public_host" field.
+func (_u *SettingsUpdateOne) SetPublicHost(v string) *SettingsUpdateOne {
+	_u.mutation.SetPublicHost(v)
+	return _u
+}
+
+// SetNillablePublicHost sets the "public_host" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillablePublicHost(v *string) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetPublicHost(*v)
+	}
+	return _u
+}
+
+// ClearPublicHost clears the value of the "public_host" field.
+func (_u *SettingsUpdateOne) ClearPublicHost() *SettingsUpdateOne {
+	_u.mutation.ClearPublicHost()
+	return _u
+}
+
+// SetPublicNebulaPort sets the "public_nebula_port" field.
+func (_u *SettingsUpdateOne) SetPublicNebulaPort(v int) *SettingsUpdateOne {
+	_u.mutation.ResetPublicNebulaPort()
+	_u.mutation.SetPublicNebulaPort(v)
+	return _u
+}
+
+// SetNillablePublicNebulaPort sets the "public_nebula_port" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillablePublicNebulaPort(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetPublicNebulaPort(*v)
+	}
+	return _u
+}
+
+// AddPublicNebulaPort adds value to the "public_nebula_port" field.
+func (_u *SettingsUpdateOne) AddPublicNebulaPort(v int) *SettingsUpdateOne {
+	_u.mutation.AddPublicNebulaPort(v)
+	return _u
+}
+
+// ClearPublicNebulaPort clears the value of the "public_nebula_port" field.
+func (_u *SettingsUpdateOne) ClearPublicNebulaPort() *SettingsUpdateOne {
+	_u.mutation.ClearPublicNebulaPort()
+	return _u
+}
+
+// SetPublicAPIPort sets the "public_api_port" field.
+func (_u *SettingsUpdateOne) SetPublicAPIPort(v int) *SettingsUpdateOne {
+	_u.mutation.ResetPublicAPIPort()
+	_u.mutation.SetPublicAPIPort(v)
+	return _u
+}
+
+// SetNillablePublicAPIPort sets the "public_api_port" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillablePublicAPIPort(v *int) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetPublicAPIPort(*v)
+	}
+	return _u
+}
+
+// AddPublicAPIPort adds value to the "public_api_port" field.
+func (_u *SettingsUpdateOne) AddPublicAPIPort(v int) *SettingsUpdateOne {
+	_u.mutation.AddPublicAPIPort(v)
+	return _u
+}
+
+// ClearPublicAPIPort clears the value of the "public_api_port" field.
+func (_u *SettingsUpdateOne) ClearPublicAPIPort() *SettingsUpdateOne {
+	_u.mutation.ClearPublicAPIPort()
+	return _u
+}
+
+// SetRequireClientKeys sets the "require_client_keys" field.
+func (_u *SettingsUpdateOne) SetRequireClientKeys(v bool) *SettingsUpdateOne {
+	_u.mutation.SetRequireClientKeys(v)
+	return _u
+}
+
+// SetNillableRequireClientKeys sets the "require_client_keys" field if the given value is not nil.
+func (_u *SettingsUpdateOne) SetNillableRequireClientKeys(v *bool) *SettingsUpdateOne {
+	if v != nil {
+		_u.SetRequireClientKeys(*v)
+	}
+	return _u
+}
+
+// SetFirewallInbound sets the "firewall_inbound" field.
+func (_u *SettingsUpdateOne) SetFirewallInbound(v []helpers.FirewallRule) *SettingsUpdateOne {
+	_u.mutation.SetFirewallInbound(v)
+	return _u
+}
+
+// AppendFirewallInbound appends value to the "firewall_inbound" field.
+func (_u *SettingsUpdateOne) AppendFirewallInbound(v []helpers.FirewallRule) *SettingsUpdateOne {
+	_u.mutation.AppendFirewallInbound(v)
+	return _u
+}
+
+// ClearFirewallInbound clears the value of the "firewall_inbound" field.
+func (_u *SettingsUpdateOne) ClearFirewallInbound() *SettingsUpdateOne {
+	_u.mutation.ClearFirewallInbound()
+	return _u
+}
+
 // SetLetsencryptRegistration sets the "letsencrypt_registration" field.
 func (_u *SettingsUpdateOne) SetLetsencryptRegistration(v helpers.EncryptedBytes) *SettingsUpdateOne {
 	_u.mutation.SetLetsencryptRegistration(v)
@@ -441,7 +873,9 @@ func (_u *SettingsUpdateOne) Select(field string, fields ...string) *SettingsUpd
 
 // Save executes the query and returns the updated Settings entity.
 func (_u *SettingsUpdateOne) Save(ctx context.Context) (*Settings, error) {
-	_u.defaults()
+	if err := _u.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
 }
 
@@ -468,11 +902,15 @@ func (_u *SettingsUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (_u *SettingsUpdateOne) defaults() {
+func (_u *SettingsUpdateOne) defaults() error {
 	if _, ok := _u.mutation.UpdatedTime(); !ok {
+		if settings.UpdateDefaultUpdatedTime == nil {
+			return fmt.Errorf("ent: uninitialized settings.UpdateDefaultUpdatedTime (forgotten import ent/runtime?)")
+		}
 		v := settings.UpdateDefaultUpdatedTime()
 		_u.mutation.SetUpdatedTime(v)
 	}
+	return nil
 }
 
 func (_u *SettingsUpdateOne) sqlSave(ctx context.Context) (_node *Settings, err error) {
@@ -534,6 +972,65 @@ func (_u *SettingsUpdateOne) sqlSave(ctx context.Context) (_node *Settings, err
 	if value, ok := _u.mutation.AddedPortOverlayIP(); ok {
 		_spec.AddField(settings.FieldPortOverlayIP, field.TypeUint32, value)
 	}
+	if value, ok := _u.mutation.PortRelay(); ok {
+		_spec.SetField(settings.FieldPortRelay, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.NebulaPort(); ok {
+		_spec.SetField(settings.FieldNebulaPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedNebulaPort(); ok {
+		_spec.AddField(settings.FieldNebulaPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HTTPPort(); ok {
+		_spec.SetField(settings.FieldHTTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHTTPPort(); ok {
+		_spec.AddField(settings.FieldHTTPPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.HTTPSPort(); ok {
+		_spec.SetField(settings.FieldHTTPSPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedHTTPSPort(); ok {
+		_spec.AddField(settings.FieldHTTPSPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.PublicHost(); ok {
+		_spec.SetField(settings.FieldPublicHost, field.TypeString, value)
+	}
+	if _u.mutation.PublicHostCleared() {
+		_spec.ClearField(settings.FieldPublicHost, field.TypeString)
+	}
+	if value, ok := _u.mutation.PublicNebulaPort(); ok {
+		_spec.SetField(settings.FieldPublicNebulaPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPublicNebulaPort(); ok {
+		_spec.AddField(settings.FieldPublicNebulaPort, field.TypeInt, value)
+	}
+	if _u.mutation.PublicNebulaPortCleared() {
+		_spec.ClearField(settings.FieldPublicNebulaPort, field.TypeInt)
+	}
+	if value, ok := _u.mutation.PublicAPIPort(); ok {
+		_spec.SetField(settings.FieldPublicAPIPort, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPublicAPIPort(); ok {
+		_spec.AddField(settings.FieldPublicAPIPort, field.TypeInt, value)
+	}
+	if _u.mutation.PublicAPIPortCleared() {
+		_spec.ClearField(settings.FieldPublicAPIPort, field.TypeInt)
+	}
+	if value, ok := _u.mutation.RequireClientKeys(); ok {
+		_spec.SetField(settings.FieldRequireClientKeys, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.FirewallInbound(); ok {
+		_spec.SetField(settings.FieldFirewallInbound, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedFirewallInbound(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, settings.FieldFirewallInbound, value)
+		})
+	}
+	if _u.mutation.FirewallInboundCleared() {
+		_spec.ClearField(settings.FieldFirewallInbound, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.LetsencryptRegistration(); ok {
 		_spec.SetField(settings.FieldLetsencryptRegistration, field.TypeBytes, value)
 	}
@@ -50,6 +50,7 @@ func main() {
 			"privacy",
 			"entql",
 			"schema/snapshot",
+			"sql/execquery",
 		),
 	}
 
@@ -61,19 +61,10 @@ func (e EncryptedBytes) Value() (driver.Value, error) {
 }
 
 func encrypt(plaintext []byte) (string, error) {
-	aead, err := chacha20poly1305.NewX(EncryptionKey[:])
+	ciphertext, err := Seal(plaintext, nil)
 	if err != nil {
 		return "", err
 	}
-
-	// Generate a random nonce (24 bytes for XChaCha20)
-	nonce := make([]byte, aead.NonceSize())
-	if _, err := rand.Read(nonce); err != nil {
-		return "", err
-	}
-
-	// Encrypt and authenticate
-	ciphertext := aead.Seal(nonce, nonce, plaintext, nil)
 	return base64.StdEncoding.EncodeToString(ciphertext), nil
 }
 
@@ -82,19 +73,42 @@ func decrypt(ciphertext string) ([]byte, error) {
 	if err != nil {
 		return []byte{}, err
 	}
+	return Open(data, nil)
+}
+
+// Encrypts and authenticates plaintext and additionalData with
+// EncryptionKey. The random nonce is prepended to the ciphertext.
+func Seal(plaintext []byte, additionalData []byte) ([]byte, error) {
+	aead, err := chacha20poly1305.NewX(EncryptionKey[:])
+	if err != nil {
+		return nil, err
+	}
+
+	// Generate a random nonce (24 bytes for XChaCha20)
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	// Encrypt and authenticate
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
 
+// Decrypts ciphertext from Seal. Fails when EncryptionKey or
+// additionalData differ.
+func Open(ciphertext []byte, additionalData []byte) ([]byte, error) {
 	aead, err := chacha20poly1305.NewX(EncryptionKey[:])
 	if err != nil {
 		return []byte{}, err
 	}
 
 	nonceSize := aead.NonceSize()
-	if len(data) < nonceSize {
+	if len(ciphertext) < nonceSize {
 		return []byte{}, fmt.Errorf("ciphertext too short")
 	}
 
-	nonce, encrypted := data[:nonceSize], data[nonceSize:]
-	plaintext, err := aead.Open(nil, nonce, encrypted, nil)
+	nonce, encrypted := ciphertext[:nonceSize], ciphertext[nonceSize:]
+	plaintext, err := aead.Open(nil, nonce, encrypted, additionalData)
 	if err != nil {
 		return []byte{}, err
 	}
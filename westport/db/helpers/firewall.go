@@ -0,0 +1,41 @@
+package helpers
+
+import (
+	"fmt"
+	"net/netip"
+	"slices"
+)
+
+// Inbound firewall rule pushed to devices. Traffic matching any rule is
+// allowed, everything else is dropped.
+type FirewallRule struct {
+	// 0 is any
+	Port int `json:"port,omitempty" yaml:"port,omitempty"`
+	// any, tcp, udp or icmp. Defaults to any.
+	Proto string `json:"proto,omitempty" yaml:"proto,omitempty"`
+	// Device name or any
+	Host string `json:"host,omitempty" yaml:"host,omitempty"`
+	// Peer cert must have all groups
+	Groups []string `json:"groups,omitempty" yaml:"groups,omitempty"`
+	// Peer overlay IP within cidr
+	Cidr string `json:"cidr,omitempty" yaml:"cidr,omitempty"`
+}
+
+func (r *FirewallRule) Validate() error {
+	if r.Port < 0 || r.Port > 65535 {
+		return fmt.Errorf("invalid firewall port `%d`", r.Port)
+	}
+	if r.Proto != "" && !slices.Contains([]string{"any", "tcp", "udp", "icmp"}, r.Proto) {
+		return fmt.Errorf("invalid firewall proto `%s`. Use any, tcp, udp or icmp", r.Proto)
+	}
+	if r.Cidr != "" {
+		_, err := netip.ParsePrefix(r.Cidr)
+		if err != nil {
+			return fmt.Errorf("invalid firewall cidr `%s`", r.Cidr)
+		}
+	}
+	if r.Host == "" && len(r.Groups) == 0 && r.Cidr == "" {
+		return fmt.Errorf("firewall rule needs a host, groups or cidr. Use host: any to allow all devices")
+	}
+	return nil
+}
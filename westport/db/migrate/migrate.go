@@ -2,14 +2,94 @@ package migrate
 
 import (
 	"context"
+	"database/sql"
+	"embed"
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
+	"entgo.io/ent/dialect"
 	"github.com/sprisa/west/westport/db"
 	"github.com/sprisa/west/westport/db/ent"
-	"github.com/sprisa/west/westport/db/ent/migrate"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
 	"github.com/sprisa/x/sig"
 )
 
+// Migration files per dialect, generated by gen.go
+//
+//go:embed migrations
+var migrations embed.FS
+
+// Version of the newest migration. Bump with each new migration so backups
+// and databases from newer binaries are refused.
+const SchemaVersion int64 = 20261019040000
+
+// Records applied migrations
+const revisionsTable = "west_schema_migrations"
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type Revision struct {
+	Version   int64
+	Name      string
+	AppliedAt time.Time
+}
+
+type Status struct {
+	// Migrations in this binary, oldest first
+	Migrations []*Migration
+	// Migrations applied to the database by version
+	Applied map[int64]*Revision
+}
+
+// Newest applied version. 0 for an empty database.
+func (s *Status) Current() int64 {
+	var current int64
+	for v := range s.Applied {
+		current = max(current, v)
+	}
+	return current
+}
+
+func (s *Status) Pending() []*Migration {
+	var pending []*Migration
+	for _, m := range s.Migrations {
+		if s.Applied[m.Version] == nil {
+			pending = append(pending, m)
+		}
+	}
+	return pending
+}
+
+// Applied migrations missing from this binary, from a newer west.
+func (s *Status) Unknown() []*Revision {
+	known := map[int64]bool{}
+	for _, m := range s.Migrations {
+		known[m.Version] = true
+	}
+	var unknown []*Revision
+	for _, r := range s.Applied {
+		if !known[r.Version] {
+			unknown = append(unknown, r)
+		}
+	}
+	sort.Slice(unknown, func(i, j int) bool { return unknown[i].Version < unknown[j].Version })
+	return unknown
+}
+
 func Migrate() error {
 	ctx := sig.ShutdownContext(context.Background())
 	client, err := db.OpenDB()
@@ -17,7 +97,6 @@ func Migrate() error {
 		return err
 	}
 
-	// Run the auto migration tool.
 	err = MigrateClient(ctx, client)
 	if err != nil {
 		return fmt.Errorf("failed migrating db: %v", err)
@@ -26,12 +105,403 @@ func Migrate() error {
 	return nil
 }
 
+// Applies pending migrations. Existing sqlite databases are copied to
+// <db>.<version>.pre-migrate first. Fails when the database was migrated by
+// a newer west.
 func MigrateClient(ctx context.Context, client *ent.Client) error {
-	return client.Schema.Create(
-		ctx,
-		migrate.WithGlobalUniqueID(true),
-		migrate.WithForeignKeys(false),
-		migrate.WithDropIndex(true),
-		migrate.WithDropColumn(true),
+	_, err := Up(ctx, client, nil)
+	return err
+}
+
+type UpOpts struct {
+	// Stop after this version. 0 applies all.
+	To int64
+	// Skip the pre-migration copy
+	SkipBackup bool
+}
+
+// Applies pending migrations in order, returning those applied.
+func Up(ctx context.Context, client *ent.Client, opts *UpOpts) ([]*Migration, error) {
+	if opts == nil {
+		opts = &UpOpts{}
+	}
+	var applied []*Migration
+	err := withLock(ctx, func(r *runner) error {
+		status, err := r.status(ctx, client)
+		if err != nil {
+			return err
+		}
+		if unknown := status.Unknown(); len(unknown) > 0 {
+			return newerErr(unknown)
+		}
+		var pending []*Migration
+		for _, m := range status.Pending() {
+			if opts.To == 0 || m.Version <= opts.To {
+				pending = append(pending, m)
+			}
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+		if !opts.SkipBackup {
+			err = r.backup(ctx, client, status.Current())
+			if err != nil {
+				return err
+			}
+		}
+		for _, m := range pending {
+			l.Log.Info().Int64("version", m.Version).Str("name", m.Name).Msg("Applying migration")
+			err = r.apply(ctx, client, m.Up, func(tx *ent.Tx) error {
+				_, err := tx.ExecContext(ctx, fmt.Sprintf(
+					"INSERT INTO %s (version, name, applied_at) VALUES (%s, %s, %s)",
+					revisionsTable, r.arg(1), r.arg(2), r.arg(3),
+				), m.Version, m.Name, time.Now().Unix())
+				return err
+			})
+			if err != nil {
+				return errutil.WrapErr(err, "error applying migration %d_%s", m.Version, m.Name)
+			}
+			applied = append(applied, m)
+		}
+		return nil
+	})
+	return applied, err
+}
+
+// Reverts the newest steps migrations, returning those reverted.
+func Down(ctx context.Context, client *ent.Client, steps int) ([]*Migration, error) {
+	var reverted []*Migration
+	err := withLock(ctx, func(r *runner) error {
+		status, err := r.status(ctx, client)
+		if err != nil {
+			return err
+		}
+		if unknown := status.Unknown(); len(unknown) > 0 {
+			return newerErr(unknown)
+		}
+		var targets []*Migration
+		for i := len(status.Migrations) - 1; i >= 0 && len(targets) < steps; i-- {
+			if m := status.Migrations[i]; status.Applied[m.Version] != nil {
+				targets = append(targets, m)
+			}
+		}
+		if len(targets) == 0 {
+			return nil
+		}
+		err = r.backup(ctx, client, status.Current())
+		if err != nil {
+			return err
+		}
+		for _, m := range targets {
+			l.Log.Info().Int64("version", m.Version).Str("name", m.Name).Msg("Reverting migration")
+			err = r.apply(ctx, client, m.Down, func(tx *ent.Tx) error {
+				_, err := tx.ExecContext(ctx, fmt.Sprintf(
+					"DELETE FROM %s WHERE version = %s", revisionsTable, r.arg(1),
+				), m.Version)
+				return err
+			})
+			if err != nil {
+				return errutil.WrapErr(err, "error reverting migration %d_%s", m.Version, m.Name)
+			}
+			reverted = append(reverted, m)
+		}
+		return nil
+	})
+	return reverted, err
+}
+
+func GetStatus(ctx context.Context, client *ent.Client) (*Status, error) {
+	r, err := newRunner()
+	if err != nil {
+		return nil, err
+	}
+	return r.status(ctx, client)
+}
+
+func newerErr(unknown []*Revision) error {
+	return fmt.Errorf(
+		"database schema version %d is newer than this binary (%d). Upgrade west, or run `west port migrate down` with the newer binary.",
+		unknown[len(unknown)-1].Version, SchemaVersion,
 	)
 }
+
+// Loads the embedded migrations for d, oldest first.
+func Migrations(d string) ([]*Migration, error) {
+	dir := "migrations/sqlite"
+	if d == dialect.Postgres {
+		dir = "migrations/postgres"
+	}
+	entries, err := fs.ReadDir(migrations, dir)
+	if err != nil {
+		return nil, err
+	}
+	byVersion := map[int64]*Migration{}
+	for _, e := range entries {
+		match := migrationFile.FindStringSubmatch(e.Name())
+		if match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		b, err := fs.ReadFile(migrations, path.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		m := byVersion[version]
+		if m == nil {
+			m = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = m
+		}
+		if match[3] == "up" {
+			m.Up = string(b)
+		} else {
+			m.Down = string(b)
+		}
+	}
+	var list []*Migration
+	for _, m := range byVersion {
+		list = append(list, m)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	return list, nil
+}
+
+// <version>_<name>.<up|down>.sql
+var migrationFile = regexp.MustCompile(`^(\d+)_(\w+)\.(up|down)\.sql$`)
+
+type runner struct {
+	dialect    string
+	migrations []*Migration
+}
+
+func newRunner() (*runner, error) {
+	d, err := db.Dialect()
+	if err != nil {
+		return nil, err
+	}
+	m, err := Migrations(d)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error loading migrations")
+	}
+	return &runner{dialect: d, migrations: m}, nil
+}
+
+// Query placeholder for the nth argument
+func (r *runner) arg(n int) string {
+	if r.dialect == dialect.Postgres {
+		return fmt.Sprintf("$%d", n)
+	}
+	return "?"
+}
+
+// Reads applied migrations, creating the revisions table as needed.
+// Databases created before versioned migrations are recorded at the
+// migration matching the schema they were auto-migrated to.
+func (r *runner) status(ctx context.Context, client *ent.Client) (*Status, error) {
+	_, err := client.ExecContext(ctx, fmt.Sprintf(
+		"CREATE TABLE IF NOT EXISTS %s (version bigint NOT NULL PRIMARY KEY, name text NOT NULL, applied_at bigint NOT NULL)",
+		revisionsTable,
+	))
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error creating migrations table")
+	}
+	applied, err := r.revisions(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(applied) == 0 && len(r.migrations) > 0 {
+		exists, err := r.tableExists(ctx, client, "settings")
+		if err != nil {
+			return nil, err
+		}
+		if exists {
+			baseline, err := r.autoMigrated(ctx, client)
+			if err != nil {
+				return nil, err
+			}
+			for _, m := range baseline {
+				l.Log.Info().Int64("version", m.Version).Str("name", m.Name).Msg("Recording existing database migration")
+				_, err = client.ExecContext(ctx, fmt.Sprintf(
+					"INSERT INTO %s (version, name, applied_at) VALUES (%s, %s, %s)",
+					revisionsTable, r.arg(1), r.arg(2), r.arg(3),
+				), m.Version, m.Name, time.Now().Unix())
+				if err != nil {
+					return nil, errutil.WrapErr(err, "error recording existing database")
+				}
+			}
+			applied, err = r.revisions(ctx, client)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	return &Status{Migrations: r.migrations, Applied: applied}, nil
+}
+
+// Columns of the second migration. Releases before versioned migrations
+// auto-migrated to either the first migration or to one with these columns.
+var autoMigratedColumns = map[string][]string{
+	"devices":  {"advertise_routes", "exit_node", "relay", "lighthouse", "public_endpoint"},
+	"settings": {"port_relay", "nebula_port", "http_port", "https_port", "public_host", "public_nebula_port", "public_api_port", "require_client_keys"},
+}
+
+// Migrations an unversioned database already has, found from its columns.
+func (r *runner) autoMigrated(ctx context.Context, client *ent.Client) ([]*Migration, error) {
+	var found, missing []string
+	for _, table := range []string{"devices", "settings"} {
+		for _, column := range autoMigratedColumns[table] {
+			exists, err := r.columnExists(ctx, client, table, column)
+			if err != nil {
+				return nil, err
+			}
+			if exists {
+				found = append(found, table+"."+column)
+			} else {
+				missing = append(missing, table+"."+column)
+			}
+		}
+	}
+	switch {
+	case len(found) == 0:
+		return r.migrations[:1], nil
+	case len(missing) == 0 && len(r.migrations) > 1:
+		return r.migrations[:2], nil
+	default:
+		return nil, fmt.Errorf("database schema matches no migration, it's missing %s. Add the columns or restore a backup.", strings.Join(missing, ", "))
+	}
+}
+
+func (r *runner) revisions(ctx context.Context, client *ent.Client) (map[int64]*Revision, error) {
+	rows, err := client.QueryContext(ctx, fmt.Sprintf("SELECT version, name, applied_at FROM %s", revisionsTable))
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error reading migrations")
+	}
+	defer rows.Close()
+	applied := map[int64]*Revision{}
+	for rows.Next() {
+		var rev Revision
+		var appliedAt int64
+		err = rows.Scan(&rev.Version, &rev.Name, &appliedAt)
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error reading migrations")
+		}
+		rev.AppliedAt = time.Unix(appliedAt, 0)
+		applied[rev.Version] = &rev
+	}
+	return applied, rows.Err()
+}
+
+func (r *runner) tableExists(ctx context.Context, client *ent.Client, table string) (bool, error) {
+	query := fmt.Sprintf("SELECT count(*) FROM sqlite_master WHERE type = 'table' AND name = %s", r.arg(1))
+	if r.dialect == dialect.Postgres {
+		query = fmt.Sprintf("SELECT count(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = %s", r.arg(1))
+	}
+	return r.exists(ctx, client, query, table)
+}
+
+func (r *runner) columnExists(ctx context.Context, client *ent.Client, table string, column string) (bool, error) {
+	query := fmt.Sprintf("SELECT count(*) FROM pragma_table_info(%s) WHERE name = %s", r.arg(1), r.arg(2))
+	if r.dialect == dialect.Postgres {
+		query = fmt.Sprintf("SELECT count(*) FROM information_schema.columns WHERE table_schema = current_schema() AND table_name = %s AND column_name = %s", r.arg(1), r.arg(2))
+	}
+	return r.exists(ctx, client, query, table, column)
+}
+
+// Runs a count query, true when it counts any rows.
+func (r *runner) exists(ctx context.Context, client *ent.Client, query string, args ...any) (bool, error) {
+	rows, err := client.QueryContext(ctx, query, args...)
+	if err != nil {
+		return false, err
+	}
+	defer rows.Close()
+	var n int
+	if rows.Next() {
+		err = rows.Scan(&n)
+	}
+	if err == nil {
+		err = rows.Err()
+	}
+	return n > 0, err
+}
+
+// Runs a migration's statements and records it in one transaction.
+func (r *runner) apply(ctx context.Context, client *ent.Client, stmts string, record func(tx *ent.Tx) error) error {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, stmts)
+	if err == nil {
+		err = record(tx)
+	}
+	if err != nil {
+		return errors.Join(err, tx.Rollback())
+	}
+	return tx.Commit()
+}
+
+// Copies a sqlite database before migrating it. Postgres is left to its own
+// backups.
+func (r *runner) backup(ctx context.Context, client *ent.Client, version int64) error {
+	if r.dialect != dialect.SQLite || version == 0 {
+		return nil
+	}
+	file, err := db.FilePath(ctx, client)
+	if err != nil {
+		return errutil.WrapErr(err, "error finding db file")
+	}
+	if file == "" {
+		// In memory
+		return nil
+	}
+	dest := fmt.Sprintf("%s.%d.pre-migrate", file, version)
+	if _, err := os.Stat(dest); err == nil {
+		l.Log.Info().Str("file", dest).Msg("Pre-migration backup exists")
+		return nil
+	}
+	err = db.VacuumInto(ctx, client, dest)
+	if err != nil {
+		return errutil.WrapErr(err, "error backing up db before migrating")
+	}
+	l.Log.Info().Str("file", dest).Msg("Backed up db before migrating")
+	return nil
+}
+
+// Arbitrary key for the postgres advisory lock held while migrating
+const lockKey = 0x77657374
+
+// Runs fn holding a postgres session advisory lock, so replicas starting
+// together migrate one at a time. Sqlite serializes writers itself.
+func withLock(ctx context.Context, fn func(r *runner) error) error {
+	r, err := newRunner()
+	if err != nil {
+		return err
+	}
+	if r.dialect != dialect.Postgres {
+		return fn(r)
+	}
+
+	pool, err := sql.Open("pgx", db.DatabaseURL)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	conn, err := pool.Conn(ctx)
+	if err != nil {
+		return errutil.WrapErr(err, "error locking db for migration")
+	}
+	defer conn.Close()
+	_, err = conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey)
+	if err != nil {
+		return errutil.WrapErr(err, "error locking db for migration")
+	}
+	defer func() {
+		_, err := conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+		if err != nil {
+			l.Log.Err(err).Msg("error unlocking db")
+		}
+	}()
+	return fn(r)
+}
@@ -0,0 +1,182 @@
+package migrate
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"entgo.io/ent/dialect"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	entmigrate "github.com/sprisa/west/westport/db/ent/migrate"
+)
+
+var memoryDBs atomic.Int64
+
+func openMemory(t *testing.T) *ent.Client {
+	client, err := db.Open(fmt.Sprintf("file:migrate-%d?mode=memory&cache=shared&_fk=1", memoryDBs.Add(1)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { client.Close() })
+	return client
+}
+
+// Fails when the ent schema changed without a new migration.
+func TestMigrationsMatchSchema(t *testing.T) {
+	ctx := context.Background()
+	client := openMemory(t)
+	_, err := Up(ctx, client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var buf bytes.Buffer
+	err = client.Schema.WriteTo(ctx, &buf,
+		entmigrate.WithGlobalUniqueID(true),
+		entmigrate.WithForeignKeys(false),
+		entmigrate.WithDropIndex(true),
+		entmigrate.WithDropColumn(true),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if diff := strings.TrimSpace(strings.NewReplacer("BEGIN;", "", "COMMIT;", "").Replace(buf.String())); diff != "" {
+		t.Fatalf("schema differs from migrations. Run `go run ./gen.go -name <name>`:\n%s", diff)
+	}
+}
+
+func TestUpDown(t *testing.T) {
+	ctx := context.Background()
+	client := openMemory(t)
+	applied, err := Up(ctx, client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(applied) == 0 {
+		t.Fatal("no migrations applied")
+	}
+	_, err = Down(ctx, client, len(applied))
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, err := GetStatus(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Current() != 0 {
+		t.Fatalf("current = %d after reverting all", status.Current())
+	}
+	_, err = Up(ctx, client, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Schema the last release before any schema changes auto-migrated sqlite
+// databases to
+const baselineSchema = `
+CREATE TABLE devices (id integer NOT NULL PRIMARY KEY AUTOINCREMENT, created_time datetime NOT NULL, updated_time datetime NOT NULL, name text NOT NULL, ip integer NOT NULL, leased_access_token text NULL, token blob NOT NULL);
+CREATE UNIQUE INDEX device_ip ON devices (ip);
+CREATE UNIQUE INDEX device_name ON devices (name);
+CREATE UNIQUE INDEX device_token ON devices (token);
+CREATE TABLE settings (id integer NOT NULL PRIMARY KEY AUTOINCREMENT, created_time datetime NOT NULL, updated_time datetime NOT NULL, domain_zone text NULL, cipher text NOT NULL DEFAULT ('aes'), ca_crt blob NOT NULL, ca_key blob NOT NULL, lighthouse_crt blob NOT NULL, lighthouse_key blob NOT NULL, cidr text NOT NULL, port_overlay_ip integer NOT NULL, letsencrypt_registration blob NULL, tls_cert blob NULL, tls_cert_key blob NULL);
+INSERT INTO sqlite_sequence (name, seq) VALUES ("settings", 4294967296);
+CREATE TABLE ent_types (id integer NOT NULL PRIMARY KEY AUTOINCREMENT, type text NOT NULL);
+CREATE UNIQUE INDEX ent_types_type_key ON ent_types (type);
+INSERT INTO ent_types (type) VALUES ('devices'), ('settings');
+`
+
+// Databases auto-migrated by older releases start at the migration matching
+// their columns.
+func TestExistingDatabase(t *testing.T) {
+	migrations, err := Migrations(dialect.SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name   string
+		schema string
+		err    string
+	}{
+		{name: "baseline", schema: baselineSchema},
+		{name: "auto migrated columns", schema: baselineSchema + migrations[1].Up},
+		{
+			name:   "partial columns",
+			schema: baselineSchema + "ALTER TABLE devices ADD COLUMN advertise_routes json NULL;",
+			err:    "matches no migration",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			client := openMemory(t)
+			_, err := client.ExecContext(ctx, tt.schema)
+			if err != nil {
+				t.Fatal(err)
+			}
+			_, err = Up(ctx, client, nil)
+			if tt.err != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.err) {
+					t.Fatalf("expected error containing `%s`, got %v", tt.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			status, err := GetStatus(ctx, client)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(status.Pending()) != 0 {
+				t.Fatalf("pending migrations: %d", len(status.Pending()))
+			}
+		})
+	}
+}
+
+func TestNewerDatabase(t *testing.T) {
+	ctx := context.Background()
+	client := openMemory(t)
+	err := MigrateClient(ctx, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ExecContext(ctx, "INSERT INTO "+revisionsTable+" (version, name, applied_at) VALUES (?, 'future', 0)", SchemaVersion+1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = MigrateClient(ctx, client)
+	if err == nil || !strings.Contains(err.Error(), "newer than this binary") {
+		t.Fatalf("expected newer database error, got %v", err)
+	}
+}
+
+func TestDialectsMatch(t *testing.T) {
+	sqlite, err := Migrations(dialect.SQLite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	postgres, err := Migrations(dialect.Postgres)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sqlite) != len(postgres) {
+		t.Fatalf("sqlite has %d migrations, postgres %d", len(sqlite), len(postgres))
+	}
+	for i := range sqlite {
+		if sqlite[i].Version != postgres[i].Version {
+			t.Fatalf("migration %d: sqlite version %d, postgres %d", i, sqlite[i].Version, postgres[i].Version)
+		}
+		if sqlite[i].Up == "" || sqlite[i].Down == "" || postgres[i].Up == "" || postgres[i].Down == "" {
+			t.Fatalf("migration %d missing up or down", sqlite[i].Version)
+		}
+	}
+	if last := sqlite[len(sqlite)-1].Version; last != SchemaVersion {
+		t.Fatalf("SchemaVersion = %d, newest migration is %d", SchemaVersion, last)
+	}
+}
@@ -0,0 +1,16 @@
+-- create "devices" table
+CREATE TABLE "devices" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "created_time" timestamptz NOT NULL, "updated_time" timestamptz NOT NULL, "name" character varying NOT NULL, "ip" bigint NOT NULL, "leased_access_token" character varying NULL, "token" bytea NOT NULL, PRIMARY KEY ("id"));
+-- create index "device_ip" to table: "devices"
+CREATE UNIQUE INDEX "device_ip" ON "devices" ("ip");
+-- create index "device_name" to table: "devices"
+CREATE UNIQUE INDEX "device_name" ON "devices" ("name");
+-- create index "device_token" to table: "devices"
+CREATE UNIQUE INDEX "device_token" ON "devices" ("token");
+-- create "settings" table
+CREATE TABLE "settings" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 4294967296), "created_time" timestamptz NOT NULL, "updated_time" timestamptz NOT NULL, "domain_zone" character varying NULL, "cipher" character varying NOT NULL DEFAULT 'aes', "ca_crt" bytea NOT NULL, "ca_key" bytea NOT NULL, "lighthouse_crt" bytea NOT NULL, "lighthouse_key" bytea NOT NULL, "cidr" character varying NOT NULL, "port_overlay_ip" bigint NOT NULL, "letsencrypt_registration" bytea NULL, "tls_cert" bytea NULL, "tls_cert_key" bytea NULL, PRIMARY KEY ("id"));
+-- create "ent_types" table
+CREATE TABLE "ent_types" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY, "type" character varying NOT NULL, PRIMARY KEY ("id"));
+-- create index "ent_types_type_key" to table: "ent_types"
+CREATE UNIQUE INDEX "ent_types_type_key" ON "ent_types" ("type");
+-- add pk ranges for ('devices'),('settings') tables
+INSERT INTO "ent_types" ("type") VALUES ('devices'), ('settings');
@@ -0,0 +1,4 @@
+-- reverse: modify "settings" table
+ALTER TABLE "settings" DROP COLUMN "require_client_keys", DROP COLUMN "public_api_port", DROP COLUMN "public_nebula_port", DROP COLUMN "public_host", DROP COLUMN "https_port", DROP COLUMN "http_port", DROP COLUMN "nebula_port", DROP COLUMN "port_relay";
+-- reverse: modify "devices" table
+ALTER TABLE "devices" DROP COLUMN "public_endpoint", DROP COLUMN "lighthouse", DROP COLUMN "relay", DROP COLUMN "exit_node", DROP COLUMN "advertise_routes";
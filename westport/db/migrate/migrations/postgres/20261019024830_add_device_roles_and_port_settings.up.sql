@@ -0,0 +1,4 @@
+-- modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "advertise_routes" jsonb NULL, ADD COLUMN "exit_node" boolean NOT NULL DEFAULT false, ADD COLUMN "relay" boolean NOT NULL DEFAULT false, ADD COLUMN "lighthouse" boolean NOT NULL DEFAULT false, ADD COLUMN "public_endpoint" character varying NULL;
+-- modify "settings" table
+ALTER TABLE "settings" ADD COLUMN "port_relay" boolean NOT NULL DEFAULT true, ADD COLUMN "nebula_port" bigint NOT NULL DEFAULT 4242, ADD COLUMN "http_port" bigint NOT NULL DEFAULT 80, ADD COLUMN "https_port" bigint NOT NULL DEFAULT 443, ADD COLUMN "public_host" character varying NULL, ADD COLUMN "public_nebula_port" bigint NULL, ADD COLUMN "public_api_port" bigint NULL, ADD COLUMN "require_client_keys" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,8 @@
+-- create "audit_events" table
+CREATE TABLE "audit_events" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 8589934592), "created_time" timestamptz NOT NULL, "action" character varying NOT NULL, "actor" character varying NOT NULL, "remote_addr" character varying NULL, "device_id" bigint NULL, "device_name" character varying NULL, "success" boolean NOT NULL DEFAULT true, "error" character varying NULL, "details" jsonb NULL, PRIMARY KEY ("id"));
+-- create index "auditevent_created_time" to table: "audit_events"
+CREATE INDEX "auditevent_created_time" ON "audit_events" ("created_time");
+-- create index "auditevent_device_name" to table: "audit_events"
+CREATE INDEX "auditevent_device_name" ON "audit_events" ("device_name");
+-- add pk ranges for ('audit_events') tables
+INSERT INTO "ent_types" ("type") VALUES ('audit_events');
@@ -0,0 +1,8 @@
+-- reverse: add pk ranges for ('device_provisions') tables
+DELETE FROM "ent_types" WHERE "type" = 'device_provisions';
+-- reverse: create index "deviceprovision_device_id_created_time" to table: "device_provisions"
+DROP INDEX "deviceprovision_device_id_created_time";
+-- reverse: create "device_provisions" table
+DROP TABLE "device_provisions";
+-- reverse: modify "devices" table
+ALTER TABLE "devices" DROP COLUMN "arch", DROP COLUMN "os", DROP COLUMN "client_version", DROP COLUMN "underlay_addr", DROP COLUMN "last_seen_time", DROP COLUMN "last_provision_time";
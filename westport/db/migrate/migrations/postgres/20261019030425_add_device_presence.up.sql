@@ -0,0 +1,8 @@
+-- modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "last_provision_time" timestamptz NULL, ADD COLUMN "last_seen_time" timestamptz NULL, ADD COLUMN "underlay_addr" character varying NULL, ADD COLUMN "client_version" character varying NULL, ADD COLUMN "os" character varying NULL, ADD COLUMN "arch" character varying NULL;
+-- create "device_provisions" table
+CREATE TABLE "device_provisions" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 12884901888), "created_time" timestamptz NOT NULL, "device_id" bigint NOT NULL, "remote_addr" character varying NULL, "client_version" character varying NULL, "os" character varying NULL, "arch" character varying NULL, "client_key" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create index "deviceprovision_device_id_created_time" to table: "device_provisions"
+CREATE INDEX "deviceprovision_device_id_created_time" ON "device_provisions" ("device_id", "created_time");
+-- add pk ranges for ('device_provisions') tables
+INSERT INTO "ent_types" ("type") VALUES ('device_provisions');
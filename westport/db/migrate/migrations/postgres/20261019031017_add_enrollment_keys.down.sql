@@ -0,0 +1,10 @@
+-- reverse: add pk ranges for ('enrollment_keys') tables
+DELETE FROM "ent_types" WHERE "type" = 'enrollment_keys';
+-- reverse: create index "enrollmentkey_jti" to table: "enrollment_keys"
+DROP INDEX "enrollmentkey_jti";
+-- reverse: create index "enrollmentkey_name" to table: "enrollment_keys"
+DROP INDEX "enrollmentkey_name";
+-- reverse: create "enrollment_keys" table
+DROP TABLE "enrollment_keys";
+-- reverse: modify "devices" table
+ALTER TABLE "devices" DROP COLUMN "groups", DROP COLUMN "single_use_token";
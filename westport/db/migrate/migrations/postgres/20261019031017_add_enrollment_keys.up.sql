@@ -0,0 +1,10 @@
+-- modify "devices" table
+ALTER TABLE "devices" ADD COLUMN "single_use_token" boolean NOT NULL DEFAULT false, ADD COLUMN "groups" jsonb NULL;
+-- create "enrollment_keys" table
+CREATE TABLE "enrollment_keys" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 17179869184), "created_time" timestamptz NOT NULL, "updated_time" timestamptz NOT NULL, "name" character varying NOT NULL, "jti" character varying NOT NULL, "groups" jsonb NULL, "max_uses" bigint NOT NULL DEFAULT 0, "uses" bigint NOT NULL DEFAULT 0, "expires_time" timestamptz NOT NULL, "revoked" boolean NOT NULL DEFAULT false, PRIMARY KEY ("id"));
+-- create index "enrollmentkey_name" to table: "enrollment_keys"
+CREATE UNIQUE INDEX "enrollmentkey_name" ON "enrollment_keys" ("name");
+-- create index "enrollmentkey_jti" to table: "enrollment_keys"
+CREATE UNIQUE INDEX "enrollmentkey_jti" ON "enrollment_keys" ("jti");
+-- add pk ranges for ('enrollment_keys') tables
+INSERT INTO "ent_types" ("type") VALUES ('enrollment_keys');
@@ -0,0 +1,6 @@
+-- reverse: add pk ranges for ('revoked_tokens') tables
+DELETE FROM "ent_types" WHERE "type" = 'revoked_tokens';
+-- reverse: create index "revokedtoken_jti" to table: "revoked_tokens"
+DROP INDEX "revokedtoken_jti";
+-- reverse: create "revoked_tokens" table
+DROP TABLE "revoked_tokens";
@@ -0,0 +1,6 @@
+-- create "revoked_tokens" table
+CREATE TABLE "revoked_tokens" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 21474836480), "created_time" timestamptz NOT NULL, "jti" character varying NOT NULL, "device_id" bigint NULL, "device_name" character varying NULL, "expires_time" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "revokedtoken_jti" to table: "revoked_tokens"
+CREATE UNIQUE INDEX "revokedtoken_jti" ON "revoked_tokens" ("jti");
+-- add pk ranges for ('revoked_tokens') tables
+INSERT INTO "ent_types" ("type") VALUES ('revoked_tokens');
@@ -0,0 +1,8 @@
+-- reverse: add pk ranges for ('dns_records') tables
+DELETE FROM "ent_types" WHERE "type" = 'dns_records';
+-- reverse: create index "dnsrecord_name" to table: "dns_records"
+DROP INDEX "dnsrecord_name";
+-- reverse: create "dns_records" table
+DROP TABLE "dns_records";
+-- reverse: modify "settings" table
+ALTER TABLE "settings" DROP COLUMN "firewall_inbound";
@@ -0,0 +1,8 @@
+-- modify "settings" table
+ALTER TABLE "settings" ADD COLUMN "firewall_inbound" jsonb NULL;
+-- create "dns_records" table
+CREATE TABLE "dns_records" ("id" bigint NOT NULL GENERATED BY DEFAULT AS IDENTITY (START WITH 25769803776), "created_time" timestamptz NOT NULL, "updated_time" timestamptz NOT NULL, "name" character varying NOT NULL, "type" character varying NOT NULL, "value" character varying NOT NULL, PRIMARY KEY ("id"));
+-- create index "dnsrecord_name" to table: "dns_records"
+CREATE UNIQUE INDEX "dnsrecord_name" ON "dns_records" ("name");
+-- add pk ranges for ('dns_records') tables
+INSERT INTO "ent_types" ("type") VALUES ('dns_records');
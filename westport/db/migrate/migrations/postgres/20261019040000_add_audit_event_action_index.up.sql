@@ -0,0 +1,2 @@
+-- create index "auditevent_action" to table: "audit_events"
+CREATE INDEX "auditevent_action" ON "audit_events" ("action");
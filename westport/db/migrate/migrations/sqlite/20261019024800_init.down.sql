@@ -0,0 +1,16 @@
+-- reverse: create index "ent_types_type_key" to table: "ent_types"
+DROP INDEX `ent_types_type_key`;
+-- reverse: create "ent_types" table
+DROP TABLE `ent_types`;
+-- reverse: set sequence for "settings" table
+UPDATE sqlite_sequence SET seq = 0 WHERE name = "settings";
+-- reverse: create "settings" table
+DROP TABLE `settings`;
+-- reverse: create index "device_token" to table: "devices"
+DROP INDEX `device_token`;
+-- reverse: create index "device_name" to table: "devices"
+DROP INDEX `device_name`;
+-- reverse: create index "device_ip" to table: "devices"
+DROP INDEX `device_ip`;
+-- reverse: create "devices" table
+DROP TABLE `devices`;
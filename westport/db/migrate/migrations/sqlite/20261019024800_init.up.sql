@@ -0,0 +1,18 @@
+-- create "devices" table
+CREATE TABLE `devices` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `updated_time` datetime NOT NULL, `name` text NOT NULL, `ip` integer NOT NULL, `leased_access_token` text NULL, `token` blob NOT NULL);
+-- create index "device_ip" to table: "devices"
+CREATE UNIQUE INDEX `device_ip` ON `devices` (`ip`);
+-- create index "device_name" to table: "devices"
+CREATE UNIQUE INDEX `device_name` ON `devices` (`name`);
+-- create index "device_token" to table: "devices"
+CREATE UNIQUE INDEX `device_token` ON `devices` (`token`);
+-- create "settings" table
+CREATE TABLE `settings` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `updated_time` datetime NOT NULL, `domain_zone` text NULL, `cipher` text NOT NULL DEFAULT ('aes'), `ca_crt` blob NOT NULL, `ca_key` blob NOT NULL, `lighthouse_crt` blob NOT NULL, `lighthouse_key` blob NOT NULL, `cidr` text NOT NULL, `port_overlay_ip` integer NOT NULL, `letsencrypt_registration` blob NULL, `tls_cert` blob NULL, `tls_cert_key` blob NULL);
+-- set sequence for "settings" table
+INSERT INTO sqlite_sequence (name, seq) VALUES ("settings", 4294967296);
+-- create "ent_types" table
+CREATE TABLE `ent_types` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `type` text NOT NULL);
+-- create index "ent_types_type_key" to table: "ent_types"
+CREATE UNIQUE INDEX `ent_types_type_key` ON `ent_types` (`type`);
+-- add pk ranges for ('devices'),('settings') tables
+INSERT INTO `ent_types` (`type`) VALUES ('devices'), ('settings');
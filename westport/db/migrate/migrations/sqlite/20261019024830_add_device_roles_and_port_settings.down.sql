@@ -0,0 +1,26 @@
+-- reverse: add column "require_client_keys" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `require_client_keys`;
+-- reverse: add column "public_api_port" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `public_api_port`;
+-- reverse: add column "public_nebula_port" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `public_nebula_port`;
+-- reverse: add column "public_host" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `public_host`;
+-- reverse: add column "https_port" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `https_port`;
+-- reverse: add column "http_port" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `http_port`;
+-- reverse: add column "nebula_port" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `nebula_port`;
+-- reverse: add column "port_relay" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `port_relay`;
+-- reverse: add column "public_endpoint" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `public_endpoint`;
+-- reverse: add column "lighthouse" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `lighthouse`;
+-- reverse: add column "relay" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `relay`;
+-- reverse: add column "exit_node" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `exit_node`;
+-- reverse: add column "advertise_routes" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `advertise_routes`;
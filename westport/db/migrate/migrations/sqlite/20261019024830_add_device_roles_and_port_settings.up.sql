@@ -0,0 +1,26 @@
+-- add column "advertise_routes" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `advertise_routes` json NULL;
+-- add column "exit_node" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `exit_node` bool NOT NULL DEFAULT (false);
+-- add column "relay" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `relay` bool NOT NULL DEFAULT (false);
+-- add column "lighthouse" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `lighthouse` bool NOT NULL DEFAULT (false);
+-- add column "public_endpoint" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `public_endpoint` text NULL;
+-- add column "port_relay" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `port_relay` bool NOT NULL DEFAULT (true);
+-- add column "nebula_port" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `nebula_port` integer NOT NULL DEFAULT (4242);
+-- add column "http_port" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `http_port` integer NOT NULL DEFAULT (80);
+-- add column "https_port" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `https_port` integer NOT NULL DEFAULT (443);
+-- add column "public_host" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `public_host` text NULL;
+-- add column "public_nebula_port" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `public_nebula_port` integer NULL;
+-- add column "public_api_port" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `public_api_port` integer NULL;
+-- add column "require_client_keys" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `require_client_keys` bool NOT NULL DEFAULT (false);
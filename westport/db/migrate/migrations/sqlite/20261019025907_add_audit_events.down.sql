@@ -0,0 +1,10 @@
+-- reverse: add pk ranges for ('audit_events') tables
+DELETE FROM `ent_types` WHERE `type` = 'audit_events';
+-- reverse: create index "auditevent_device_name" to table: "audit_events"
+DROP INDEX `auditevent_device_name`;
+-- reverse: create index "auditevent_created_time" to table: "audit_events"
+DROP INDEX `auditevent_created_time`;
+-- reverse: set sequence for "audit_events" table
+UPDATE sqlite_sequence SET seq = 0 WHERE name = "audit_events";
+-- reverse: create "audit_events" table
+DROP TABLE `audit_events`;
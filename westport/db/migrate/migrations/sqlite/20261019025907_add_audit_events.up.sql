@@ -0,0 +1,10 @@
+-- create "audit_events" table
+CREATE TABLE `audit_events` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `action` text NOT NULL, `actor` text NOT NULL, `remote_addr` text NULL, `device_id` integer NULL, `device_name` text NULL, `success` bool NOT NULL DEFAULT (true), `error` text NULL, `details` json NULL);
+-- set sequence for "audit_events" table
+INSERT INTO sqlite_sequence (name, seq) VALUES ("audit_events", 8589934592);
+-- create index "auditevent_created_time" to table: "audit_events"
+CREATE INDEX `auditevent_created_time` ON `audit_events` (`created_time`);
+-- create index "auditevent_device_name" to table: "audit_events"
+CREATE INDEX `auditevent_device_name` ON `audit_events` (`device_name`);
+-- add pk ranges for ('audit_events') tables
+INSERT INTO `ent_types` (`type`) VALUES ('audit_events');
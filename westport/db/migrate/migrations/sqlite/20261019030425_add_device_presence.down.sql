@@ -0,0 +1,20 @@
+-- reverse: add pk ranges for ('device_provisions') tables
+DELETE FROM `ent_types` WHERE `type` = 'device_provisions';
+-- reverse: create index "deviceprovision_device_id_created_time" to table: "device_provisions"
+DROP INDEX `deviceprovision_device_id_created_time`;
+-- reverse: set sequence for "device_provisions" table
+UPDATE sqlite_sequence SET seq = 0 WHERE name = "device_provisions";
+-- reverse: create "device_provisions" table
+DROP TABLE `device_provisions`;
+-- reverse: add column "arch" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `arch`;
+-- reverse: add column "os" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `os`;
+-- reverse: add column "client_version" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `client_version`;
+-- reverse: add column "underlay_addr" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `underlay_addr`;
+-- reverse: add column "last_seen_time" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `last_seen_time`;
+-- reverse: add column "last_provision_time" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `last_provision_time`;
@@ -0,0 +1,20 @@
+-- add column "last_provision_time" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `last_provision_time` datetime NULL;
+-- add column "last_seen_time" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `last_seen_time` datetime NULL;
+-- add column "underlay_addr" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `underlay_addr` text NULL;
+-- add column "client_version" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `client_version` text NULL;
+-- add column "os" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `os` text NULL;
+-- add column "arch" to table: "devices"
+ALTER TABLE `devices` ADD COLUMN `arch` text NULL;
+-- create "device_provisions" table
+CREATE TABLE `device_provisions` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `device_id` integer NOT NULL, `remote_addr` text NULL, `client_version` text NULL, `os` text NULL, `arch` text NULL, `client_key` bool NOT NULL DEFAULT (false));
+-- set sequence for "device_provisions" table
+INSERT INTO sqlite_sequence (name, seq) VALUES ("device_provisions", 12884901888);
+-- create index "deviceprovision_device_id_created_time" to table: "device_provisions"
+CREATE INDEX `deviceprovision_device_id_created_time` ON `device_provisions` (`device_id`, `created_time`);
+-- add pk ranges for ('device_provisions') tables
+INSERT INTO `ent_types` (`type`) VALUES ('device_provisions');
@@ -0,0 +1,14 @@
+-- reverse: add pk ranges for ('enrollment_keys') tables
+DELETE FROM `ent_types` WHERE `type` = 'enrollment_keys';
+-- reverse: create index "enrollmentkey_jti" to table: "enrollment_keys"
+DROP INDEX `enrollmentkey_jti`;
+-- reverse: create index "enrollmentkey_name" to table: "enrollment_keys"
+DROP INDEX `enrollmentkey_name`;
+-- reverse: set sequence for "enrollment_keys" table
+UPDATE sqlite_sequence SET seq = 0 WHERE name = "enrollment_keys";
+-- reverse: create "enrollment_keys" table
+DROP TABLE `enrollment_keys`;
+-- reverse: add column "groups" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `groups`;
+-- reverse: add column "single_use_token" to table: "devices"
+ALTER TABLE `devices` DROP COLUMN `single_use_token`;
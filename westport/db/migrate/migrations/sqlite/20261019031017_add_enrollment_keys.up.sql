@@ -0,0 +1,28 @@
+-- disable the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = off;
+-- create "new_devices" table
+CREATE TABLE `new_devices` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `updated_time` datetime NOT NULL, `name` text NOT NULL, `ip` integer NOT NULL, `leased_access_token` text NULL, `token` blob NOT NULL, `single_use_token` bool NOT NULL DEFAULT (false), `groups` json NULL, `advertise_routes` json NULL, `exit_node` bool NOT NULL DEFAULT (false), `relay` bool NOT NULL DEFAULT (false), `lighthouse` bool NOT NULL DEFAULT (false), `public_endpoint` text NULL, `last_provision_time` datetime NULL, `last_seen_time` datetime NULL, `underlay_addr` text NULL, `client_version` text NULL, `os` text NULL, `arch` text NULL);
+-- copy rows from old table "devices" to new temporary table "new_devices"
+INSERT INTO `new_devices` (`id`, `created_time`, `updated_time`, `name`, `ip`, `leased_access_token`, `token`, `advertise_routes`, `exit_node`, `relay`, `lighthouse`, `public_endpoint`, `last_provision_time`, `last_seen_time`, `underlay_addr`, `client_version`, `os`, `arch`) SELECT `id`, `created_time`, `updated_time`, `name`, `ip`, `leased_access_token`, `token`, `advertise_routes`, `exit_node`, `relay`, `lighthouse`, `public_endpoint`, `last_provision_time`, `last_seen_time`, `underlay_addr`, `client_version`, `os`, `arch` FROM `devices`;
+-- drop "devices" table after copying rows
+DROP TABLE `devices`;
+-- rename temporary table "new_devices" to "devices"
+ALTER TABLE `new_devices` RENAME TO `devices`;
+-- create index "device_ip" to table: "devices"
+CREATE UNIQUE INDEX `device_ip` ON `devices` (`ip`);
+-- create index "device_name" to table: "devices"
+CREATE UNIQUE INDEX `device_name` ON `devices` (`name`);
+-- create index "device_token" to table: "devices"
+CREATE UNIQUE INDEX `device_token` ON `devices` (`token`);
+-- create "enrollment_keys" table
+CREATE TABLE `enrollment_keys` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `updated_time` datetime NOT NULL, `name` text NOT NULL, `jti` text NOT NULL, `groups` json NULL, `max_uses` integer NOT NULL DEFAULT (0), `uses` integer NOT NULL DEFAULT (0), `expires_time` datetime NOT NULL, `revoked` bool NOT NULL DEFAULT (false));
+-- set sequence for "enrollment_keys" table
+INSERT INTO sqlite_sequence (name, seq) VALUES ("enrollment_keys", 17179869184);
+-- create index "enrollmentkey_name" to table: "enrollment_keys"
+CREATE UNIQUE INDEX `enrollmentkey_name` ON `enrollment_keys` (`name`);
+-- create index "enrollmentkey_jti" to table: "enrollment_keys"
+CREATE UNIQUE INDEX `enrollmentkey_jti` ON `enrollment_keys` (`jti`);
+-- enable back the enforcement of foreign-keys constraints
+PRAGMA foreign_keys = on;
+-- add pk ranges for ('enrollment_keys') tables
+INSERT INTO `ent_types` (`type`) VALUES ('enrollment_keys');
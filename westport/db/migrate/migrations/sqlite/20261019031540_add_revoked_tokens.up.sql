@@ -0,0 +1,8 @@
+-- create "revoked_tokens" table
+CREATE TABLE `revoked_tokens` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `jti` text NOT NULL, `device_id` integer NULL, `device_name` text NULL, `expires_time` datetime NOT NULL);
+-- set sequence for "revoked_tokens" table
+INSERT INTO sqlite_sequence (name, seq) VALUES ("revoked_tokens", 21474836480);
+-- create index "revokedtoken_jti" to table: "revoked_tokens"
+CREATE UNIQUE INDEX `revokedtoken_jti` ON `revoked_tokens` (`jti`);
+-- add pk ranges for ('revoked_tokens') tables
+INSERT INTO `ent_types` (`type`) VALUES ('revoked_tokens');
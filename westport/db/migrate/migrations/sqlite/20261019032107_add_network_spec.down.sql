@@ -0,0 +1,10 @@
+-- reverse: add pk ranges for ('dns_records') tables
+DELETE FROM `ent_types` WHERE `type` = 'dns_records';
+-- reverse: create index "dnsrecord_name" to table: "dns_records"
+DROP INDEX `dnsrecord_name`;
+-- reverse: set sequence for "dns_records" table
+UPDATE sqlite_sequence SET seq = 0 WHERE name = "dns_records";
+-- reverse: create "dns_records" table
+DROP TABLE `dns_records`;
+-- reverse: add column "firewall_inbound" to table: "settings"
+ALTER TABLE `settings` DROP COLUMN `firewall_inbound`;
@@ -0,0 +1,10 @@
+-- add column "firewall_inbound" to table: "settings"
+ALTER TABLE `settings` ADD COLUMN `firewall_inbound` json NULL;
+-- create "dns_records" table
+CREATE TABLE `dns_records` (`id` integer NOT NULL PRIMARY KEY AUTOINCREMENT, `created_time` datetime NOT NULL, `updated_time` datetime NOT NULL, `name` text NOT NULL, `type` text NOT NULL, `value` text NOT NULL);
+-- set sequence for "dns_records" table
+INSERT INTO sqlite_sequence (name, seq) VALUES ("dns_records", 25769803776);
+-- create index "dnsrecord_name" to table: "dns_records"
+CREATE UNIQUE INDEX `dnsrecord_name` ON `dns_records` (`name`);
+-- add pk ranges for ('dns_records') tables
+INSERT INTO `ent_types` (`type`) VALUES ('dns_records');
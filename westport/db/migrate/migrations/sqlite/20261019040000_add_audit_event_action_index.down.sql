@@ -0,0 +1,2 @@
+-- reverse: create index "auditevent_action" to table: "audit_events"
+DROP INDEX `auditevent_action`;
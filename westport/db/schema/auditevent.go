@@ -0,0 +1,91 @@
+package schema
+
+import (
+	"entgo.io/contrib/entgql"
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db/ent/privacy"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/west/westport/db/mixin"
+	"github.com/sprisa/west/westport/db/policy"
+)
+
+// Administrative and provisioning events. Append only.
+type AuditEvent struct {
+	ent.Schema
+}
+
+func (AuditEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("action").
+			Immutable().
+			Comment("What happened, e.g. device.create or device.provision"),
+		field.String("actor").
+			Immutable().
+			Comment("Who did it, e.g. cli:alice@port-host, admin:alice or device:laptop"),
+		field.String("remote_addr").
+			Optional().
+			Immutable().
+			Comment("Remote address of API requests"),
+		field.Int("device_id").
+			Optional().
+			Immutable().
+			Comment("Device acted on. Kept after the device is deleted"),
+		field.String("device_name").
+			Optional().
+			Immutable().
+			Comment("Name of the device acted on when the event was recorded"),
+		field.Bool("success").
+			Default(true).
+			Immutable(),
+		field.String("error").
+			Optional().
+			Immutable().
+			Comment("Why the action failed"),
+		field.JSON("details", map[string]string{}).
+			Optional().
+			Immutable().
+			Annotations(entgql.Skip()).
+			Comment("Action specific details, e.g. changed fields or cert fingerprint"),
+	}
+}
+
+func (AuditEvent) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (AuditEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("created_time"),
+		index.Fields("device_name"),
+		index.Fields("action"),
+	}
+}
+
+func (AuditEvent) Annotations() []schema.Annotation {
+	return []schema.Annotation{
+		entgql.Skip(helpers.EntGQLSkipNone),
+	}
+}
+
+func (AuditEvent) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreatedTimeMixin{},
+	}
+}
+
+// Events can be created but never changed. Only admins read them through the API.
+func (AuditEvent) Policy() ent.Policy {
+	return policy.DefaultPolicy(privacy.Policy{
+		Mutation: privacy.MutationPolicy{
+			privacy.OnMutationOperation(privacy.AlwaysAllowRule(), ent.OpCreate),
+		},
+		Query: privacy.QueryPolicy{
+			audit.DenyUnauthenticatedAPI(),
+			privacy.AlwaysAllowRule(),
+		},
+	})
+}
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"context"
 	"fmt"
 
 	"entgo.io/contrib/entgql"
@@ -10,6 +11,10 @@ import (
 	"entgo.io/ent/schema/index"
 	"github.com/anandvarma/namegen"
 	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/westport/audit"
+	gen "github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/hook"
 	"github.com/sprisa/west/westport/db/helpers"
 	"github.com/sprisa/west/westport/db/mixin"
 )
@@ -55,6 +60,47 @@ func (Device) Fields() []ent.Field {
 		field.Bytes("token").
 			Sensitive().
 			GoType(helpers.EncryptedBytes{}),
+		field.Bool("single_use_token").
+			Default(false).
+			Comment("Token is exchanged for a new one on first provision, so it can't be used twice"),
+		field.Strings("groups").
+			Optional().
+			Comment("Nebula groups signed into the device cert, for firewall rules"),
+		field.Strings("advertise_routes").
+			Optional().
+			Comment("IPv4 LAN cidrs this device routes for. Signed into the device cert and pushed to all other devices as unsafe routes."),
+		field.Bool("exit_node").
+			Default(false).
+			Comment("Device can route internet traffic for other devices. Opted into with `west start --exit-node`"),
+		field.Bool("relay").
+			Default(false).
+			Comment("Device relays traffic for peers that cannot hole punch, e.g. behind symmetric NAT"),
+		field.Bool("lighthouse").
+			Default(false).
+			Comment("Device is an additional lighthouse alongside west port"),
+		field.String("public_endpoint").
+			Optional().
+			Comment("Public underlay host:port other devices use to reach this device. Required for lighthouses"),
+		field.Time("last_provision_time").
+			Optional().
+			Nillable().
+			Comment("Last time the device provisioned or checked in with the API"),
+		field.Time("last_seen_time").
+			Optional().
+			Nillable().
+			Comment("Last time the device had a tunnel to west port's lighthouse"),
+		field.String("underlay_addr").
+			Optional().
+			Comment("Last known underlay address. The lighthouse's host:port for the device, or the API caller's IP after provisioning"),
+		field.String("client_version").
+			Optional().
+			Comment("West version the device last provisioned with"),
+		field.String("os").
+			Optional().
+			Comment("Operating system the device last provisioned from, e.g. linux"),
+		field.String("arch").
+			Optional().
+			Comment("CPU architecture the device last provisioned from, e.g. arm64"),
 	}
 }
 
@@ -85,6 +131,35 @@ func (Device) Mixin() []ent.Mixin {
 	}
 }
 
+func (Device) Hooks() []ent.Hook {
+	return []ent.Hook{
+		audit.DeviceHook(),
+		hook.On(deleteProvisionHistory, ent.OpDelete|ent.OpDeleteOne),
+	}
+}
+
+// Deletes the provisioning history of deleted devices. Migrations create no
+// foreign keys to cascade the delete.
+func deleteProvisionHistory(next ent.Mutator) ent.Mutator {
+	return hook.DeviceFunc(func(ctx context.Context, m *gen.DeviceMutation) (ent.Value, error) {
+		ids, err := m.IDs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		v, err := next.Mutate(ctx, m)
+		if err != nil {
+			return v, err
+		}
+		_, err = m.Client().DeviceProvision.Delete().
+			Where(deviceprovision.DeviceIDIn(ids...)).
+			Exec(ctx)
+		if err != nil {
+			return v, fmt.Errorf("error deleting provisioning history: %w", err)
+		}
+		return v, nil
+	})
+}
+
 // func (Device) Policy() ent.Policy {
 // 	return policy.DefaultPolicy(privacy.Policy{
 // 		Mutation: privacy.MutationPolicy{
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/sprisa/west/westport/db/mixin"
+)
+
+// Provisioning history of devices, including check-ins.
+type DeviceProvision struct {
+	ent.Schema
+}
+
+func (DeviceProvision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("device_id").
+			Immutable(),
+		field.String("remote_addr").
+			Optional().
+			Immutable().
+			Comment("IP the device called the API from"),
+		field.String("client_version").
+			Optional().
+			Immutable().
+			Comment("West version of the device. Empty for versions before it was sent"),
+		field.String("os").
+			Optional().
+			Immutable(),
+		field.String("arch").
+			Optional().
+			Immutable(),
+		field.Bool("client_key").
+			Default(false).
+			Immutable().
+			Comment("Device generated its own key pair"),
+	}
+}
+
+func (DeviceProvision) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (DeviceProvision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("device_id", "created_time"),
+	}
+}
+
+func (DeviceProvision) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
+
+func (DeviceProvision) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreatedTimeMixin{},
+	}
+}
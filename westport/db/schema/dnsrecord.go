@@ -0,0 +1,47 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/sprisa/west/westport/db/mixin"
+)
+
+// Extra record served by west port's DNS within the domain zone, e.g. an
+// alias for a device.
+type DNSRecord struct {
+	ent.Schema
+}
+
+func (DNSRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("Name within the domain zone, e.g. grafana for grafana.<zone>. Unique"),
+		field.Enum("type").
+			Values("A", "CNAME", "TXT"),
+		field.String("value").
+			Comment("IPv4 for A records, a device name or fqdn for CNAME records, or text"),
+	}
+}
+
+func (DNSRecord) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (DNSRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+	}
+}
+
+func (DNSRecord) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
+
+func (DNSRecord) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+	}
+}
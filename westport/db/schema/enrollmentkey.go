@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/sprisa/west/westport/db/mixin"
+)
+
+// Reusable key that registers a new device on each use, e.g. for
+// autoscaling groups.
+type EnrollmentKey struct {
+	ent.Schema
+}
+
+func (EnrollmentKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("name").
+			Comment("Key name. Unique"),
+		field.String("jti").
+			Immutable().
+			Comment("JWT ID of the key token. Tokens are matched to keys by it"),
+		field.Strings("groups").
+			Optional().
+			Comment("Nebula groups given to devices registered with the key"),
+		field.Int("max_uses").
+			Default(0).
+			NonNegative().
+			Comment("Devices the key can register. 0 is unlimited"),
+		field.Int("uses").
+			Default(0).
+			NonNegative().
+			Comment("Devices registered with the key"),
+		field.Time("expires_time").
+			Immutable(),
+		field.Bool("revoked").
+			Default(false),
+	}
+}
+
+func (EnrollmentKey) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (EnrollmentKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("name").
+			Unique(),
+		index.Fields("jti").
+			Unique(),
+	}
+}
+
+func (EnrollmentKey) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
+
+func (EnrollmentKey) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.TimeMixin{},
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/sprisa/west/westport/db/mixin"
+)
+
+// Device and admin tokens that can no longer be used, by JWT ID. Admin
+// tokens have no device.
+type RevokedToken struct {
+	ent.Schema
+}
+
+func (RevokedToken) Fields() []ent.Field {
+	return []ent.Field{
+		field.String("jti").
+			Immutable().
+			Comment("JWT ID of the revoked token"),
+		field.Int("device_id").
+			Optional().
+			Immutable(),
+		field.String("device_name").
+			Optional().
+			Immutable(),
+		field.Time("expires_time").
+			Immutable().
+			Comment("Token expiry. Rows can be pruned after it"),
+	}
+}
+
+func (RevokedToken) Edges() []ent.Edge {
+	return []ent.Edge{}
+}
+
+func (RevokedToken) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("jti").
+			Unique(),
+	}
+}
+
+func (RevokedToken) Annotations() []schema.Annotation {
+	return []schema.Annotation{}
+}
+
+func (RevokedToken) Mixin() []ent.Mixin {
+	return []ent.Mixin{
+		mixin.CreatedTimeMixin{},
+	}
+}
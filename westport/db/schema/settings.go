@@ -7,6 +7,7 @@ import (
 	"entgo.io/ent/schema"
 	"entgo.io/ent/schema/field"
 	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/westport/audit"
 	"github.com/sprisa/west/westport/db/helpers"
 	"github.com/sprisa/west/westport/db/mixin"
 )
@@ -40,6 +41,33 @@ func (Settings) Fields() []ent.Field {
 		field.Uint32("port_overlay_ip").
 			GoType(ipconv.IP(0)).
 			Comment("Network cidr range"),
+		field.Bool("port_relay").
+			Default(true).
+			Comment("West port relays traffic for devices that cannot hole punch"),
+		field.Int("nebula_port").
+			Default(4242).
+			Comment("UDP port nebula listens on"),
+		field.Int("http_port").
+			Default(80).
+			Comment("Port the API listens on for HTTP"),
+		field.Int("https_port").
+			Default(443).
+			Comment("Port the API listens on for HTTPS"),
+		field.String("public_host").
+			Optional().
+			Comment("Public host or IP devices use to reach west port. Discovered when empty"),
+		field.Int("public_nebula_port").
+			Optional().
+			Comment("Public nebula port when remapped by a load balancer. Defaults to nebula_port"),
+		field.Int("public_api_port").
+			Optional().
+			Comment("Public API port when remapped by a load balancer. Defaults to http_port or https_port"),
+		field.Bool("require_client_keys").
+			Default(false).
+			Comment("Only provision devices that generate their own key pair. Rejects provision_device, which returns a port generated private key"),
+		field.JSON("firewall_inbound", []helpers.FirewallRule{}).
+			Optional().
+			Comment("Inbound firewall rules pushed to devices. Devices allow all inbound traffic when unset"),
 		field.Bytes("letsencrypt_registration").
 			Sensitive().
 			GoType(helpers.EncryptedBytes{}).
@@ -86,6 +114,12 @@ func (Settings) Mixin() []ent.Mixin {
 	}
 }
 
+func (Settings) Hooks() []ent.Hook {
+	return []ent.Hook{
+		audit.SettingsHook(),
+	}
+}
+
 // func (Settings) Policy() ent.Policy {
 // 	return policy.DefaultPolicy(privacy.Policy{
 // 		Mutation: privacy.MutationPolicy{
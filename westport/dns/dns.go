@@ -4,12 +4,15 @@ import (
 	"context"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/miekg/dns"
 	"github.com/sprisa/west/util/info"
+	"github.com/sprisa/west/util/metrics"
 	"github.com/sprisa/west/westport/acme"
 	"github.com/sprisa/west/westport/db/ent"
 	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
 	"github.com/sprisa/x/errutil"
 	l "github.com/sprisa/x/log"
 )
@@ -28,31 +31,41 @@ func StartCompassDNSServer(
 		return nil
 	}
 
-	ip, err := info.GetPublicIP()
-	if err != nil {
-		l.Log.Err(err).Msg("error finding public ip")
-	} else {
+	if ip := net.ParseIP(settings.PublicHost); ip != nil {
 		l.Log.Info().Msgf("Public IP: %s", ip.String())
 		publicIp = ip
+	} else {
+		ip, err := info.GetPublicIP()
+		if err != nil {
+			l.Log.Err(err).Msg("error finding public ip")
+		} else {
+			l.Log.Info().Msgf("Public IP: %s", ip.String())
+			publicIp = ip
+		}
 	}
 
-	dnsServer := &dns.Server{Addr: addr, Net: "udp"}
-	dns.HandleFunc(".", func(res dns.ResponseWriter, msg *dns.Msg) {
-		handleDnsRequest(ctx, res, msg, client, settings, acme)
-	})
+	dnsServer := &dns.Server{
+		Addr: addr,
+		Net:  "udp",
+		Handler: dns.HandlerFunc(func(res dns.ResponseWriter, msg *dns.Msg) {
+			handleDnsRequest(ctx, res, msg, client, settings, acme)
+		}),
+	}
 
-	var closeError error
+	closeError := make(chan error, 1)
 	go func() {
 		<-ctx.Done()
-		closeError = dnsServer.Shutdown()
-		l.Log.Err(closeError).Msg("Compass DNS shutdown")
+		err := dnsServer.Shutdown()
+		l.Log.Err(err).Msg("Compass DNS shutdown")
+		closeError <- err
 	}()
 	l.Log.Info().Str("addr", dnsServer.Addr).Msg("Starting Compass DNS Server")
-	err = dnsServer.ListenAndServe()
+	err := dnsServer.ListenAndServe()
 	if err != nil {
 		return errutil.WrapErr(err, "failed to start Compass DNS Server")
 	}
-	return closeError
+	// Only returns without error once shut down
+	return <-closeError
 }
 
 func handleDnsRequest(
@@ -63,6 +76,7 @@ func handleDnsRequest(
 	settings *ent.Settings,
 	acme *acme.DNSProvider,
 ) {
+	start := time.Now()
 	m := new(dns.Msg)
 	m.SetReply(msg)
 	m.Compress = false
@@ -73,6 +87,12 @@ func handleDnsRequest(
 	}
 
 	res.WriteMsg(m)
+
+	metrics.DNSQueryDuration.Observe(time.Since(start).Seconds())
+	rcode := dns.RcodeToString[m.Rcode]
+	for _, q := range msg.Question {
+		metrics.DNSQueries.WithLabelValues(dns.TypeToString[q.Qtype], rcode).Inc()
+	}
 }
 
 func parseQuery(
@@ -117,6 +137,12 @@ func parseQuery(
 					Select(device.FieldIP).
 					Where(device.Name(dvcName)).
 					First(ctx)
+				if ent.IsNotFound(err) {
+					if answers := recordAnswers(ctx, client, settings, qName, dvcName, q.Qtype); len(answers) > 0 {
+						msg.Answer = append(msg.Answer, answers...)
+						continue
+					}
+				}
 				if err != nil {
 					l.Log.Err(err).
 						Str("dvc", dvcName).
@@ -171,6 +197,72 @@ func parseQuery(
 					return
 				}
 			}
+			name, _ := strings.CutSuffix(host, "."+settings.DomainZone)
+			msg.Answer = append(msg.Answer, recordAnswers(ctx, client, settings, qName, name, q.Qtype)...)
+
+		case dns.TypeCNAME:
+			name, _ := strings.CutSuffix(host, "."+settings.DomainZone)
+			msg.Answer = append(msg.Answer, recordAnswers(ctx, client, settings, qName, name, q.Qtype)...)
+		}
+	}
+}
+
+// Answers for qType from the DNS record named name. A queries follow CNAME
+// records to devices in the zone.
+func recordAnswers(
+	ctx context.Context,
+	client *ent.Client,
+	settings *ent.Settings,
+	qName string,
+	name string,
+	qType uint16,
+) []dns.RR {
+	rec, err := client.DNSRecord.Query().
+		Where(dnsrecord.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			l.Log.Err(err).Str("name", name).Msg("dns error fetching record")
+		}
+		return nil
+	}
+	hdr := func(name string, rrType uint16) dns.RR_Header {
+		return dns.RR_Header{
+			Name:   name,
+			Rrtype: rrType,
+			Class:  dns.ClassINET,
+			Ttl:    300,
+		}
+	}
+
+	switch {
+	case rec.Type == dnsrecord.TypeA && qType == dns.TypeA:
+		return []dns.RR{&dns.A{A: net.ParseIP(rec.Value), Hdr: hdr(qName, dns.TypeA)}}
+	case rec.Type == dnsrecord.TypeTXT && qType == dns.TypeTXT:
+		return []dns.RR{&dns.TXT{Txt: []string{rec.Value}, Hdr: hdr(qName, dns.TypeTXT)}}
+	case rec.Type == dnsrecord.TypeCNAME && (qType == dns.TypeA || qType == dns.TypeCNAME):
+		// Bare names are devices in the zone
+		target := rec.Value
+		if !strings.Contains(target, ".") {
+			target += "." + settings.DomainZone
+		}
+		target = dns.Fqdn(target)
+		answers := []dns.RR{&dns.CNAME{Target: target, Hdr: hdr(qName, dns.TypeCNAME)}}
+		if qType != dns.TypeA {
+			return answers
+		}
+		dvcName, ok := strings.CutSuffix(strings.TrimSuffix(target, "."), "."+settings.DomainZone)
+		if !ok {
+			return answers
+		}
+		dvc, err := client.Device.Query().
+			Select(device.FieldIP).
+			Where(device.Name(dvcName)).
+			First(ctx)
+		if err == nil {
+			answers = append(answers, &dns.A{A: dvc.IP.ToIPV4(), Hdr: hdr(target, dns.TypeA)})
 		}
+		return answers
 	}
+	return nil
 }
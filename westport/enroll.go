@@ -0,0 +1,247 @@
+package westport
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	"github.com/urfave/cli/v3"
+)
+
+var EnrollmentKeyCommand = &cli.Command{
+	Name:      "enrollment-key",
+	Usage:     "Manage reusable keys that register a new device on each use, e.g. for autoscaling groups",
+	UsageText: "west port enrollment-key create --name web --max-uses 50",
+	Before:    localOnly,
+	Commands: []*cli.Command{
+		EnrollmentKeyCreateCommand,
+		EnrollmentKeyListCommand,
+		EnrollmentKeyRevokeCommand,
+	},
+	Action: func(ctx context.Context, cmd *cli.Command) error {
+		return cli.ShowSubcommandHelp(cmd)
+	},
+}
+
+var EnrollmentKeyCreateCommand = &cli.Command{
+	Name:      "create",
+	Usage:     "Create an enrollment key and print its token",
+	UsageText: "west port enrollment-key create --name web [--max-uses 50] [--expires 720h] [--group web]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:     "name",
+			Required: true,
+			Usage:    "Key name. Must be unique.",
+		},
+		&cli.IntFlag{
+			Name:  "max-uses",
+			Usage: "Devices the key can register. 0 is unlimited.",
+			Validator: func(n int) error {
+				if n < 0 {
+					return errors.New("max uses must not be negative")
+				}
+				return nil
+			},
+		},
+		&cli.DurationFlag{
+			Name:  "expires",
+			Value: 30 * 24 * time.Hour,
+			Usage: "Time until the key expires. Registered devices keep working.",
+		},
+		&cli.StringSliceFlag{
+			Name:  "group",
+			Usage: "Nebula group given to registered devices. Repeatable.",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		err := readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		settings, err := client.Settings.Query().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.New("error finding settings. Trying installing first.")
+			}
+			return errutil.WrapErr(err, "error reading settings. Is the password correct?")
+		}
+
+		token, err := CreateEnrollmentKey(ctx, client, settings, &EnrollmentKeyOpts{
+			Name:    c.String("name"),
+			MaxUses: c.Int("max-uses"),
+			Expires: c.Duration("expires"),
+			Groups:  c.StringSlice("group"),
+		})
+		if err != nil {
+			return err
+		}
+
+		println(token)
+		return nil
+	},
+}
+
+type EnrollmentKeyOpts struct {
+	Name string
+	// Devices the key can register. 0 is unlimited.
+	MaxUses int
+	// Key lifetime. Defaults to 30 days.
+	Expires time.Duration
+	// Nebula groups given to registered devices
+	Groups []string
+}
+
+// Creates an enrollment key and returns its token.
+// Requires helpers.EncryptionKey to be set.
+func CreateEnrollmentKey(ctx context.Context, client *ent.Client, settings *ent.Settings, opts *EnrollmentKeyOpts) (string, error) {
+	claims, err := tokenClaims(settings)
+	if err != nil {
+		return "", err
+	}
+	lifetime := opts.Expires
+	if lifetime == 0 {
+		lifetime = 30 * 24 * time.Hour
+	}
+	expires := time.Now().Add(lifetime)
+	claims.ID = rand.Text()
+	claims.ExpiresAt = jwt.NewNumericDate(expires)
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+		SignedString(helpers.EncryptionKey[:])
+	if err != nil {
+		return "", errutil.WrapErr(err, "error creating token")
+	}
+
+	key, err := client.EnrollmentKey.Create().
+		SetName(opts.Name).
+		SetJti(claims.ID).
+		SetGroups(opts.Groups).
+		SetMaxUses(opts.MaxUses).
+		SetExpiresTime(expires).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return "", fmt.Errorf("enrollment key `%s` already exists", opts.Name)
+		}
+		return "", errutil.WrapErr(err, "error saving enrollment key")
+	}
+	err = audit.Record(ctx, client, &audit.Event{
+		Action: audit.EnrollmentKeyCreate,
+		Details: map[string]string{
+			"name":     key.Name,
+			"max_uses": strconv.Itoa(key.MaxUses),
+			"groups":   strings.Join(key.Groups, ","),
+			"expires":  key.ExpiresTime.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+var EnrollmentKeyListCommand = &cli.Command{
+	Name:      "list",
+	Usage:     "List enrollment keys and their uses",
+	UsageText: "west port enrollment-key list",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		keys, err := client.EnrollmentKey.Query().
+			Order(ent.Asc(enrollmentkey.FieldName)).
+			All(ctx)
+		if err != nil {
+			return errutil.WrapErr(err, "error listing enrollment keys")
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tSTATUS\tUSES\tEXPIRES\tGROUPS")
+		for _, key := range keys {
+			status := "active"
+			switch {
+			case key.Revoked:
+				status = "revoked"
+			case time.Now().After(key.ExpiresTime):
+				status = "expired"
+			case key.MaxUses > 0 && key.Uses >= key.MaxUses:
+				status = "used up"
+			}
+			uses := strconv.Itoa(key.Uses)
+			if key.MaxUses > 0 {
+				uses += "/" + strconv.Itoa(key.MaxUses)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+				key.Name,
+				status,
+				uses,
+				key.ExpiresTime.Format(time.RFC3339),
+				dash(strings.Join(key.Groups, ",")),
+			)
+		}
+		return w.Flush()
+	},
+}
+
+var EnrollmentKeyRevokeCommand = &cli.Command{
+	Name:      "revoke",
+	Usage:     "Stop an enrollment key from registering devices. Registered devices keep working.",
+	UsageText: "west port enrollment-key revoke <name>",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		name := c.Args().First()
+		if name == "" {
+			return errors.New("enrollment key name required")
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		n, err := client.EnrollmentKey.Update().
+			Where(enrollmentkey.Name(name)).
+			SetRevoked(true).
+			Save(ctx)
+		if err != nil {
+			return errutil.WrapErr(err, "error revoking enrollment key")
+		}
+		if n == 0 {
+			return fmt.Errorf("enrollment key `%s` not found", name)
+		}
+		return audit.Record(ctx, client, &audit.Event{
+			Action:  audit.EnrollmentKeyRevoke,
+			Details: map[string]string{"name": name},
+		})
+	},
+}
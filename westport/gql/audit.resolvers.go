@@ -0,0 +1,40 @@
+package gql
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db/ent"
+)
+
+// Details is the resolver for the details field.
+func (r *auditEventResolver) Details(ctx context.Context, obj *ent.AuditEvent) ([]*AuditDetail, error) {
+	details := make([]*AuditDetail, 0, len(obj.Details))
+	for _, key := range slices.Sorted(maps.Keys(obj.Details)) {
+		details = append(details, &AuditDetail{Key: key, Value: obj.Details[key]})
+	}
+	return details, nil
+}
+
+// AuditEvents is the resolver for the audit_events field.
+func (r *queryResolver) AuditEvents(ctx context.Context, since *time.Time, until *time.Time, device *string, action *string, limit *int) ([]*ent.AuditEvent, error) {
+	if !audit.ActorFrom(ctx).Admin {
+		return nil, errors.New("admin token required")
+	}
+	return audit.Events(ctx, r.client, &audit.Filter{
+		Since:  lo.FromPtr(since),
+		Until:  lo.FromPtr(until),
+		Device: lo.FromPtr(device),
+		Action: lo.FromPtr(action),
+		Limit:  lo.FromPtr(limit),
+	})
+}
@@ -0,0 +1,227 @@
+package gql
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	jwt "github.com/golang-jwt/jwt/v5"
+	"github.com/sprisa/west/util/auth"
+	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/enrollmentkey"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/x/errutil"
+)
+
+var errNotEnrollmentKey = errors.New("not an enrollment key")
+
+// Registers a new device for an enrollment key token, named after the
+// device's hostname. Returns the device and its device token.
+func (r *Resolver) enroll(ctx context.Context, client *ent.Client, settings *ent.Settings, token string, info *ClientInfoInput) (*ent.Device, string, error) {
+	claims, err := parseToken(token)
+	if err != nil || claims.ID == "" {
+		return nil, "", errNotEnrollmentKey
+	}
+	key, err := client.EnrollmentKey.Query().
+		Where(enrollmentkey.Jti(claims.ID)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, "", errNotEnrollmentKey
+	}
+	if err != nil {
+		return nil, "", errutil.WrapErr(err, "error finding enrollment key")
+	}
+
+	actor := *audit.ActorFrom(ctx)
+	actor.Name = "enrollment-key:" + key.Name
+	ctx = audit.WithActor(ctx, &actor)
+
+	hostname := ""
+	if info != nil && info.Hostname != nil {
+		hostname = *info.Hostname
+	}
+	// Concurrent enrollments can race for the same name or IP
+	for attempt := 1; ; attempt++ {
+		dvc, deviceToken, err := enrollDevice(ctx, client, settings, key, claims, hostname)
+		if err == nil || !ent.IsConstraintError(err) || attempt == 5 {
+			return dvc, deviceToken, err
+		}
+	}
+}
+
+// Runs in a savepoint of client's transaction. A failed insert aborts the
+// rest of a postgres transaction, the savepoint lets enroll retry.
+func enrollDevice(ctx context.Context, client *ent.Client, settings *ent.Settings, key *ent.EnrollmentKey, claims *auth.TokenClaims, hostname string) (_ *ent.Device, _ string, err error) {
+	_, err = client.ExecContext(ctx, "SAVEPOINT enroll")
+	if err != nil {
+		return nil, "", errutil.WrapErr(err, "error starting enrollment")
+	}
+	defer func() {
+		if err != nil {
+			client.ExecContext(ctx, "ROLLBACK TO SAVEPOINT enroll")
+		}
+	}()
+
+	used, err := client.EnrollmentKey.Update().
+		Where(
+			enrollmentkey.ID(key.ID),
+			enrollmentkey.Revoked(false),
+			enrollmentkey.ExpiresTimeGT(time.Now()),
+			enrollmentkey.Or(
+				enrollmentkey.MaxUses(0),
+				func(s *sql.Selector) {
+					s.Where(sql.ColumnsLT(s.C(enrollmentkey.FieldUses), s.C(enrollmentkey.FieldMaxUses)))
+				},
+			),
+		).
+		AddUses(1).
+		Save(ctx)
+	if err != nil {
+		return nil, "", errutil.WrapErr(err, "error using enrollment key")
+	}
+	if used == 0 {
+		return nil, "", fmt.Errorf("enrollment key `%s` is revoked, expired or used up", key.Name)
+	}
+
+	dvcs, err := client.Device.Query().
+		Select(device.FieldName, device.FieldIP).
+		All(ctx)
+	if err != nil {
+		return nil, "", errutil.WrapErr(err, "error listing devices")
+	}
+	ip, err := allocateIP(settings, dvcs)
+	if err != nil {
+		return nil, "", err
+	}
+	ipInt, err := ipconv.FromIPAddr(ip)
+	if err != nil {
+		return nil, "", errutil.WrapErr(err, "error converting ip")
+	}
+
+	deviceClaims := *claims
+	deviceClaims.IP = netip.PrefixFrom(ip, settings.Cidr.Bits()).String()
+	deviceClaims.ID = rand.Text()
+	deviceClaims.ExpiresAt = jwt.NewNumericDate(time.Now().Add(auth.DeviceTokenExpiry))
+	deviceToken, err := signToken(&deviceClaims)
+	if err != nil {
+		return nil, "", err
+	}
+
+	create := client.Device.Create().
+		SetIP(ipInt).
+		SetToken(helpers.EncryptedBytes(deviceToken)).
+		SetGroups(key.Groups)
+	if name := deviceName(hostname, dvcs); name != "" {
+		create.SetName(name)
+	}
+	dvc, err := create.Save(ctx)
+	if err != nil {
+		return nil, "", errutil.WrapErr(err, "error saving device")
+	}
+	_, err = client.ExecContext(ctx, "RELEASE SAVEPOINT enroll")
+	if err != nil {
+		return nil, "", errutil.WrapErr(err, "error saving device")
+	}
+	return dvc, deviceToken, nil
+}
+
+// Replaces a single use token with a new device token. Fails when the token
+// was already exchanged.
+func exchangeToken(ctx context.Context, client *ent.Client, dvc *ent.Device, claims *auth.TokenClaims) (string, error) {
+	fresh := *claims
+	fresh.ID = rand.Text()
+	fresh.ExpiresAt = jwt.NewNumericDate(time.Now().Add(auth.DeviceTokenExpiry))
+	token, err := signToken(&fresh)
+	if err != nil {
+		return "", err
+	}
+	n, err := client.Device.Update().
+		Where(device.ID(dvc.ID), device.SingleUseToken(true)).
+		SetToken(helpers.EncryptedBytes(token)).
+		SetSingleUseToken(false).
+		Save(ctx)
+	if err != nil {
+		return "", errutil.WrapErr(err, "error exchanging token")
+	}
+	if n == 0 {
+		return "", errors.New("token already used")
+	}
+	return token, nil
+}
+
+// Lowest free IP in the network, skipping the port, network and broadcast
+// addresses.
+func allocateIP(settings *ent.Settings, dvcs []*ent.Device) (netip.Addr, error) {
+	used := map[netip.Addr]bool{
+		settings.PortOverlayIP.ToIpAddr(): true,
+	}
+	for _, dvc := range dvcs {
+		used[dvc.IP.ToIpAddr()] = true
+	}
+	prefix := settings.Cidr.Masked()
+	for ip := prefix.Addr().Next(); prefix.Contains(ip.Next()); ip = ip.Next() {
+		if !used[ip] {
+			return ip, nil
+		}
+	}
+	return netip.Addr{}, fmt.Errorf("no free ips left in network cidr `%s`", settings.Cidr)
+}
+
+// DNS safe device name from the first label of hostname. A number is
+// appended when the name is taken. Empty when hostname has no usable
+// characters.
+func deviceName(hostname string, dvcs []*ent.Device) string {
+	label, _, _ := strings.Cut(strings.ToLower(hostname), ".")
+	name := strings.Map(func(r rune) rune {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') || r == '-' {
+			return r
+		}
+		return '-'
+	}, label)
+	name = strings.Trim(name, "-")
+	// Room for a suffix within the 63 character DNS label limit
+	name = strings.TrimRight(name[:min(len(name), 56)], "-")
+	if name == "" {
+		return ""
+	}
+
+	taken := map[string]bool{}
+	for _, dvc := range dvcs {
+		taken[dvc.Name] = true
+	}
+	candidate := name
+	for i := 2; taken[candidate]; i++ {
+		candidate = name + "-" + strconv.Itoa(i)
+	}
+	return candidate
+}
+
+// Verifies a device or enrollment key token.
+func parseToken(token string) (*auth.TokenClaims, error) {
+	claims := &auth.TokenClaims{}
+	_, err := jwt.NewParser().ParseWithClaims(token, claims, func(t *jwt.Token) (any, error) {
+		return helpers.EncryptionKey[:], nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
+
+func signToken(claims *auth.TokenClaims) (string, error) {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).
+		SignedString(helpers.EncryptionKey[:])
+	if err != nil {
+		return "", errutil.WrapErr(err, "error creating token")
+	}
+	return token, nil
+}
@@ -0,0 +1,94 @@
+package gql
+
+import (
+	"net/netip"
+	"strings"
+	"testing"
+
+	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/helpers"
+)
+
+func devices(t *testing.T, ips ...string) []*ent.Device {
+	t.Helper()
+	dvcs := []*ent.Device{}
+	for _, s := range ips {
+		ip, err := ipconv.ParseToIP(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		dvcs = append(dvcs, &ent.Device{IP: ip})
+	}
+	return dvcs
+}
+
+func TestAllocateIP(t *testing.T) {
+	for _, c := range []struct {
+		cidr    string
+		portIP  string
+		devices []string
+		want    string
+	}{
+		{"10.10.10.1/24", "10.10.10.1", nil, "10.10.10.2"},
+		{"10.10.10.1/24", "10.10.10.1", []string{"10.10.10.2", "10.10.10.3"}, "10.10.10.4"},
+		{"10.10.10.1/24", "10.10.10.1", []string{"10.10.10.3"}, "10.10.10.2"},
+		// Network address is skipped
+		{"10.10.10.0/24", "10.10.10.5", nil, "10.10.10.1"},
+		// Broadcast address is never allocated
+		{"10.0.0.1/30", "10.0.0.1", []string{"10.0.0.2"}, ""},
+	} {
+		cidr, err := helpers.NewIpCidr(c.cidr)
+		if err != nil {
+			t.Fatal(err)
+		}
+		portIP, err := ipconv.ParseToIP(c.portIP)
+		if err != nil {
+			t.Fatal(err)
+		}
+		settings := &ent.Settings{Cidr: cidr, PortOverlayIP: portIP}
+		got, err := allocateIP(settings, devices(t, c.devices...))
+		if c.want == "" {
+			if err == nil || !strings.Contains(err.Error(), "no free ips") {
+				t.Errorf("allocateIP(%s, %v) = %s, want no free ips error", c.cidr, c.devices, got)
+			}
+			continue
+		}
+		if err != nil || got != netip.MustParseAddr(c.want) {
+			t.Errorf("allocateIP(%s, %v) = %s, %v, want %s", c.cidr, c.devices, got, err, c.want)
+		}
+	}
+}
+
+func TestDeviceName(t *testing.T) {
+	long := strings.Repeat("a", 60)
+	for _, c := range []struct {
+		hostname string
+		taken    []string
+		want     string
+	}{
+		{"web-01.example.com", nil, "web-01"},
+		{"My_Laptop.local", nil, "my-laptop"},
+		{"--db--", nil, "db"},
+		{"ünïcode", nil, "n-code"},
+		{"", nil, ""},
+		{"!!!.example.com", nil, ""},
+		{"web", []string{"web"}, "web-2"},
+		{"web.example.com", []string{"web", "web-2"}, "web-3"},
+		{"web-2", []string{"web-2"}, "web-2-2"},
+		// Truncated to 56 characters, leaving room for a suffix
+		{long, nil, long[:56]},
+		{long, []string{long[:56]}, long[:56] + "-2"},
+		// Trailing dashes left by truncating are trimmed
+		{strings.Repeat("a", 55) + "-bbbb", nil, strings.Repeat("a", 55)},
+	} {
+		dvcs := []*ent.Device{}
+		for _, name := range c.taken {
+			dvcs = append(dvcs, &ent.Device{Name: name})
+		}
+		got := deviceName(c.hostname, dvcs)
+		if got != c.want {
+			t.Errorf("deviceName(%q, %v) = %q, want %q", c.hostname, c.taken, got, c.want)
+		}
+	}
+}
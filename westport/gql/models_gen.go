@@ -2,15 +2,89 @@
 
 package gql
 
+type AuditDetail struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// The west build provisioning a device. Sent by `west start`.
+type ClientInfoInput struct {
+	Version string `json:"version"`
+	// GOOS, e.g. linux
+	Os string `json:"os"`
+	// GOARCH, e.g. arm64
+	Arch string `json:"arch"`
+	// Names devices registered with an enrollment key
+	Hostname *string `json:"hostname,omitempty"`
+}
+
+type ExitNode struct {
+	Name string `json:"name"`
+	// Overlay IP of the exit node
+	IP string `json:"ip"`
+}
+
+type FirewallRule struct {
+	// 0 is any
+	Port int `json:"port"`
+	// any, tcp, udp or icmp
+	Proto string `json:"proto"`
+	// Device name or any
+	Host string `json:"host"`
+	// Peer cert must have all groups
+	Groups []string `json:"groups"`
+	// Peer overlay IP within cidr
+	Cidr string `json:"cidr"`
+}
+
+type Lighthouse struct {
+	// Overlay IP of the lighthouse
+	IP string `json:"ip"`
+	// Public underlay host:port addresses of the lighthouse
+	Endpoints []string `json:"endpoints"`
+}
+
 type ProvisionDeviceInput struct {
+	Token  string           `json:"token"`
+	Client *ClientInfoInput `json:"client,omitempty"`
+}
+
+type ProvisionDevicePublicKeyInput struct {
 	Token string `json:"token"`
+	// PEM encoded X25519 public key generated by the device
+	PublicKey string           `json:"public_key"`
+	Client    *ClientInfoInput `json:"client,omitempty"`
 }
 
 type ProvisionDeviceResponse struct {
-	Name          string `json:"name"`
-	Ca            string `json:"ca"`
-	Cert          string `json:"cert"`
-	Key           string `json:"key"`
-	AccessToken   string `json:"access_token"`
-	NetworkCipher string `json:"networkCipher"`
+	Name string `json:"name"`
+	Ca   string `json:"ca"`
+	Cert string `json:"cert"`
+	Key  string `json:"key"`
+	// Token the device provisions with from now on. Differs from the given
+	// token after one-time tokens and enrollment keys.
+	AccessToken   string         `json:"access_token"`
+	NetworkCipher string         `json:"networkCipher"`
+	UnsafeRoutes  []*UnsafeRoute `json:"unsafe_routes"`
+	ExitNodes     []*ExitNode    `json:"exit_nodes"`
+	// Overlay IPs of relays peers can use to reach this device
+	Relays []string `json:"relays"`
+	// Device should relay traffic for other devices
+	AmRelay bool `json:"am_relay"`
+	// Lighthouses in addition to west port
+	Lighthouses []*Lighthouse `json:"lighthouses"`
+	// Device should act as a lighthouse
+	AmLighthouse bool `json:"am_lighthouse"`
+	// Public nebula host:port of west port. Empty when not configured
+	PortEndpoint string `json:"port_endpoint"`
+	// Inbound firewall rules for the device. Null when west port doesn't manage
+	// the firewall, in which case all inbound traffic is allowed.
+	FirewallInbound []*FirewallRule `json:"firewall_inbound,omitempty"`
+}
+
+type UnsafeRoute struct {
+	// LAN cidr reachable through the device at `via`
+	Route string `json:"route"`
+	// Overlay IP of the subnet router advertising `route`
+	Via string `json:"via"`
 }
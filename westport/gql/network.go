@@ -0,0 +1,125 @@
+package gql
+
+import (
+	"net"
+	"strconv"
+
+	"github.com/sprisa/west/util/route"
+	"github.com/sprisa/west/westport/db/ent"
+)
+
+// Builds the unsafe routes a device needs to reach the LANs advertised by
+// every other subnet router in the network.
+func unsafeRoutesFor(dvc *ent.Device, dvcs []*ent.Device) []*UnsafeRoute {
+	routes := []*UnsafeRoute{}
+	for _, router := range dvcs {
+		if router.ID == dvc.ID {
+			continue
+		}
+		for _, route := range router.AdvertiseRoutes {
+			routes = append(routes, &UnsafeRoute{
+				Route: route,
+				Via:   router.IP.ToIpAddr().String(),
+			})
+		}
+	}
+	return routes
+}
+
+// Lists every exit node a device could opt into, excluding itself.
+func exitNodesFor(dvc *ent.Device, dvcs []*ent.Device) []*ExitNode {
+	nodes := []*ExitNode{}
+	for _, node := range dvcs {
+		if node.ID == dvc.ID || node.ExitNode == false {
+			continue
+		}
+		nodes = append(nodes, &ExitNode{
+			Name: node.Name,
+			IP:   node.IP.ToIpAddr().String(),
+		})
+	}
+	return nodes
+}
+
+// Subnets signed into a device cert. Exit nodes route all internet traffic,
+// so their cert must allow any destination.
+func certSubnetsFor(dvc *ent.Device) []string {
+	subnets := append([]string{}, dvc.AdvertiseRoutes...)
+	if dvc.ExitNode {
+		subnets = append(subnets, route.DefaultRoute)
+	}
+	return subnets
+}
+
+// Lists the overlay IPs of every relay other than the device itself.
+// West port is listed first when relaying is enabled.
+func relaysFor(dvc *ent.Device, dvcs []*ent.Device, settings *ent.Settings) []string {
+	relays := []string{}
+	if settings.PortRelay {
+		relays = append(relays, settings.PortOverlayIP.ToIpAddr().String())
+	}
+	for _, relay := range dvcs {
+		if relay.ID == dvc.ID || relay.Relay == false {
+			continue
+		}
+		relays = append(relays, relay.IP.ToIpAddr().String())
+	}
+	return relays
+}
+
+// Lists every lighthouse device other than the device itself.
+func lighthousesFor(dvc *ent.Device, dvcs []*ent.Device) []*Lighthouse {
+	lighthouses := []*Lighthouse{}
+	for _, lh := range dvcs {
+		if lh.ID == dvc.ID || lh.Lighthouse == false || lh.PublicEndpoint == "" {
+			continue
+		}
+		lighthouses = append(lighthouses, &Lighthouse{
+			IP:        lh.IP.ToIpAddr().String(),
+			Endpoints: []string{lh.PublicEndpoint},
+		})
+	}
+	return lighthouses
+}
+
+// Public nebula host:port of west port. Uses fallbackHost when no public host
+// or domain zone is configured, returning empty if that is empty too.
+func PortEndpoint(settings *ent.Settings, fallbackHost string) string {
+	host := settings.PublicHost
+	if host == "" {
+		host = settings.DomainZone
+	}
+	if host == "" {
+		host = fallbackHost
+	}
+	if host == "" {
+		return ""
+	}
+	port := settings.PublicNebulaPort
+	if port == 0 {
+		port = settings.NebulaPort
+	}
+	return net.JoinHostPort(host, strconv.Itoa(port))
+}
+
+// Inbound firewall rules for devices. Nil when the firewall is unmanaged.
+func firewallFor(settings *ent.Settings) []*FirewallRule {
+	if settings.FirewallInbound == nil {
+		return nil
+	}
+	rules := []*FirewallRule{}
+	for _, r := range settings.FirewallInbound {
+		rule := &FirewallRule{
+			Port:   r.Port,
+			Proto:  r.Proto,
+			Host:   r.Host,
+			Groups: append([]string{}, r.Groups...),
+			Cidr:   r.Cidr,
+		}
+		if rule.Proto == "" {
+			rule.Proto = "any"
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
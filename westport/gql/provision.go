@@ -0,0 +1,311 @@
+package gql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/samber/lo"
+	"github.com/sprisa/west/util/metrics"
+	"github.com/sprisa/west/util/pki"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/auditevent"
+	"github.com/sprisa/west/westport/db/ent/deviceprovision"
+	"github.com/sprisa/west/westport/db/ent/privacy"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+)
+
+// Provisions the device holding token. Signs publicKey when given,
+// otherwise generates a key pair and returns the private key. client is nil
+// for west versions that don't send it.
+func (r *Resolver) provisionDevice(ctx context.Context, token string, publicKey []byte, client *ClientInfoInput) (_ *ProvisionDeviceResponse, err error) {
+	outcome := metrics.ProvisionError
+	var dvc *ent.Device
+	enrolled := false
+	defer func() {
+		metrics.ProvisionRequests.WithLabelValues(outcome).Inc()
+		if err != nil {
+			// Undoes enrollment and token exchange before auditing the
+			// failure outside the transaction
+			if tx := ent.TxFromContext(ctx); tx != nil {
+				tx.Rollback()
+			}
+			if enrolled {
+				dvc = nil
+			}
+			recordProvisionFailure(ctx, r.client, dvc, err)
+		}
+	}()
+	db := r.txClient(ctx)
+
+	settings, err := db.Settings.Query().Only(ctx)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error fetching settings")
+	}
+	// Tokens are encrypted, so they can only be compared once decrypted
+	dvcs, err := db.Device.Query().
+		All(ctx)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error finding device")
+	}
+	if publicKey == nil && settings.RequireClientKeys {
+		outcome = metrics.ProvisionRejected
+		return nil, errors.New("west port requires devices to generate their own keys. Upgrade west on the device.")
+	}
+
+	dvc, found := lo.Find(dvcs, func(dvc *ent.Device) bool {
+		return dvc.Token.String() == token
+	})
+	// Token the device uses from now on
+	accessToken := token
+	if !found {
+		// Enrollment keys register a new device on each use
+		dvc, accessToken, err = r.enroll(ctx, db, settings, token, client)
+		if errors.Is(err, errNotEnrollmentKey) {
+			outcome = metrics.ProvisionNotFound
+			return nil, errors.New("device not found")
+		}
+		if err != nil {
+			outcome = metrics.ProvisionInvalidToken
+			return nil, err
+		}
+		enrolled = true
+		dvcs = append(dvcs, dvc)
+	}
+
+	claims, err := parseToken(accessToken)
+	if err != nil {
+		l.Log.Err(err).Msg("ProvisionDevice: error parsing jwt")
+		outcome = metrics.ProvisionInvalidToken
+		return nil, errors.New("error parsing jwt")
+	}
+
+	if claims.ExpiresAt.Before(time.Now()) {
+		outcome = metrics.ProvisionInvalidToken
+		return nil, errors.New("invalid token")
+	}
+
+	if claims.ID != "" {
+		revoked, err := db.RevokedToken.Query().
+			Where(revokedtoken.Jti(claims.ID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error checking token revocation")
+		}
+		if revoked {
+			outcome = metrics.ProvisionInvalidToken
+			return nil, errors.New("token revoked. Issue a new one with `west port token issue`")
+		}
+	}
+
+	if dvc.SingleUseToken {
+		accessToken, err = exchangeToken(ctx, db, dvc, claims)
+		if err != nil {
+			outcome = metrics.ProvisionInvalidToken
+			return nil, err
+		}
+	}
+
+	ip := dvc.IP.ToIpAddr()
+	nebulaIp := netip.PrefixFrom(ip, settings.Cidr.Bits())
+
+	cert, err := pki.SignCert(&pki.SignCertOptions{
+		CaCrt:     settings.CaCrt,
+		CaKey:     settings.CaKey,
+		Name:      dvc.Name,
+		Ip:        nebulaIp.String(),
+		Subnets:   certSubnetsFor(dvc),
+		Groups:    dvc.Groups,
+		PublicKey: publicKey,
+	})
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error signing cert")
+	}
+	// Unaudited certs are not handed out
+	err = recordProvision(ctx, db, dvc, cert.Cert, publicKey != nil)
+	if err != nil {
+		return nil, err
+	}
+	err = recordHistory(ctx, db, dvc, publicKey != nil, client)
+	if err != nil {
+		// Only informational. Not worth failing the device over.
+		l.Log.Err(err).Str("device", dvc.Name).Msg("ProvisionDevice: error recording history")
+	}
+
+	res := &ProvisionDeviceResponse{
+		Name:            dvc.Name,
+		Ca:              string(settings.CaCrt),
+		Cert:            string(cert.Cert),
+		Key:             string(cert.Key),
+		AccessToken:     accessToken,
+		NetworkCipher:   settings.Cipher,
+		UnsafeRoutes:    unsafeRoutesFor(dvc, dvcs),
+		ExitNodes:       exitNodesFor(dvc, dvcs),
+		Relays:          relaysFor(dvc, dvcs, settings),
+		AmRelay:         dvc.Relay,
+		Lighthouses:     lighthousesFor(dvc, dvcs),
+		AmLighthouse:    dvc.Lighthouse,
+		PortEndpoint:    PortEndpoint(settings, ""),
+		FirewallInbound: firewallFor(settings),
+	}
+	outcome = metrics.ProvisionSuccess
+	return res, nil
+}
+
+// Client of the request transaction opened by entgql.Transactioner, so a
+// failed provisioning leaves no enrolled device or used token behind.
+func (r *Resolver) txClient(ctx context.Context) *ent.Client {
+	if client := ent.FromContext(ctx); client != nil {
+		return client
+	}
+	return r.client
+}
+
+// Audits the cert issued to dvc. Check-ins provision every hour, so certs
+// matching the last one audited for the device are only kept in its
+// provisioning history.
+func recordProvision(ctx context.Context, client *ent.Client, dvc *ent.Device, crt []byte, clientKey bool) error {
+	nc, _, err := pki.UnmarshalNebulaCertificateFromPEM(crt)
+	if err != nil {
+		return errutil.WrapErr(err, "error reading signed cert")
+	}
+	fingerprint, err := nc.Sha256Sum()
+	if err != nil {
+		return errutil.WrapErr(err, "error fingerprinting cert")
+	}
+	identity := certIdentity(nc)
+	// Devices can't read the audit log through the API
+	last, err := client.AuditEvent.Query().
+		Where(
+			auditevent.DeviceName(dvc.Name),
+			auditevent.DeviceID(dvc.ID),
+			auditevent.Action(audit.CertIssue),
+		).
+		Order(ent.Desc(auditevent.FieldCreatedTime)).
+		First(privacy.DecisionContext(ctx, privacy.Allow))
+	if err != nil && !ent.IsNotFound(err) {
+		return errutil.WrapErr(err, "error finding last issued cert")
+	}
+	if last != nil && last.Details["identity"] == identity {
+		return nil
+	}
+
+	err = audit.Record(ctx, client, &audit.Event{
+		Action:     audit.DeviceProvision,
+		Actor:      "device:" + dvc.Name,
+		DeviceID:   dvc.ID,
+		DeviceName: dvc.Name,
+	})
+	if err != nil {
+		return err
+	}
+	return audit.Record(ctx, client, &audit.Event{
+		Action:     audit.CertIssue,
+		Actor:      "device:" + dvc.Name,
+		DeviceID:   dvc.ID,
+		DeviceName: dvc.Name,
+		Details: map[string]string{
+			"fingerprint": fingerprint,
+			"identity":    identity,
+			"expires":     nc.Details.NotAfter.UTC().Format(time.RFC3339),
+			"client_key":  strconv.FormatBool(clientKey),
+		},
+	})
+}
+
+// Hash of everything in a cert but its validity period and signature.
+// Stays the same across check-ins until the cert's details, key or CA
+// change.
+func certIdentity(nc *pki.NebulaCertificate) string {
+	h := sha256.New()
+	fmt.Fprintln(h, nc.Details.Issuer, nc.Details.Name, nc.Details.IsCA)
+	for _, ip := range nc.Details.Ips {
+		fmt.Fprintln(h, "ip", ip)
+	}
+	for _, subnet := range nc.Details.Subnets {
+		fmt.Fprintln(h, "subnet", subnet)
+	}
+	for _, group := range nc.Details.Groups {
+		fmt.Fprintln(h, "group", group)
+	}
+	h.Write(nc.Details.PublicKey)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// Provisioning history rows kept per device. Check-ins add one every hour.
+const provisionHistoryLimit = 100
+
+// Adds a history row, keeping the newest provisionHistoryLimit, and updates
+// the device's last provision details.
+func recordHistory(ctx context.Context, client *ent.Client, dvc *ent.Device, clientKey bool, info *ClientInfoInput) error {
+	if info == nil {
+		info = &ClientInfoInput{}
+	}
+	remoteIP := audit.ActorFrom(ctx).RemoteAddr
+	if host, _, err := net.SplitHostPort(remoteIP); err == nil {
+		remoteIP = host
+	}
+	err := client.DeviceProvision.Create().
+		SetDeviceID(dvc.ID).
+		SetRemoteAddr(remoteIP).
+		SetClientVersion(info.Version).
+		SetOs(info.Os).
+		SetArch(info.Arch).
+		SetClientKey(clientKey).
+		Exec(ctx)
+	if err != nil {
+		return errutil.WrapErr(err, "error saving provision history")
+	}
+	old, err := client.DeviceProvision.Query().
+		Where(deviceprovision.DeviceID(dvc.ID)).
+		Order(ent.Desc(deviceprovision.FieldCreatedTime), ent.Desc(deviceprovision.FieldID)).
+		Offset(provisionHistoryLimit).
+		IDs(ctx)
+	if err != nil {
+		return errutil.WrapErr(err, "error finding old provision history")
+	}
+	if len(old) > 0 {
+		_, err = client.DeviceProvision.Delete().
+			Where(deviceprovision.IDIn(old...)).
+			Exec(ctx)
+		if err != nil {
+			return errutil.WrapErr(err, "error pruning provision history")
+		}
+	}
+	update := client.Device.UpdateOne(dvc).
+		SetLastProvisionTime(time.Now()).
+		SetClientVersion(info.Version).
+		SetOs(info.Os).
+		SetArch(info.Arch)
+	if remoteIP != "" {
+		update.SetUnderlayAddr(remoteIP)
+	}
+	return update.Exec(ctx)
+}
+
+// Failures are logged but never mask the provisioning error.
+func recordProvisionFailure(ctx context.Context, client *ent.Client, dvc *ent.Device, cause error) {
+	e := &audit.Event{
+		Action: audit.DeviceProvision,
+		Actor:  "device:unknown",
+		Err:    cause,
+	}
+	if dvc != nil {
+		e.Actor = "device:" + dvc.Name
+		e.DeviceID = dvc.ID
+		e.DeviceName = dvc.Name
+	}
+	err := audit.Record(ctx, client, e)
+	if err != nil {
+		l.Log.Err(err).Msg("ProvisionDevice: error recording failure")
+	}
+}
@@ -0,0 +1,183 @@
+package westport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/miekg/dns"
+	"github.com/sprisa/west/westport/db/ent"
+)
+
+const (
+	healthOK       = "ok"
+	healthError    = "error"
+	healthDisabled = "disabled"
+)
+
+const healthTimeout = 2 * time.Second
+
+type healthCheck struct {
+	Status  string     `json:"status"`
+	Error   string     `json:"error,omitempty"`
+	Expires *time.Time `json:"expires,omitempty"`
+}
+
+type healthResponse struct {
+	Status string                 `json:"status"`
+	Checks map[string]healthCheck `json:"checks"`
+}
+
+// Tracks the state of each west port component for /healthz and /readyz.
+type health struct {
+	client *ent.Client
+
+	mu     sync.Mutex
+	nebula bool
+	// Compass DNS address and zone to query. Empty when DNS is disabled.
+	dnsAddr string
+	dnsZone string
+	// HTTPS is configured. tlsExpiry is zero until a cert is loaded.
+	tlsEnabled bool
+	tlsExpiry  time.Time
+}
+
+func newHealth(client *ent.Client) *health {
+	return &health{client: client}
+}
+
+func (h *health) setNebula(running bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.nebula = running
+}
+
+func (h *health) setDNS(addr string, zone string) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	host, port, err := net.SplitHostPort(addr)
+	if err == nil {
+		// Query unspecified listeners over loopback
+		if ip := net.ParseIP(host); ip != nil && ip.IsUnspecified() {
+			addr = net.JoinHostPort("127.0.0.1", port)
+		}
+	}
+	h.dnsAddr = addr
+	h.dnsZone = zone
+}
+
+func (h *health) setTLSEnabled(enabled bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tlsEnabled = enabled
+}
+
+func (h *health) setTLSExpiry(expiry time.Time) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.tlsExpiry = expiry
+}
+
+// Liveness. Fails when the db is unreachable or nebula stopped.
+func (h *health) liveness(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, map[string]healthCheck{
+		"db":     h.checkDB(r.Context()),
+		"nebula": h.checkNebula(),
+	})
+}
+
+// Readiness. Also fails when DNS isn't answering or the TLS cert is missing
+// or expired.
+func (h *health) readiness(w http.ResponseWriter, r *http.Request) {
+	h.respond(w, map[string]healthCheck{
+		"db":     h.checkDB(r.Context()),
+		"nebula": h.checkNebula(),
+		"dns":    h.checkDNS(),
+		"tls":    h.checkTLS(),
+	})
+}
+
+func (h *health) respond(w http.ResponseWriter, checks map[string]healthCheck) {
+	res := healthResponse{Status: healthOK, Checks: checks}
+	code := http.StatusOK
+	for _, check := range checks {
+		if check.Status == healthError {
+			res.Status = healthError
+			code = http.StatusServiceUnavailable
+		}
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(res)
+}
+
+func (h *health) checkDB(ctx context.Context) healthCheck {
+	ctx, cancel := context.WithTimeout(ctx, healthTimeout)
+	defer cancel()
+	_, err := h.client.Settings.Query().Exist(ctx)
+	return checkErr(err)
+}
+
+func (h *health) checkNebula() healthCheck {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if !h.nebula {
+		return checkErr(errors.New("nebula not running"))
+	}
+	return healthCheck{Status: healthOK}
+}
+
+// Queries Compass DNS for the zone's own A record.
+func (h *health) checkDNS() healthCheck {
+	h.mu.Lock()
+	addr, zone := h.dnsAddr, h.dnsZone
+	h.mu.Unlock()
+	if zone == "" {
+		return healthCheck{Status: healthDisabled}
+	}
+	if addr == "" {
+		return checkErr(errors.New("dns not started"))
+	}
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(zone), dns.TypeA)
+	client := &dns.Client{Timeout: healthTimeout}
+	res, _, err := client.Exchange(msg, addr)
+	if err != nil {
+		return checkErr(err)
+	}
+	if res.Rcode != dns.RcodeSuccess {
+		return checkErr(fmt.Errorf("dns answered %s", dns.RcodeToString[res.Rcode]))
+	}
+	return healthCheck{Status: healthOK}
+}
+
+func (h *health) checkTLS() healthCheck {
+	h.mu.Lock()
+	enabled, expiry := h.tlsEnabled, h.tlsExpiry
+	h.mu.Unlock()
+	if !enabled {
+		return healthCheck{Status: healthDisabled}
+	}
+	if expiry.IsZero() {
+		return checkErr(errors.New("no tls certificate"))
+	}
+	check := healthCheck{Status: healthOK, Expires: &expiry}
+	if time.Now().After(expiry) {
+		check.Status = healthError
+		check.Error = "tls certificate expired"
+	}
+	return check
+}
+
+func checkErr(err error) healthCheck {
+	if err != nil {
+		return healthCheck{Status: healthError, Error: err.Error()}
+	}
+	return healthCheck{Status: healthOK}
+}
@@ -22,7 +22,7 @@ var InstallCommand = &cli.Command{
 	Name:      "install",
 	Usage:     "Install west port",
 	UsageText: "west port install",
-	Flags: []cli.Flag{
+	Flags: append([]cli.Flag{
 		&cli.StringFlag{
 			Name:  "ca-crt",
 			Value: "ca.crt",
@@ -50,7 +50,11 @@ var InstallCommand = &cli.Command{
 			Name:  "letsencrypt-accept-tos",
 			Usage: "Accept the letsencrypt terms of service. Required for automated HTTPS certificates",
 		},
-	},
+		&cli.BoolFlag{
+			Name:  "disable-relay",
+			Usage: "Don't relay traffic for devices that cannot connect directly",
+		},
+	}, networkFlags...),
 	Action: func(ctx context.Context, c *cli.Command) error {
 		caPath := c.String("ca-crt")
 		caKeyPath := c.String("ca-key")
@@ -130,7 +134,7 @@ var InstallCommand = &cli.Command{
 			return err
 		}
 
-		err = client.Settings.Create().
+		create := client.Settings.Create().
 			SetCaCrt(ca).
 			SetCaKey(caKey).
 			// TODO: Store info in a device so it get's all the
@@ -141,7 +145,9 @@ var InstallCommand = &cli.Command{
 			SetPortOverlayIP(overlayIp).
 			SetDomainZone(domainZone).
 			SetLetsencryptRegistration(acmeRegistration).
-			Exec(ctx)
+			SetPortRelay(c.Bool("disable-relay") == false)
+		setNetworkFlags(c, create.Mutation())
+		err = create.Exec(ctx)
 		if err != nil {
 			return errutil.WrapErr(err, "error saving settings")
 		}
@@ -0,0 +1,121 @@
+package westport
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	"github.com/urfave/cli/v3"
+)
+
+var ListCommand = &cli.Command{
+	Name:      "list",
+	Usage:     "List devices and when west port last saw them",
+	UsageText: "west port list [--stale 10m] [--only-stale]",
+	Flags: []cli.Flag{
+		&cli.DurationFlag{
+			Name:  "stale",
+			Value: 10 * time.Minute,
+			Usage: "Devices unseen by the lighthouse for this long are stale",
+		},
+		&cli.BoolFlag{
+			Name:  "only-stale",
+			Usage: "Only list stale devices",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		remote, err := remoteFor(c)
+		if err != nil {
+			return err
+		}
+		var dvcs []*ent.Device
+		if remote != nil {
+			dvcs, err = remote.Devices(ctx)
+		} else {
+			dvcs, err = listDevices(ctx)
+		}
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "NAME\tIP\tSTATUS\tLAST SEEN\tLAST PROVISIONED\tUNDERLAY\tVERSION\tPLATFORM")
+		for _, dvc := range dvcs {
+			status := deviceStatus(dvc, c.Duration("stale"))
+			if c.Bool("only-stale") && status == "online" {
+				continue
+			}
+			platform := "-"
+			if dvc.Os != "" {
+				platform = dvc.Os + "/" + dvc.Arch
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				dvc.Name,
+				dvc.IP.ToIpAddr(),
+				status,
+				ago(dvc.LastSeenTime),
+				ago(dvc.LastProvisionTime),
+				dash(dvc.UnderlayAddr),
+				dash(dvc.ClientVersion),
+				platform,
+			)
+		}
+		return w.Flush()
+	},
+}
+
+func listDevices(ctx context.Context) ([]*ent.Device, error) {
+	client, err := db.OpenDB()
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error opening db")
+	}
+	defer client.Close()
+	err = migrate.MigrateClient(ctx, client)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error migrating db")
+	}
+
+	// Tokens are left out, they can't be read without the password
+	dvcs, err := client.Device.Query().
+		Select(
+			device.FieldName,
+			device.FieldIP,
+			device.FieldClientVersion,
+			device.FieldOs,
+			device.FieldArch,
+			device.FieldUnderlayAddr,
+			device.FieldLastSeenTime,
+			device.FieldLastProvisionTime,
+		).
+		Order(ent.Asc(device.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error listing devices")
+	}
+	return dvcs, nil
+}
+
+// online, stale, or never for devices the lighthouse has not seen.
+func deviceStatus(dvc *ent.Device, stale time.Duration) string {
+	if dvc.LastSeenTime == nil {
+		return "never"
+	}
+	if time.Since(*dvc.LastSeenTime) > stale {
+		return "stale"
+	}
+	return "online"
+}
+
+func ago(t *time.Time) string {
+	if t == nil {
+		return "-"
+	}
+	return time.Since(*t).Round(time.Second).String() + " ago"
+}
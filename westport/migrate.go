@@ -0,0 +1,115 @@
+package westport
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+var MigrateCommand = &cli.Command{
+	Name:      "migrate",
+	Usage:     "Show or change the database schema version",
+	UsageText: "west port migrate status",
+	Commands: []*cli.Command{
+		MigrateStatusCommand,
+		MigrateUpCommand,
+		MigrateDownCommand,
+	},
+	Action: func(ctx context.Context, cmd *cli.Command) error {
+		return cli.ShowSubcommandHelp(cmd)
+	},
+}
+
+var MigrateStatusCommand = &cli.Command{
+	Name:      "status",
+	Usage:     "List applied and pending migrations",
+	UsageText: "west port migrate status",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		status, err := migrate.GetStatus(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		fmt.Fprintln(w, "VERSION\tNAME\tSTATUS\tAPPLIED")
+		for _, m := range status.Migrations {
+			state, applied := "pending", "-"
+			if r := status.Applied[m.Version]; r != nil {
+				state, applied = "applied", r.AppliedAt.Format(time.RFC3339)
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", m.Version, m.Name, state, applied)
+		}
+		for _, r := range status.Unknown() {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%s\n", r.Version, r.Name, "newer than binary", r.AppliedAt.Format(time.RFC3339))
+		}
+		return w.Flush()
+	},
+}
+
+var MigrateUpCommand = &cli.Command{
+	Name:      "up",
+	Usage:     "Apply pending migrations. Sqlite databases are backed up first.",
+	UsageText: "west port migrate up [--to version]",
+	Flags: []cli.Flag{
+		&cli.Int64Flag{
+			Name:  "to",
+			Usage: "Stop after this version",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		applied, err := migrate.Up(ctx, client, &migrate.UpOpts{To: c.Int64("to")})
+		if err != nil {
+			return err
+		}
+		if len(applied) == 0 {
+			l.Log.Info().Msg("Database is up to date")
+			return nil
+		}
+		l.Log.Info().Int("applied", len(applied)).Msg("Migrated")
+		return nil
+	},
+}
+
+var MigrateDownCommand = &cli.Command{
+	Name:      "down",
+	Usage:     "Revert the newest migrations. Sqlite databases are backed up first.",
+	UsageText: "west port migrate down [--steps 1]",
+	Flags: []cli.Flag{
+		&cli.IntFlag{
+			Name:  "steps",
+			Value: 1,
+			Usage: "Number of migrations to revert",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		reverted, err := migrate.Down(ctx, client, c.Int("steps"))
+		if err != nil {
+			return err
+		}
+		l.Log.Info().Int("reverted", len(reverted)).Msg("Migrated down")
+		return nil
+	},
+}
@@ -0,0 +1,176 @@
+package westport
+
+import (
+	"net/http"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/sprisa/west"
+)
+
+// OpenAPI 3 document of the REST API, built from the route table so it can't
+// drift from the handlers.
+func openAPIDoc(routes []*restRoute) map[string]any {
+	schemas := map[string]any{}
+	schemaOf(reflect.TypeFor[restError](), schemas)
+	paths := map[string]map[string]any{}
+	for _, route := range routes {
+		op := map[string]any{
+			"summary":     route.Summary,
+			"operationId": operationID(route),
+			"security":    []map[string][]string{{"adminToken": {}}},
+		}
+		params := []map[string]any{}
+		for _, m := range pathParam.FindAllStringSubmatch(route.Path, -1) {
+			params = append(params, map[string]any{
+				"name":     m[1],
+				"in":       "path",
+				"required": true,
+				"schema":   map[string]any{"type": "string"},
+			})
+		}
+		if len(params) > 0 {
+			op["parameters"] = params
+		}
+		if route.Request != nil {
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(schemaOf(reflect.TypeOf(route.Request), schemas)),
+			}
+		}
+		status := strconv.Itoa(route.Status)
+		responses := map[string]any{
+			"default": map[string]any{
+				"description": "Error",
+				"content":     jsonContent(ref("Error")),
+			},
+		}
+		if route.Response != nil {
+			responses[status] = map[string]any{
+				"description": http.StatusText(route.Status),
+				"content":     jsonContent(schemaOf(reflect.TypeOf(route.Response), schemas)),
+			}
+		} else {
+			responses[status] = map[string]any{
+				"description": http.StatusText(route.Status),
+			}
+		}
+		op["responses"] = responses
+
+		if paths[route.Path] == nil {
+			paths[route.Path] = map[string]any{}
+		}
+		paths[route.Path][strings.ToLower(route.Method)] = op
+	}
+
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "west port",
+			"version": west.Build,
+		},
+		"paths": paths,
+		"components": map[string]any{
+			"schemas": schemas,
+			"securitySchemes": map[string]any{
+				"adminToken": map[string]any{
+					"type":        "http",
+					"scheme":      "bearer",
+					"description": "Token from `west port admin-token`",
+				},
+			},
+		},
+	}
+}
+
+var pathParam = regexp.MustCompile(`\{(\w+)\}`)
+
+// e.g. GET /v1/dns-records/{name} is getDnsRecord
+func operationID(route *restRoute) string {
+	verb := map[string]string{
+		"GET":    "get",
+		"POST":   "create",
+		"PUT":    "replace",
+		"PATCH":  "update",
+		"DELETE": "delete",
+	}[route.Method]
+	parts := strings.Split(strings.TrimPrefix(route.Path, "/v1/"), "/")
+	name := ""
+	for _, word := range strings.Split(parts[0], "-") {
+		name += strings.ToUpper(word[:1]) + word[1:]
+	}
+	if len(parts) == 1 && route.Method == "GET" {
+		verb = "list"
+	} else {
+		name = strings.TrimSuffix(name, "s")
+	}
+	return verb + name
+}
+
+func ref(name string) map[string]any {
+	return map[string]any{"$ref": "#/components/schemas/" + name}
+}
+
+func jsonContent(schema map[string]any) map[string]any {
+	return map[string]any{
+		"application/json": map[string]any{"schema": schema},
+	}
+}
+
+var timeType = reflect.TypeFor[time.Time]()
+
+// JSON schema of t. Structs are added to schemas by name and referenced.
+func schemaOf(t reflect.Type, schemas map[string]any) map[string]any {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	switch {
+	case t == timeType:
+		return map[string]any{"type": "string", "format": "date-time"}
+	case t.Kind() == reflect.String:
+		return map[string]any{"type": "string"}
+	case t.Kind() == reflect.Bool:
+		return map[string]any{"type": "boolean"}
+	case t.Kind() == reflect.Int:
+		return map[string]any{"type": "integer"}
+	case t.Kind() == reflect.Slice:
+		return map[string]any{"type": "array", "items": schemaOf(t.Elem(), schemas)}
+	case t.Kind() != reflect.Struct:
+		panic("no openapi schema for " + t.String())
+	}
+
+	name := strings.TrimPrefix(t.Name(), "rest")
+	if _, ok := schemas[name]; ok {
+		return ref(name)
+	}
+	props := map[string]any{}
+	required := []string{}
+	for i := range t.NumField() {
+		f := t.Field(i)
+		tag, opts, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if tag == "" || tag == "-" {
+			continue
+		}
+		prop := schemaOf(f.Type, schemas)
+		omitempty := strings.Contains(opts, "omitempty")
+		if _, isRef := prop["$ref"]; f.Type.Kind() == reflect.Pointer && !isRef && !omitempty {
+			prop["nullable"] = true
+		}
+		props[tag] = prop
+		if !omitempty {
+			required = append(required, tag)
+		}
+	}
+	schema := map[string]any{
+		"type":       "object",
+		"properties": props,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	schemas[name] = schema
+	return ref(name)
+}
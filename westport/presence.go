@@ -0,0 +1,51 @@
+package westport
+
+import (
+	"context"
+	"time"
+
+	"github.com/sprisa/west"
+	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	l "github.com/sprisa/x/log"
+)
+
+// How often the lighthouse host map is checked for devices
+const presenceInterval = time.Minute
+
+// Records when devices last had a tunnel to the port's lighthouse and the
+// underlay address it saw them at. Runs until ctx is done.
+func trackPresence(ctx context.Context, client *ent.Client, ctrl *west.Control) {
+	ticker := time.NewTicker(presenceInterval)
+	defer ticker.Stop()
+	for {
+		recordPresence(ctx, client, ctrl.ListHostmapHosts(false))
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func recordPresence(ctx context.Context, client *ent.Client, hosts []west.ControlHostInfo) {
+	now := time.Now()
+	for _, h := range hosts {
+		ip, err := ipconv.FromIPAddr(h.VpnIp)
+		if err != nil {
+			continue
+		}
+		update := client.Device.Update().
+			Where(device.IP(ip)).
+			SetLastSeenTime(now)
+		// Relayed tunnels have no direct remote
+		if h.CurrentRemote.IsValid() {
+			update.SetUnderlayAddr(h.CurrentRemote.String())
+		}
+		err = update.Exec(ctx)
+		if err != nil && ctx.Err() == nil {
+			l.Log.Err(err).Str("ip", h.VpnIp.String()).Msg("error recording device presence")
+		}
+	}
+}
@@ -0,0 +1,358 @@
+package westport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+var LoginCommand = &cli.Command{
+	Name:      "login",
+	Usage:     "Run admin commands against a remote west port's API instead of the local database",
+	UsageText: "west port login https://west.mycompany.dev [--token <admin token>] [--insecure]",
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "token",
+			Usage: "Admin token from `west port admin-token`. Read from stdin or prompted for when left out.",
+		},
+		&cli.BoolFlag{
+			Name:  "insecure",
+			Usage: "Allow an http url. The admin token is sent in plain text.",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		rawURL := c.Args().First()
+		if rawURL == "" {
+			return errors.New("west port url required")
+		}
+		insecure := c.Bool("insecure")
+		apiURL, err := parseRemoteURL(rawURL, insecure)
+		if err != nil {
+			return err
+		}
+		token := c.String("token")
+		if token == "" {
+			token, err = readSecret("admin token:")
+			if err != nil {
+				return err
+			}
+		}
+
+		profile := &Profile{URL: apiURL, Token: token, Insecure: insecure}
+		// Checks the url and token before saving them
+		_, err = profile.Remote().Devices(ctx)
+		if err != nil {
+			return errutil.WrapErr(err, "error logging in to %s", apiURL)
+		}
+		err = SaveProfile(profile)
+		if err != nil {
+			return err
+		}
+		l.Log.Info().Msgf("Logged in to %s. Admin commands now use its API. Pass --local to use the local database.", apiURL)
+		return nil
+	},
+}
+
+var LogoutCommand = &cli.Command{
+	Name:      "logout",
+	Usage:     "Forget the remote west port from `west port login`",
+	UsageText: "west port logout",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		err := os.Remove(ProfilePath)
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return errutil.WrapErr(err, "error removing profile")
+		}
+		return nil
+	},
+}
+
+// Remote west port admin commands run against
+type Profile struct {
+	// Base url of the port's HTTP API, e.g. https://west.mycompany.dev
+	URL string `json:"url"`
+	// Admin token
+	Token string `json:"token"`
+	// URL may be http, from `west port login --insecure`
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// Set by WEST_PORT_PROFILE. Defaults to the user config dir.
+var ProfilePath = defaultProfilePath()
+
+func defaultProfilePath() string {
+	if path := os.Getenv("WEST_PORT_PROFILE"); path != "" {
+		return path
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(dir, "west", "port-profile.json")
+}
+
+// Returns the saved profile, or nil when not logged in.
+// WEST_PORT_URL and WEST_PORT_TOKEN take precedence over the saved profile,
+// WEST_PORT_INSECURE=true allows an http WEST_PORT_URL.
+func LoadProfile() (*Profile, error) {
+	if apiURL, token := os.Getenv("WEST_PORT_URL"), os.Getenv("WEST_PORT_TOKEN"); apiURL != "" && token != "" {
+		insecure := os.Getenv("WEST_PORT_INSECURE") == "true"
+		apiURL, err := parseRemoteURL(apiURL, insecure)
+		if err != nil {
+			return nil, err
+		}
+		return &Profile{URL: apiURL, Token: token, Insecure: insecure}, nil
+	}
+	if ProfilePath == "" {
+		return nil, nil
+	}
+	b, err := os.ReadFile(ProfilePath)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, errutil.WrapErr(err, "error reading profile")
+	}
+	profile := &Profile{}
+	err = json.Unmarshal(b, profile)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error parsing profile `%s`", ProfilePath)
+	}
+	// Guards against edited profiles
+	_, err = parseRemoteURL(profile.URL, profile.Insecure)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error parsing profile `%s`", ProfilePath)
+	}
+	return profile, nil
+}
+
+// Saves profile readable only by the current user, as it holds an admin token.
+func SaveProfile(profile *Profile) error {
+	if ProfilePath == "" {
+		return errors.New("no config dir for the profile. Set WEST_PORT_PROFILE.")
+	}
+	err := os.MkdirAll(filepath.Dir(ProfilePath), 0700)
+	if err != nil {
+		return errutil.WrapErr(err, "error creating profile dir")
+	}
+	b, err := json.MarshalIndent(profile, "", "  ")
+	if err != nil {
+		return err
+	}
+	err = os.WriteFile(ProfilePath, b, 0600)
+	if err != nil {
+		return errutil.WrapErr(err, "error saving profile")
+	}
+	return nil
+}
+
+// Accepts the port's base url or its /api endpoint. http urls send the
+// admin token in plain text and need insecure.
+func parseRemoteURL(rawURL string, insecure bool) (string, error) {
+	u, err := url.Parse(rawURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return "", fmt.Errorf("invalid west port url `%s`. Use e.g. https://west.mycompany.dev", rawURL)
+	}
+	if u.Scheme == "http" && !insecure {
+		return "", fmt.Errorf("west port url `%s` isn't https. Pass --insecure to send the admin token in plain text.", rawURL)
+	}
+	u.Path = strings.TrimSuffix(strings.TrimSuffix(u.Path, "/"), "/api")
+	u.RawQuery = ""
+	u.Fragment = ""
+	return u.String(), nil
+}
+
+// Remote admin client for commands, or nil to use the local database.
+// --local and --database-url always use the database.
+func remoteFor(c *cli.Command) (*Remote, error) {
+	if c.Bool("local") || db.DatabaseURL != "" {
+		return nil, nil
+	}
+	profile, err := LoadProfile()
+	if err != nil || profile == nil {
+		return nil, err
+	}
+	return profile.Remote(), nil
+}
+
+// Before hook of commands that only run against the local database. Fails
+// when logged in, rather than quietly using a local database.
+func localOnly(ctx context.Context, c *cli.Command) (context.Context, error) {
+	remote, err := remoteFor(c)
+	if err != nil {
+		return ctx, err
+	}
+	if remote != nil {
+		return ctx, fmt.Errorf("`%s` can't run against the remote west port at %s. Run it on the port, or pass --local to use the local database.", c.FullName(), remote.url)
+	}
+	return ctx, nil
+}
+
+func (p *Profile) Remote() *Remote {
+	return &Remote{
+		url:    p.URL,
+		token:  p.Token,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+// Runs admin commands with a port's REST API
+type Remote struct {
+	url    string
+	token  string
+	client *http.Client
+}
+
+func (r *Remote) do(ctx context.Context, method string, path string, body any, out any) error {
+	var reqBody io.Reader
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewReader(b)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, r.url+path, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+r.token)
+	req.Header.Set("Content-Type", "application/json")
+	res, err := r.client.Do(req)
+	if err != nil {
+		return errutil.WrapErr(err, "error calling west port at %s", r.url)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode >= 400 {
+		b, _ := io.ReadAll(io.LimitReader(res.Body, 4096))
+		resErr := &restError{}
+		err = json.Unmarshal(b, resErr)
+		if err == nil && resErr.Error != "" {
+			return &apiError{res.StatusCode, errors.New(resErr.Error)}
+		}
+		// Auth errors are plain text
+		if res.StatusCode == http.StatusUnauthorized {
+			return &apiError{res.StatusCode, errors.New(strings.TrimSpace(string(b)))}
+		}
+		return &apiError{res.StatusCode, fmt.Errorf("west port returned %s. Is %s a west port?", res.Status, r.url)}
+	}
+	if out == nil {
+		return nil
+	}
+	err = json.NewDecoder(res.Body).Decode(out)
+	if err != nil {
+		return errutil.WrapErr(err, "error reading west port response")
+	}
+	return nil
+}
+
+// Lists devices without their tokens.
+func (r *Remote) Devices(ctx context.Context) ([]*ent.Device, error) {
+	res := []*restDevice{}
+	err := r.do(ctx, http.MethodGet, "/v1/devices", nil, &res)
+	if err != nil {
+		return nil, err
+	}
+	dvcs := []*ent.Device{}
+	for _, d := range res {
+		addr, err := netip.ParseAddr(d.IP)
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error parsing ip of device `%s`", d.Name)
+		}
+		ip, err := ipconv.FromIPAddr(addr)
+		if err != nil {
+			return nil, err
+		}
+		dvcs = append(dvcs, &ent.Device{
+			ID:                d.ID,
+			Name:              d.Name,
+			IP:                ip,
+			Groups:            d.Groups,
+			AdvertiseRoutes:   d.AdvertiseRoutes,
+			ExitNode:          d.ExitNode,
+			Relay:             d.Relay,
+			Lighthouse:        d.Lighthouse,
+			PublicEndpoint:    d.PublicEndpoint,
+			ClientVersion:     d.ClientVersion,
+			Os:                d.Os,
+			Arch:              d.Arch,
+			UnderlayAddr:      d.UnderlayAddr,
+			LastSeenTime:      d.LastSeenTime,
+			LastProvisionTime: d.LastProvisionTime,
+			CreatedTime:       d.CreatedTime,
+		})
+	}
+	return dvcs, nil
+}
+
+// Remote AddDevice. Returns the device's token.
+func (r *Remote) AddDevice(ctx context.Context, opts *AddDeviceOpts) (string, error) {
+	req := &restDeviceCreate{
+		Name:            opts.Name,
+		IP:              opts.IP.String(),
+		Groups:          opts.Groups,
+		AdvertiseRoutes: opts.AdvertiseRoutes,
+		ExitNode:        opts.ExitNode,
+		Relay:           opts.Relay,
+		Lighthouse:      opts.Lighthouse,
+		PublicEndpoint:  opts.PublicEndpoint,
+		Once:            opts.SingleUse,
+	}
+	if opts.Expires > 0 {
+		req.Expires = opts.Expires.String()
+	}
+	res := &restDeviceCreated{}
+	err := r.do(ctx, http.MethodPost, "/v1/devices", req, res)
+	if err != nil {
+		return "", err
+	}
+	return res.Token, nil
+}
+
+// Remote IssueToken
+func (r *Remote) IssueToken(ctx context.Context, name string, opts *IssueTokenOpts) (string, error) {
+	req := &restTokenIssue{
+		Device: name,
+		Once:   opts.SingleUse,
+	}
+	if opts.Expires > 0 {
+		req.Expires = opts.Expires.String()
+	}
+	res := &restToken{}
+	err := r.do(ctx, http.MethodPost, "/v1/tokens", req, res)
+	if err != nil {
+		return "", err
+	}
+	return res.Token, nil
+}
+
+// Remote RevokeToken
+func (r *Remote) RevokeToken(ctx context.Context, name string) error {
+	token := &restToken{}
+	err := r.do(ctx, http.MethodGet, "/v1/tokens/"+url.PathEscape(name), nil, token)
+	if err != nil {
+		return err
+	}
+	// Matches the local command, the API doesn't fail on a second revoke
+	if token.Revoked {
+		return fmt.Errorf("token of `%s` is already revoked", name)
+	}
+	return r.do(ctx, http.MethodDelete, "/v1/tokens/"+url.PathEscape(name), nil, nil)
+}
@@ -0,0 +1,747 @@
+package westport
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/netip"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
+	l "github.com/sprisa/x/log"
+)
+
+// JSON REST API for ops tooling, e.g. Terraform providers. Every route
+// requires an admin token. Served under /v1 next to the GraphQL API.
+type restAPI struct {
+	client *ent.Client
+}
+
+type restRoute struct {
+	Method  string
+	Path    string
+	Summary string
+	// Body type, nil for routes without one
+	Request any
+	// Success body type, nil for 204 responses
+	Response any
+	Status   int
+	handle   func(r *http.Request) (any, error)
+}
+
+type restDevice struct {
+	ID   int    `json:"id"`
+	Name string `json:"name"`
+	IP   string `json:"ip"`
+	// Nebula groups signed into the device cert
+	Groups []string `json:"groups"`
+	// LAN cidrs the device routes for
+	AdvertiseRoutes []string `json:"advertise_routes"`
+	ExitNode        bool     `json:"exit_node"`
+	Relay           bool     `json:"relay"`
+	Lighthouse      bool     `json:"lighthouse"`
+	PublicEndpoint  string   `json:"public_endpoint"`
+	ClientVersion   string   `json:"client_version"`
+	Os              string   `json:"os"`
+	Arch            string   `json:"arch"`
+	// Last known underlay host:port
+	UnderlayAddr      string     `json:"underlay_addr"`
+	LastSeenTime      *time.Time `json:"last_seen_time"`
+	LastProvisionTime *time.Time `json:"last_provision_time"`
+	CreatedTime       time.Time  `json:"created_time"`
+}
+
+type restDeviceCreate struct {
+	Name            string   `json:"name"`
+	IP              string   `json:"ip"`
+	Groups          []string `json:"groups,omitempty"`
+	AdvertiseRoutes []string `json:"advertise_routes,omitempty"`
+	ExitNode        bool     `json:"exit_node,omitempty"`
+	Relay           bool     `json:"relay,omitempty"`
+	Lighthouse      bool     `json:"lighthouse,omitempty"`
+	PublicEndpoint  string   `json:"public_endpoint,omitempty"`
+	// Token lifetime, e.g. 24h. Defaults to a year.
+	Expires string `json:"expires,omitempty"`
+	// Token can only be used once
+	Once bool `json:"once,omitempty"`
+}
+
+type restDeviceCreated struct {
+	Device *restDevice `json:"device"`
+	// Provisioning token for `west start`
+	Token string `json:"token"`
+}
+
+// Fields left out are unchanged
+type restDeviceUpdate struct {
+	Groups          *[]string `json:"groups,omitempty"`
+	AdvertiseRoutes *[]string `json:"advertise_routes,omitempty"`
+	ExitNode        *bool     `json:"exit_node,omitempty"`
+	Relay           *bool     `json:"relay,omitempty"`
+	Lighthouse      *bool     `json:"lighthouse,omitempty"`
+	PublicEndpoint  *string   `json:"public_endpoint,omitempty"`
+}
+
+type restGroup struct {
+	Name string `json:"name"`
+	// Names of devices in the group
+	Devices []string `json:"devices"`
+}
+
+type restDNSRecord struct {
+	ID int `json:"id"`
+	// Name within the domain zone
+	Name string `json:"name"`
+	// A, CNAME or TXT
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type restDNSRecordWrite struct {
+	// Ignored when updating, the path names the record
+	Name string `json:"name,omitempty"`
+	// A, CNAME or TXT
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+type restTokenIssue struct {
+	Device string `json:"device"`
+	// Token lifetime, e.g. 24h. Defaults to a year.
+	Expires string `json:"expires,omitempty"`
+	// Token can only be used once
+	Once bool `json:"once,omitempty"`
+}
+
+type restToken struct {
+	Device string `json:"device"`
+	// Only returned when the token is issued
+	Token string `json:"token,omitempty"`
+	// JWT ID. Empty for tokens issued before token IDs.
+	Jti         string     `json:"jti"`
+	ExpiresTime *time.Time `json:"expires_time"`
+	Once        bool       `json:"once"`
+	Revoked     bool       `json:"revoked"`
+}
+
+type restError struct {
+	Error string `json:"error"`
+}
+
+// Error with the status it is returned with
+type apiError struct {
+	status int
+	err    error
+}
+
+func (e *apiError) Error() string { return e.err.Error() }
+func (e *apiError) Unwrap() error { return e.err }
+
+func badRequest(format string, a ...any) error {
+	return &apiError{http.StatusBadRequest, fmt.Errorf(format, a...)}
+}
+
+func notFound(format string, a ...any) error {
+	return &apiError{http.StatusNotFound, fmt.Errorf(format, a...)}
+}
+
+func newRESTHandler(client *ent.Client) http.Handler {
+	api := &restAPI{client: client}
+	mux := http.NewServeMux()
+	routes := api.routes()
+	for _, route := range routes {
+		mux.HandleFunc(route.Method+" "+route.Path, api.serve(route))
+	}
+	doc := openAPIDoc(routes)
+	mux.HandleFunc("GET /v1/openapi.json", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusOK, doc)
+	})
+	return mux
+}
+
+func (api *restAPI) routes() []*restRoute {
+	return []*restRoute{
+		{Method: "GET", Path: "/v1/devices", Summary: "List devices", Response: []restDevice{}, Status: http.StatusOK, handle: api.listDevices},
+		{Method: "POST", Path: "/v1/devices", Summary: "Register a device and return its token", Request: restDeviceCreate{}, Response: restDeviceCreated{}, Status: http.StatusCreated, handle: api.createDevice},
+		{Method: "GET", Path: "/v1/devices/{name}", Summary: "Get a device", Response: restDevice{}, Status: http.StatusOK, handle: api.getDevice},
+		{Method: "PATCH", Path: "/v1/devices/{name}", Summary: "Update a device. Devices pick up changes when they next run `west start`.", Request: restDeviceUpdate{}, Response: restDevice{}, Status: http.StatusOK, handle: api.updateDevice},
+		{Method: "DELETE", Path: "/v1/devices/{name}", Summary: "Delete a device", Status: http.StatusNoContent, handle: api.deleteDevice},
+		{Method: "GET", Path: "/v1/groups", Summary: "List groups and their devices. Groups are set on devices.", Response: []restGroup{}, Status: http.StatusOK, handle: api.listGroups},
+		{Method: "GET", Path: "/v1/groups/{name}", Summary: "Get a group", Response: restGroup{}, Status: http.StatusOK, handle: api.getGroup},
+		{Method: "GET", Path: "/v1/dns-records", Summary: "List DNS records", Response: []restDNSRecord{}, Status: http.StatusOK, handle: api.listDNSRecords},
+		{Method: "POST", Path: "/v1/dns-records", Summary: "Create a DNS record", Request: restDNSRecordWrite{}, Response: restDNSRecord{}, Status: http.StatusCreated, handle: api.createDNSRecord},
+		{Method: "GET", Path: "/v1/dns-records/{name}", Summary: "Get a DNS record", Response: restDNSRecord{}, Status: http.StatusOK, handle: api.getDNSRecord},
+		{Method: "PUT", Path: "/v1/dns-records/{name}", Summary: "Replace a DNS record", Request: restDNSRecordWrite{}, Response: restDNSRecord{}, Status: http.StatusOK, handle: api.updateDNSRecord},
+		{Method: "DELETE", Path: "/v1/dns-records/{name}", Summary: "Delete a DNS record", Status: http.StatusNoContent, handle: api.deleteDNSRecord},
+		{Method: "GET", Path: "/v1/tokens", Summary: "List the current token of every device", Response: []restToken{}, Status: http.StatusOK, handle: api.listTokens},
+		{Method: "POST", Path: "/v1/tokens", Summary: "Issue a new token for a device, revoking its previous one", Request: restTokenIssue{}, Response: restToken{}, Status: http.StatusCreated, handle: api.issueToken},
+		{Method: "GET", Path: "/v1/tokens/{device}", Summary: "Get a device's current token, without the secret", Response: restToken{}, Status: http.StatusOK, handle: api.getToken},
+		{Method: "DELETE", Path: "/v1/tokens/{device}", Summary: "Revoke a device's current token", Status: http.StatusNoContent, handle: api.revokeToken},
+	}
+}
+
+func (api *restAPI) serve(route *restRoute) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !audit.ActorFrom(r.Context()).Admin {
+			writeJSON(w, http.StatusUnauthorized, &restError{Error: "admin token required"})
+			return
+		}
+		res, err := route.handle(r)
+		if err != nil {
+			status := http.StatusInternalServerError
+			var apiErr *apiError
+			switch {
+			case errors.As(err, &apiErr):
+				status = apiErr.status
+			case ent.IsNotFound(err):
+				status = http.StatusNotFound
+			case ent.IsConstraintError(err):
+				status = http.StatusConflict
+			case ent.IsValidationError(err):
+				status = http.StatusBadRequest
+			}
+			if status == http.StatusInternalServerError {
+				l.Log.Err(err).Str("path", r.URL.Path).Msg("REST API error")
+			}
+			writeJSON(w, status, &restError{Error: err.Error()})
+			return
+		}
+		if route.Status == http.StatusNoContent {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		writeJSON(w, route.Status, res)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func readJSON(r *http.Request, v any) error {
+	dec := json.NewDecoder(r.Body)
+	dec.DisallowUnknownFields()
+	err := dec.Decode(v)
+	if err != nil {
+		return badRequest("invalid request body: %s", err)
+	}
+	return nil
+}
+
+// Device fields returned by the API. Tokens are read separately.
+var restDeviceFields = []string{
+	device.FieldName,
+	device.FieldIP,
+	device.FieldGroups,
+	device.FieldAdvertiseRoutes,
+	device.FieldExitNode,
+	device.FieldRelay,
+	device.FieldLighthouse,
+	device.FieldPublicEndpoint,
+	device.FieldClientVersion,
+	device.FieldOs,
+	device.FieldArch,
+	device.FieldUnderlayAddr,
+	device.FieldLastSeenTime,
+	device.FieldLastProvisionTime,
+	device.FieldCreatedTime,
+}
+
+func toRESTDevice(dvc *ent.Device) *restDevice {
+	return &restDevice{
+		ID:                dvc.ID,
+		Name:              dvc.Name,
+		IP:                dvc.IP.ToIpAddr().String(),
+		Groups:            nonNil(dvc.Groups),
+		AdvertiseRoutes:   nonNil(dvc.AdvertiseRoutes),
+		ExitNode:          dvc.ExitNode,
+		Relay:             dvc.Relay,
+		Lighthouse:        dvc.Lighthouse,
+		PublicEndpoint:    dvc.PublicEndpoint,
+		ClientVersion:     dvc.ClientVersion,
+		Os:                dvc.Os,
+		Arch:              dvc.Arch,
+		UnderlayAddr:      dvc.UnderlayAddr,
+		LastSeenTime:      dvc.LastSeenTime,
+		LastProvisionTime: dvc.LastProvisionTime,
+		CreatedTime:       dvc.CreatedTime,
+	}
+}
+
+func (api *restAPI) device(ctx context.Context, name string) (*ent.Device, error) {
+	dvc, err := api.client.Device.Query().
+		Where(device.Name(name)).
+		Select(restDeviceFields...).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, notFound("device `%s` not found", name)
+	}
+	return dvc, err
+}
+
+func (api *restAPI) listDevices(r *http.Request) (any, error) {
+	dvcs, err := api.client.Device.Query().
+		Select(restDeviceFields...).
+		Order(ent.Asc(device.FieldName)).
+		All(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	res := []*restDevice{}
+	for _, dvc := range dvcs {
+		res = append(res, toRESTDevice(dvc))
+	}
+	return res, nil
+}
+
+func (api *restAPI) getDevice(r *http.Request) (any, error) {
+	dvc, err := api.device(r.Context(), r.PathValue("name"))
+	if err != nil {
+		return nil, err
+	}
+	return toRESTDevice(dvc), nil
+}
+
+func (api *restAPI) createDevice(r *http.Request) (any, error) {
+	ctx := r.Context()
+	req := &restDeviceCreate{}
+	err := readJSON(r, req)
+	if err != nil {
+		return nil, err
+	}
+	if req.Name == "" {
+		return nil, badRequest("name required")
+	}
+	ip, err := netip.ParseAddr(req.IP)
+	if err != nil {
+		return nil, badRequest("invalid ip `%s`", req.IP)
+	}
+	expires, err := parseExpires(req.Expires)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := api.client.Settings.Query().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := AddDevice(ctx, api.client, settings, &AddDeviceOpts{
+		Name:            req.Name,
+		IP:              ip,
+		AdvertiseRoutes: req.AdvertiseRoutes,
+		ExitNode:        req.ExitNode,
+		Relay:           req.Relay,
+		Lighthouse:      req.Lighthouse,
+		PublicEndpoint:  req.PublicEndpoint,
+		Groups:          req.Groups,
+		Expires:         expires,
+		SingleUse:       req.Once,
+	})
+	if err != nil {
+		var invalid *invalidDeviceError
+		switch {
+		case errors.As(err, &invalid):
+			return nil, &apiError{http.StatusBadRequest, err}
+		case ent.IsConstraintError(err):
+			return nil, &apiError{http.StatusConflict, fmt.Errorf("device name `%s` or ip `%s` is taken", req.Name, ip)}
+		}
+		// Validation errors are answered with 400 by serve, the rest with 500
+		return nil, err
+	}
+	dvc, err := api.device(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	return &restDeviceCreated{Device: toRESTDevice(dvc), Token: token}, nil
+}
+
+func (api *restAPI) updateDevice(r *http.Request) (any, error) {
+	ctx := r.Context()
+	req := &restDeviceUpdate{}
+	err := readJSON(r, req)
+	if err != nil {
+		return nil, err
+	}
+	dvc, err := api.device(ctx, r.PathValue("name"))
+	if err != nil {
+		return nil, err
+	}
+	settings, err := api.client.Settings.Query().
+		Select(specSettingsFields...).
+		Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	update := api.client.Device.UpdateOne(dvc)
+	if req.Groups != nil {
+		update.SetGroups(*req.Groups)
+	}
+	if req.AdvertiseRoutes != nil {
+		routes := []string{}
+		for _, s := range *req.AdvertiseRoutes {
+			route, err := parseRoute(s)
+			if err != nil {
+				return nil, &apiError{http.StatusBadRequest, err}
+			}
+			if route.Overlaps(settings.Cidr.Prefix) {
+				return nil, badRequest("route `%s` must not overlap network cidr `%s`", route, settings.Cidr)
+			}
+			routes = append(routes, route.String())
+		}
+		update.SetAdvertiseRoutes(routes)
+	}
+	if req.ExitNode != nil {
+		update.SetExitNode(*req.ExitNode)
+	}
+	if req.Relay != nil {
+		update.SetRelay(*req.Relay)
+	}
+	endpoint := dvc.PublicEndpoint
+	if req.PublicEndpoint != nil {
+		endpoint = *req.PublicEndpoint
+		if endpoint != "" {
+			_, err = parseEndpoint(endpoint)
+			if err != nil {
+				return nil, &apiError{http.StatusBadRequest, err}
+			}
+		}
+		update.SetPublicEndpoint(endpoint)
+	}
+	lighthouse := dvc.Lighthouse
+	if req.Lighthouse != nil {
+		lighthouse = *req.Lighthouse
+		update.SetLighthouse(lighthouse)
+	}
+	if lighthouse && endpoint == "" {
+		return nil, badRequest("lighthouse requires a public endpoint")
+	}
+	err = update.Exec(ctx)
+	if err != nil {
+		return nil, err
+	}
+	dvc, err = api.device(ctx, dvc.Name)
+	if err != nil {
+		return nil, err
+	}
+	return toRESTDevice(dvc), nil
+}
+
+func (api *restAPI) deleteDevice(r *http.Request) (any, error) {
+	name := r.PathValue("name")
+	n, err := api.client.Device.Delete().
+		Where(device.Name(name)).
+		Exec(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, notFound("device `%s` not found", name)
+	}
+	return nil, nil
+}
+
+func (api *restAPI) groups(ctx context.Context) ([]*restGroup, error) {
+	dvcs, err := api.client.Device.Query().
+		Select(device.FieldName, device.FieldGroups).
+		Order(ent.Asc(device.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	members := map[string][]string{}
+	for _, dvc := range dvcs {
+		for _, group := range dvc.Groups {
+			members[group] = append(members[group], dvc.Name)
+		}
+	}
+	groups := []*restGroup{}
+	for name, dvcs := range members {
+		groups = append(groups, &restGroup{Name: name, Devices: dvcs})
+	}
+	slices.SortFunc(groups, func(a, b *restGroup) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return groups, nil
+}
+
+func (api *restAPI) listGroups(r *http.Request) (any, error) {
+	return api.groups(r.Context())
+}
+
+func (api *restAPI) getGroup(r *http.Request) (any, error) {
+	groups, err := api.groups(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	name := r.PathValue("name")
+	for _, group := range groups {
+		if group.Name == name {
+			return group, nil
+		}
+	}
+	return nil, notFound("no device is in group `%s`", name)
+}
+
+func toRESTDNSRecord(rec *ent.DNSRecord) *restDNSRecord {
+	return &restDNSRecord{
+		ID:    rec.ID,
+		Name:  rec.Name,
+		Type:  string(rec.Type),
+		Value: rec.Value,
+	}
+}
+
+func (api *restAPI) listDNSRecords(r *http.Request) (any, error) {
+	records, err := api.client.DNSRecord.Query().
+		Order(ent.Asc(dnsrecord.FieldName)).
+		All(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	res := []*restDNSRecord{}
+	for _, rec := range records {
+		res = append(res, toRESTDNSRecord(rec))
+	}
+	return res, nil
+}
+
+func (api *restAPI) dnsRecord(ctx context.Context, name string) (*ent.DNSRecord, error) {
+	rec, err := api.client.DNSRecord.Query().
+		Where(dnsrecord.Name(name)).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, notFound("dns record `%s` not found", name)
+	}
+	return rec, err
+}
+
+func (api *restAPI) getDNSRecord(r *http.Request) (any, error) {
+	rec, err := api.dnsRecord(r.Context(), r.PathValue("name"))
+	if err != nil {
+		return nil, err
+	}
+	return toRESTDNSRecord(rec), nil
+}
+
+// Validates a DNS record and checks it doesn't shadow a device.
+func (api *restAPI) checkDNSRecord(ctx context.Context, req *restDNSRecordWrite) error {
+	err := validateDNSRecord(&DNSRecordSpec{Name: req.Name, Type: req.Type, Value: req.Value})
+	if err != nil {
+		return &apiError{http.StatusBadRequest, err}
+	}
+	taken, err := api.client.Device.Query().
+		Where(device.Name(req.Name)).
+		Exist(ctx)
+	if err != nil {
+		return err
+	}
+	if taken {
+		return &apiError{http.StatusConflict, fmt.Errorf("dns record `%s` has the name of a device", req.Name)}
+	}
+	return nil
+}
+
+func (api *restAPI) createDNSRecord(r *http.Request) (any, error) {
+	ctx := r.Context()
+	req := &restDNSRecordWrite{}
+	err := readJSON(r, req)
+	if err != nil {
+		return nil, err
+	}
+	err = api.checkDNSRecord(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	rec, err := api.client.DNSRecord.Create().
+		SetName(req.Name).
+		SetType(dnsrecord.Type(req.Type)).
+		SetValue(req.Value).
+		Save(ctx)
+	if ent.IsConstraintError(err) {
+		return nil, &apiError{http.StatusConflict, fmt.Errorf("dns record `%s` already exists", req.Name)}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toRESTDNSRecord(rec), nil
+}
+
+func (api *restAPI) updateDNSRecord(r *http.Request) (any, error) {
+	ctx := r.Context()
+	req := &restDNSRecordWrite{}
+	err := readJSON(r, req)
+	if err != nil {
+		return nil, err
+	}
+	req.Name = r.PathValue("name")
+	rec, err := api.dnsRecord(ctx, req.Name)
+	if err != nil {
+		return nil, err
+	}
+	err = api.checkDNSRecord(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	rec, err = rec.Update().
+		SetType(dnsrecord.Type(req.Type)).
+		SetValue(req.Value).
+		Save(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return toRESTDNSRecord(rec), nil
+}
+
+func (api *restAPI) deleteDNSRecord(r *http.Request) (any, error) {
+	name := r.PathValue("name")
+	n, err := api.client.DNSRecord.Delete().
+		Where(dnsrecord.Name(name)).
+		Exec(r.Context())
+	if err != nil {
+		return nil, err
+	}
+	if n == 0 {
+		return nil, notFound("dns record `%s` not found", name)
+	}
+	return nil, nil
+}
+
+// Metadata of the device's current token.
+func (api *restAPI) tokenInfo(ctx context.Context, dvc *ent.Device) (*restToken, error) {
+	claims := currentClaims(dvc)
+	res := &restToken{
+		Device: dvc.Name,
+		Jti:    claims.ID,
+		Once:   dvc.SingleUseToken,
+	}
+	if claims.ExpiresAt != nil {
+		res.ExpiresTime = &claims.ExpiresAt.Time
+	}
+	if claims.ID != "" {
+		revoked, err := api.client.RevokedToken.Query().
+			Where(revokedtoken.Jti(claims.ID)).
+			Exist(ctx)
+		if err != nil {
+			return nil, err
+		}
+		res.Revoked = revoked
+	}
+	return res, nil
+}
+
+func (api *restAPI) listTokens(r *http.Request) (any, error) {
+	ctx := r.Context()
+	dvcs, err := api.client.Device.Query().
+		Order(ent.Asc(device.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res := []*restToken{}
+	for _, dvc := range dvcs {
+		token, err := api.tokenInfo(ctx, dvc)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, token)
+	}
+	return res, nil
+}
+
+func (api *restAPI) getToken(r *http.Request) (any, error) {
+	ctx := r.Context()
+	dvc, err := findDevice(ctx, api.client, r.PathValue("device"))
+	if err != nil {
+		return nil, &apiError{http.StatusNotFound, err}
+	}
+	return api.tokenInfo(ctx, dvc)
+}
+
+func (api *restAPI) issueToken(r *http.Request) (any, error) {
+	ctx := r.Context()
+	req := &restTokenIssue{}
+	err := readJSON(r, req)
+	if err != nil {
+		return nil, err
+	}
+	expires, err := parseExpires(req.Expires)
+	if err != nil {
+		return nil, err
+	}
+	_, err = findDevice(ctx, api.client, req.Device)
+	if err != nil {
+		return nil, &apiError{http.StatusNotFound, err}
+	}
+	settings, err := api.client.Settings.Query().Only(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := IssueToken(ctx, api.client, settings, req.Device, &IssueTokenOpts{
+		Expires:   expires,
+		SingleUse: req.Once,
+	})
+	if err != nil {
+		return nil, err
+	}
+	dvc, err := findDevice(ctx, api.client, req.Device)
+	if err != nil {
+		return nil, err
+	}
+	res, err := api.tokenInfo(ctx, dvc)
+	if err != nil {
+		return nil, err
+	}
+	res.Token = token
+	return res, nil
+}
+
+func (api *restAPI) revokeToken(r *http.Request) (any, error) {
+	ctx := r.Context()
+	dvc, err := findDevice(ctx, api.client, r.PathValue("device"))
+	if err != nil {
+		return nil, &apiError{http.StatusNotFound, err}
+	}
+	info, err := api.tokenInfo(ctx, dvc)
+	if err != nil {
+		return nil, err
+	}
+	// Deleting twice is not an error
+	if info.Revoked {
+		return nil, nil
+	}
+	err = RevokeToken(ctx, api.client, dvc.Name)
+	if err != nil {
+		return nil, &apiError{http.StatusBadRequest, err}
+	}
+	return nil, nil
+}
+
+// Parses an optional token lifetime. Zero uses the default.
+func parseExpires(s string) (time.Duration, error) {
+	if s == "" {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, badRequest("invalid expires `%s`. Use a duration like 24h", s)
+	}
+	return d, nil
+}
+
+// Lists are returned as [] rather than null
+func nonNil(s []string) []string {
+	if s == nil {
+		return []string{}
+	}
+	return s
+}
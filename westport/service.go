@@ -0,0 +1,88 @@
+package westport
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"entgo.io/ent/dialect"
+	"github.com/sprisa/west/util/service"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/x/errutil"
+	"github.com/urfave/cli/v3"
+)
+
+const serviceName = "west-port"
+
+var ServiceCommand = &cli.Command{
+	Name:      "service",
+	Usage:     "Run west port as a systemd service",
+	UsageText: "west port service install",
+	Commands: append([]*cli.Command{
+		ServiceInstallCommand,
+	}, service.Commands(serviceName)...),
+	Action: func(ctx context.Context, cmd *cli.Command) error {
+		return cli.ShowSubcommandHelp(cmd)
+	},
+}
+
+var ServiceInstallCommand = &cli.Command{
+	Name:      "install",
+	Usage:     "Install and start the west port systemd service. Linux only.",
+	UsageText: "west port service install [-- west port start flags]",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		d, err := db.Dialect()
+		if err != nil {
+			return err
+		}
+		// The sqlite database is opened relative to the working directory
+		workDir, err := os.Getwd()
+		if err != nil {
+			return err
+		}
+		if d == dialect.SQLite {
+			dbPath, err := filepath.Abs(db.DBFilePath)
+			if err != nil {
+				return err
+			}
+			if _, err := os.Stat(dbPath); os.IsNotExist(err) {
+				return fmt.Errorf("no west port database at `%s`. Run from the directory of `west port install`.", dbPath)
+			}
+			workDir = filepath.Dir(dbPath)
+		}
+
+		pswd, err := readPassword()
+		if err != nil {
+			return err
+		}
+		// Catch a wrong password now rather than in a restart loop
+		copy(helpers.EncryptionKey[:], pswd)
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		_, err = client.Settings.Query().Only(ctx)
+		client.Close()
+		if err != nil {
+			return errutil.WrapErr(err, "error reading settings. Is the password correct?")
+		}
+
+		creds := []service.Credential{
+			{Name: passwordCredential, Secret: []byte(pswd)},
+		}
+		if db.DatabaseURL != "" {
+			creds = append(creds, service.Credential{Name: databaseURLCredential, Secret: []byte(db.DatabaseURL)})
+		}
+
+		return service.Install(&service.Unit{
+			Name:             serviceName,
+			Description:      "West port coordination server",
+			Args:             append([]string{"port", "start"}, c.Args().Slice()...),
+			WorkingDirectory: workDir,
+			// Tun device and DNS / HTTP ports
+			Capabilities: []string{"CAP_NET_ADMIN", "CAP_NET_BIND_SERVICE"},
+		}, creds)
+	},
+}
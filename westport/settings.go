@@ -0,0 +1,122 @@
+package westport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	l "github.com/sprisa/x/log"
+	"github.com/urfave/cli/v3"
+)
+
+// Listen ports, public endpoint and provisioning flags. Shared by install and settings.
+var networkFlags = []cli.Flag{
+	&cli.IntFlag{
+		Name:  "nebula-port",
+		Usage: "UDP port nebula listens on (default: 4242)",
+	},
+	&cli.IntFlag{
+		Name:  "http-port",
+		Usage: "Port the API listens on for HTTP (default: 80)",
+	},
+	&cli.IntFlag{
+		Name:  "https-port",
+		Usage: "Port the API listens on for HTTPS (default: 443)",
+	},
+	&cli.StringFlag{
+		Name:  "public-host",
+		Usage: "Public host or IP devices use to reach west port. Discovered via checkip.amazonaws.com when empty",
+	},
+	&cli.IntFlag{
+		Name:  "public-nebula-port",
+		Usage: "Public nebula port when remapped by a load balancer. Defaults to --nebula-port",
+	},
+	&cli.IntFlag{
+		Name:  "public-api-port",
+		Usage: "Public API port when remapped by a load balancer. Defaults to --http-port or --https-port",
+	},
+	&cli.BoolFlag{
+		Name:  "require-client-keys",
+		Usage: "Only provision devices that generate their own key pair. Older west versions will be rejected.",
+	},
+}
+
+// Applies network flags explicitly set on the command to the settings mutation.
+func setNetworkFlags(c *cli.Command, m *ent.SettingsMutation) {
+	if c.IsSet("nebula-port") {
+		m.SetNebulaPort(c.Int("nebula-port"))
+	}
+	if c.IsSet("http-port") {
+		m.SetHTTPPort(c.Int("http-port"))
+	}
+	if c.IsSet("https-port") {
+		m.SetHTTPSPort(c.Int("https-port"))
+	}
+	if c.IsSet("public-host") {
+		m.SetPublicHost(c.String("public-host"))
+	}
+	if c.IsSet("public-nebula-port") {
+		m.SetPublicNebulaPort(c.Int("public-nebula-port"))
+	}
+	if c.IsSet("public-api-port") {
+		m.SetPublicAPIPort(c.Int("public-api-port"))
+	}
+	if c.IsSet("require-client-keys") {
+		m.SetRequireClientKeys(c.Bool("require-client-keys"))
+	}
+}
+
+var SettingsCommand = &cli.Command{
+	Name:      "settings",
+	Usage:     "Show or update west port network settings",
+	UsageText: "west port settings [--public-host host] [--nebula-port port]",
+	Before:    localOnly,
+	Flags:     networkFlags,
+	Action: func(ctx context.Context, c *cli.Command) error {
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+
+		err = readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+
+		settings, err := client.Settings.Query().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.New("error finding settings. Trying installing first.")
+			}
+			return errutil.WrapErr(err, "error initializing settings")
+		}
+
+		update := settings.Update()
+		setNetworkFlags(c, update.Mutation())
+		if len(update.Mutation().Fields()) > 0 {
+			settings, err = update.Save(ctx)
+			if err != nil {
+				return errutil.WrapErr(err, "error saving settings")
+			}
+			l.Log.Info().Msg("Settings updated. Restart west port to apply.")
+		}
+
+		fmt.Printf("nebula-port:         %d\n", settings.NebulaPort)
+		fmt.Printf("http-port:           %d\n", settings.HTTPPort)
+		fmt.Printf("https-port:          %d\n", settings.HTTPSPort)
+		fmt.Printf("public-host:         %s\n", settings.PublicHost)
+		fmt.Printf("public-nebula-port:  %d\n", settings.PublicNebulaPort)
+		fmt.Printf("public-api-port:     %d\n", settings.PublicAPIPort)
+		fmt.Printf("require-client-keys: %t\n", settings.RequireClientKeys)
+		return nil
+	},
+}
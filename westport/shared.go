@@ -8,28 +8,51 @@ import (
 	"github.com/cqroot/prompt"
 	"github.com/cqroot/prompt/input"
 	"github.com/sprisa/west/util/ioutil"
+	"github.com/sprisa/west/util/service"
 	"github.com/sprisa/west/westport/db/helpers"
 )
 
-func readEncryptionPassword() (err error) {
-	var pswd string
+// Credentials holding the encryption password and database url when run as a
+// systemd service
+const (
+	passwordCredential    = "password"
+	databaseURLCredential = "database-url"
+)
+
+func readEncryptionPassword() error {
+	pswd, err := readPassword()
+	if err != nil {
+		return err
+	}
+	copy(helpers.EncryptionKey[:], pswd)
+	// l.Log.Info().Msg(pswd)
+	// l.Log.Info().Msgf("key: %s", string(helpers.EncryptionKey[:]))
+	return nil
+}
+
+// Reads the encryption password from the systemd credential, stdin or
+// prompts for it.
+func readPassword() (string, error) {
+	cred, err := service.ReadCredential(passwordCredential)
+	if err != nil {
+		return "", err
+	}
+	if len(cred) > 0 {
+		return string(cred), nil
+	}
+	return readSecret("password:")
+}
+
+// Reads a secret from stdin or prompts for it without echo.
+func readSecret(label string) (string, error) {
 	// Read from stdin if available
 	if ioutil.StdinAvailable() {
 		b, err := io.ReadAll(os.Stdin)
 		if err != nil {
-			return err
-		}
-		pswd = string(bytes.TrimSpace(b))
-	} else {
-		pswd, err = prompt.New().Ask("password:").
-			Input("", input.WithEchoMode(input.EchoPassword), input.WithHelp(true))
-		if err != nil {
-			return err
+			return "", err
 		}
+		return string(bytes.TrimSpace(b)), nil
 	}
-
-	copy(helpers.EncryptionKey[:], pswd)
-	// l.Log.Info().Msg(pswd)
-	// l.Log.Info().Msgf("key: %s", string(helpers.EncryptionKey[:]))
-	return nil
+	return prompt.New().Ask(label).
+		Input("", input.WithEchoMode(input.EchoPassword), input.WithHelp(true))
 }
@@ -0,0 +1,596 @@
+package westport
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/dnsrecord"
+	"github.com/sprisa/west/westport/db/ent/settings"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/x/errutil"
+	"gopkg.in/yaml.v3"
+)
+
+// Declarative network definition applied with `west port apply`. Sections
+// left out are not managed. An empty list deletes everything in it.
+type NetworkSpec struct {
+	Settings   *SettingsSpec   `yaml:"settings,omitempty"`
+	Devices    []DeviceSpec    `yaml:"devices,omitempty"`
+	DNSRecords []DNSRecordSpec `yaml:"dns_records,omitempty"`
+	Firewall   *FirewallSpec   `yaml:"firewall,omitempty"`
+}
+
+// Network settings. Unset fields are not managed.
+type SettingsSpec struct {
+	PublicHost        *string `yaml:"public_host,omitempty"`
+	NebulaPort        *int    `yaml:"nebula_port,omitempty"`
+	HTTPPort          *int    `yaml:"http_port,omitempty"`
+	HTTPSPort         *int    `yaml:"https_port,omitempty"`
+	PublicNebulaPort  *int    `yaml:"public_nebula_port,omitempty"`
+	PublicAPIPort     *int    `yaml:"public_api_port,omitempty"`
+	PortRelay         *bool   `yaml:"port_relay,omitempty"`
+	RequireClientKeys *bool   `yaml:"require_client_keys,omitempty"`
+}
+
+type DeviceSpec struct {
+	Name            string   `yaml:"name"`
+	IP              string   `yaml:"ip"`
+	Groups          []string `yaml:"groups,omitempty"`
+	AdvertiseRoutes []string `yaml:"advertise_routes,omitempty"`
+	ExitNode        bool     `yaml:"exit_node,omitempty"`
+	Relay           bool     `yaml:"relay,omitempty"`
+	Lighthouse      bool     `yaml:"lighthouse,omitempty"`
+	PublicEndpoint  string   `yaml:"public_endpoint,omitempty"`
+}
+
+type DNSRecordSpec struct {
+	// Name within the domain zone
+	Name string `yaml:"name"`
+	// A, CNAME or TXT
+	Type  string `yaml:"type"`
+	Value string `yaml:"value"`
+}
+
+type FirewallSpec struct {
+	// Rules allowing inbound traffic to every device
+	Inbound []helpers.FirewallRule `yaml:"inbound"`
+}
+
+// Reads a spec from path, or stdin when path is -.
+func LoadSpec(path string) (*NetworkSpec, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error opening spec")
+		}
+		defer f.Close()
+		r = f
+	}
+	return ParseSpec(r)
+}
+
+// Parses a YAML spec. Unknown fields are errors so typos aren't ignored.
+func ParseSpec(r io.Reader) (*NetworkSpec, error) {
+	dec := yaml.NewDecoder(r)
+	dec.KnownFields(true)
+	spec := &NetworkSpec{}
+	err := dec.Decode(spec)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return nil, errutil.WrapErr(err, "error parsing spec")
+	}
+	return spec, nil
+}
+
+// Change needed to reconcile the database with a spec.
+type Change struct {
+	// + create, ~ update or - delete
+	Op   string
+	Kind string
+	Name string
+	// Changed fields, e.g. `groups: [] -> [web]`
+	Diff  []string
+	apply func(ctx context.Context, client *ent.Client, res *ApplyResult) error
+}
+
+func (c *Change) String() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %s", c.Op, c.Kind)
+	if c.Name != "" {
+		fmt.Fprintf(&b, " %s", c.Name)
+	}
+	for _, d := range c.Diff {
+		fmt.Fprintf(&b, "\n    %s", d)
+	}
+	return b.String()
+}
+
+type Plan struct {
+	Changes []*Change
+}
+
+func (p *Plan) String() string {
+	if len(p.Changes) == 0 {
+		return "No changes. The network matches the spec."
+	}
+	var b strings.Builder
+	counts := map[string]int{}
+	for _, c := range p.Changes {
+		b.WriteString(c.String())
+		b.WriteString("\n")
+		counts[c.Op]++
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to add, %d to change, %d to delete.", counts["+"], counts["~"], counts["-"])
+	return b.String()
+}
+
+// Settings fields read for planning. Encrypted fields are left out so diffs
+// don't need the password.
+var specSettingsFields = []string{
+	settings.FieldID,
+	settings.FieldCidr,
+	settings.FieldPortOverlayIP,
+	settings.FieldPublicHost,
+	settings.FieldNebulaPort,
+	settings.FieldHTTPPort,
+	settings.FieldHTTPSPort,
+	settings.FieldPublicNebulaPort,
+	settings.FieldPublicAPIPort,
+	settings.FieldPortRelay,
+	settings.FieldRequireClientKeys,
+	settings.FieldFirewallInbound,
+}
+
+// Compares spec with the database. Fails when the spec is invalid or asks
+// for a change that can't be made, e.g. a new IP for an existing device.
+func PlanSpec(ctx context.Context, client *ent.Client, spec *NetworkSpec) (*Plan, error) {
+	current, err := client.Settings.Query().
+		Select(specSettingsFields...).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, errors.New("error finding settings. Trying installing first.")
+		}
+		return nil, errutil.WrapErr(err, "error reading settings")
+	}
+	// Tokens are left out, they can't be read without the password
+	dvcs, err := client.Device.Query().
+		Select(
+			device.FieldName,
+			device.FieldIP,
+			device.FieldGroups,
+			device.FieldAdvertiseRoutes,
+			device.FieldExitNode,
+			device.FieldRelay,
+			device.FieldLighthouse,
+			device.FieldPublicEndpoint,
+		).
+		Order(ent.Asc(device.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error listing devices")
+	}
+	records, err := client.DNSRecord.Query().
+		Order(ent.Asc(dnsrecord.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, errutil.WrapErr(err, "error listing dns records")
+	}
+
+	plan := &Plan{}
+	if spec.Settings != nil {
+		planSettings(plan, current, spec.Settings)
+	}
+	if spec.Firewall != nil {
+		err = planFirewall(plan, current, spec.Firewall)
+		if err != nil {
+			return nil, err
+		}
+	}
+	deviceNames := map[string]bool{}
+	for _, dvc := range dvcs {
+		deviceNames[dvc.Name] = true
+	}
+	if spec.Devices != nil {
+		deviceNames, err = planDevices(plan, current, dvcs, spec.Devices)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if spec.DNSRecords != nil {
+		err = planDNSRecords(plan, records, spec.DNSRecords, deviceNames)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return plan, nil
+}
+
+func planSettings(plan *Plan, current *ent.Settings, spec *SettingsSpec) {
+	diff := []string{}
+	sets := []func(m *ent.SettingsMutation){}
+	// Adds a managed field to the plan when it differs
+	field := func(name string, managed bool, cur any, want any, set func(m *ent.SettingsMutation)) {
+		if !managed || cur == want {
+			return
+		}
+		diff = append(diff, fmt.Sprintf("%s: %s -> %s", name, show(cur), show(want)))
+		sets = append(sets, set)
+	}
+	s := spec
+	field("public_host", s.PublicHost != nil, current.PublicHost, deref(s.PublicHost), func(m *ent.SettingsMutation) { m.SetPublicHost(*s.PublicHost) })
+	field("nebula_port", s.NebulaPort != nil, current.NebulaPort, deref(s.NebulaPort), func(m *ent.SettingsMutation) { m.SetNebulaPort(*s.NebulaPort) })
+	field("http_port", s.HTTPPort != nil, current.HTTPPort, deref(s.HTTPPort), func(m *ent.SettingsMutation) { m.SetHTTPPort(*s.HTTPPort) })
+	field("https_port", s.HTTPSPort != nil, current.HTTPSPort, deref(s.HTTPSPort), func(m *ent.SettingsMutation) { m.SetHTTPSPort(*s.HTTPSPort) })
+	field("public_nebula_port", s.PublicNebulaPort != nil, current.PublicNebulaPort, deref(s.PublicNebulaPort), func(m *ent.SettingsMutation) { m.SetPublicNebulaPort(*s.PublicNebulaPort) })
+	field("public_api_port", s.PublicAPIPort != nil, current.PublicAPIPort, deref(s.PublicAPIPort), func(m *ent.SettingsMutation) { m.SetPublicAPIPort(*s.PublicAPIPort) })
+	field("port_relay", s.PortRelay != nil, current.PortRelay, deref(s.PortRelay), func(m *ent.SettingsMutation) { m.SetPortRelay(*s.PortRelay) })
+	field("require_client_keys", s.RequireClientKeys != nil, current.RequireClientKeys, deref(s.RequireClientKeys), func(m *ent.SettingsMutation) { m.SetRequireClientKeys(*s.RequireClientKeys) })
+	if len(diff) == 0 {
+		return
+	}
+	plan.Changes = append(plan.Changes, &Change{
+		Op:   "~",
+		Kind: "settings",
+		Diff: diff,
+		apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+			u := client.Settings.UpdateOneID(current.ID)
+			for _, set := range sets {
+				set(u.Mutation())
+			}
+			res.RestartPort = true
+			return u.Exec(ctx)
+		},
+	})
+}
+
+func planFirewall(plan *Plan, current *ent.Settings, spec *FirewallSpec) error {
+	rules := spec.Inbound
+	if rules == nil {
+		rules = []helpers.FirewallRule{}
+	}
+	for i := range rules {
+		err := rules[i].Validate()
+		if err != nil {
+			return errutil.WrapErr(err, "firewall inbound rule %d", i+1)
+		}
+	}
+	cur, _ := json.Marshal(current.FirewallInbound)
+	want, _ := json.Marshal(rules)
+	if current.FirewallInbound != nil && bytes.Equal(cur, want) {
+		return nil
+	}
+
+	diff := []string{}
+	for _, r := range current.FirewallInbound {
+		if !slices.ContainsFunc(rules, func(w helpers.FirewallRule) bool { return ruleString(w) == ruleString(r) }) {
+			diff = append(diff, "- inbound "+ruleString(r))
+		}
+	}
+	for _, r := range rules {
+		if !slices.ContainsFunc(current.FirewallInbound, func(c helpers.FirewallRule) bool { return ruleString(c) == ruleString(r) }) {
+			diff = append(diff, "+ inbound "+ruleString(r))
+		}
+	}
+	if len(diff) == 0 {
+		// Only the order changed, or the firewall becomes managed
+		diff = append(diff, fmt.Sprintf("inbound: %d rules", len(rules)))
+	}
+	plan.Changes = append(plan.Changes, &Change{
+		Op:   "~",
+		Kind: "firewall",
+		Diff: diff,
+		apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+			res.ReprovisionDevices = true
+			return client.Settings.UpdateOneID(current.ID).
+				SetFirewallInbound(rules).
+				Exec(ctx)
+		},
+	})
+	return nil
+}
+
+// Plans device changes and returns the device names after applying.
+func planDevices(plan *Plan, current *ent.Settings, dvcs []*ent.Device, specs []DeviceSpec) (map[string]bool, error) {
+	names := map[string]bool{}
+	ips := map[netip.Addr]string{}
+	existing := map[string]*ent.Device{}
+	for _, dvc := range dvcs {
+		existing[dvc.Name] = dvc
+	}
+
+	creates := []*Change{}
+	updates := []*Change{}
+	for _, s := range specs {
+		if s.Name == "" {
+			return nil, errors.New("device name required")
+		}
+		if names[s.Name] {
+			return nil, fmt.Errorf("device `%s` is listed twice", s.Name)
+		}
+		names[s.Name] = true
+		ip, err := netip.ParseAddr(s.IP)
+		if err != nil {
+			return nil, errutil.WrapErr(err, "error parsing ip `%s` of device `%s`", s.IP, s.Name)
+		}
+		if !current.Cidr.Contains(ip) {
+			return nil, fmt.Errorf("ip `%s` of device `%s` must be within network cidr `%s`", ip, s.Name, current.Cidr)
+		}
+		if ip == current.PortOverlayIP.ToIpAddr() {
+			return nil, fmt.Errorf("ip `%s` of device `%s` is west port's", ip, s.Name)
+		}
+		if other, ok := ips[ip]; ok {
+			return nil, fmt.Errorf("devices `%s` and `%s` have the same ip `%s`", other, s.Name, ip)
+		}
+		ips[ip] = s.Name
+		routes := []string{}
+		for _, r := range s.AdvertiseRoutes {
+			route, err := parseRoute(r)
+			if err != nil {
+				return nil, err
+			}
+			if route.Overlaps(current.Cidr.Prefix) {
+				return nil, fmt.Errorf("route `%s` must not overlap network cidr `%s`", route, current.Cidr)
+			}
+			routes = append(routes, route.String())
+		}
+		if s.PublicEndpoint != "" {
+			_, err = parseEndpoint(s.PublicEndpoint)
+			if err != nil {
+				return nil, err
+			}
+		}
+		if s.Lighthouse && s.PublicEndpoint == "" {
+			return nil, fmt.Errorf("lighthouse `%s` requires a public endpoint", s.Name)
+		}
+
+		dvc, ok := existing[s.Name]
+		if !ok {
+			creates = append(creates, &Change{
+				Op:   "+",
+				Kind: "device",
+				Name: fmt.Sprintf("%s (%s)", s.Name, ip),
+				apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+					settings, err := client.Settings.Query().Only(ctx)
+					if err != nil {
+						return errutil.WrapErr(err, "error reading settings")
+					}
+					token, err := AddDevice(ctx, client, settings, &AddDeviceOpts{
+						Name:            s.Name,
+						IP:              ip,
+						AdvertiseRoutes: routes,
+						ExitNode:        s.ExitNode,
+						Relay:           s.Relay,
+						Lighthouse:      s.Lighthouse,
+						PublicEndpoint:  s.PublicEndpoint,
+						Groups:          s.Groups,
+					})
+					if err != nil {
+						return errutil.WrapErr(err, "error adding device `%s`", s.Name)
+					}
+					res.Tokens = append(res.Tokens, DeviceToken{Name: s.Name, Token: token})
+					return nil
+				},
+			})
+			continue
+		}
+
+		if dvc.IP.ToIpAddr() != ip {
+			return nil, fmt.Errorf("ip of device `%s` can't change from `%s` to `%s`. Rename it to add a new device", s.Name, dvc.IP.ToIpAddr(), ip)
+		}
+		diff := []string{}
+		sets := []func(u *ent.DeviceUpdateOne){}
+		if !slices.Equal(dvc.Groups, s.Groups) {
+			diff = append(diff, fmt.Sprintf("groups: %s -> %s", show(dvc.Groups), show(s.Groups)))
+			sets = append(sets, func(u *ent.DeviceUpdateOne) { u.SetGroups(s.Groups) })
+		}
+		if !slices.Equal(dvc.AdvertiseRoutes, routes) {
+			diff = append(diff, fmt.Sprintf("advertise_routes: %s -> %s", show(dvc.AdvertiseRoutes), show(routes)))
+			sets = append(sets, func(u *ent.DeviceUpdateOne) { u.SetAdvertiseRoutes(routes) })
+		}
+		if dvc.ExitNode != s.ExitNode {
+			diff = append(diff, fmt.Sprintf("exit_node: %t -> %t", dvc.ExitNode, s.ExitNode))
+			sets = append(sets, func(u *ent.DeviceUpdateOne) { u.SetExitNode(s.ExitNode) })
+		}
+		if dvc.Relay != s.Relay {
+			diff = append(diff, fmt.Sprintf("relay: %t -> %t", dvc.Relay, s.Relay))
+			sets = append(sets, func(u *ent.DeviceUpdateOne) { u.SetRelay(s.Relay) })
+		}
+		if dvc.Lighthouse != s.Lighthouse {
+			diff = append(diff, fmt.Sprintf("lighthouse: %t -> %t", dvc.Lighthouse, s.Lighthouse))
+			sets = append(sets, func(u *ent.DeviceUpdateOne) { u.SetLighthouse(s.Lighthouse) })
+		}
+		if dvc.PublicEndpoint != s.PublicEndpoint {
+			diff = append(diff, fmt.Sprintf("public_endpoint: %s -> %s", show(dvc.PublicEndpoint), show(s.PublicEndpoint)))
+			sets = append(sets, func(u *ent.DeviceUpdateOne) { u.SetPublicEndpoint(s.PublicEndpoint) })
+		}
+		if len(diff) == 0 {
+			continue
+		}
+		id := dvc.ID
+		updates = append(updates, &Change{
+			Op:   "~",
+			Kind: "device",
+			Name: s.Name,
+			Diff: diff,
+			apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+				u := client.Device.UpdateOneID(id)
+				for _, set := range sets {
+					set(u)
+				}
+				res.ReprovisionDevices = true
+				return u.Exec(ctx)
+			},
+		})
+	}
+
+	// Deletes go first to free names and IPs
+	for _, dvc := range dvcs {
+		if names[dvc.Name] {
+			continue
+		}
+		id := dvc.ID
+		plan.Changes = append(plan.Changes, &Change{
+			Op:   "-",
+			Kind: "device",
+			Name: fmt.Sprintf("%s (%s)", dvc.Name, dvc.IP.ToIpAddr()),
+			apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+				return client.Device.DeleteOneID(id).Exec(ctx)
+			},
+		})
+	}
+	plan.Changes = append(plan.Changes, updates...)
+	plan.Changes = append(plan.Changes, creates...)
+	return names, nil
+}
+
+func planDNSRecords(plan *Plan, records []*ent.DNSRecord, specs []DNSRecordSpec, deviceNames map[string]bool) error {
+	names := map[string]bool{}
+	existing := map[string]*ent.DNSRecord{}
+	for _, rec := range records {
+		existing[rec.Name] = rec
+	}
+
+	changes := []*Change{}
+	for _, s := range specs {
+		err := validateDNSRecord(&s)
+		if err != nil {
+			return err
+		}
+		if names[s.Name] {
+			return fmt.Errorf("dns record `%s` is listed twice", s.Name)
+		}
+		names[s.Name] = true
+		if deviceNames[s.Name] {
+			return fmt.Errorf("dns record `%s` has the name of a device", s.Name)
+		}
+		typ := dnsrecord.Type(s.Type)
+
+		rec, ok := existing[s.Name]
+		if !ok {
+			changes = append(changes, &Change{
+				Op:   "+",
+				Kind: "dns_record",
+				Name: fmt.Sprintf("%s %s %s", s.Name, s.Type, s.Value),
+				apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+					return client.DNSRecord.Create().
+						SetName(s.Name).
+						SetType(typ).
+						SetValue(s.Value).
+						Exec(ctx)
+				},
+			})
+			continue
+		}
+		if rec.Type == typ && rec.Value == s.Value {
+			continue
+		}
+		id := rec.ID
+		changes = append(changes, &Change{
+			Op:   "~",
+			Kind: "dns_record",
+			Name: s.Name,
+			Diff: []string{fmt.Sprintf("%s %s -> %s %s", rec.Type, rec.Value, s.Type, s.Value)},
+			apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+				return client.DNSRecord.UpdateOneID(id).
+					SetType(typ).
+					SetValue(s.Value).
+					Exec(ctx)
+			},
+		})
+	}
+
+	for _, rec := range records {
+		if names[rec.Name] {
+			continue
+		}
+		id := rec.ID
+		plan.Changes = append(plan.Changes, &Change{
+			Op:   "-",
+			Kind: "dns_record",
+			Name: fmt.Sprintf("%s %s %s", rec.Name, rec.Type, rec.Value),
+			apply: func(ctx context.Context, client *ent.Client, res *ApplyResult) error {
+				return client.DNSRecord.DeleteOneID(id).Exec(ctx)
+			},
+		})
+	}
+	plan.Changes = append(plan.Changes, changes...)
+	return nil
+}
+
+func validateDNSRecord(s *DNSRecordSpec) error {
+	if s.Name == "" {
+		return errors.New("dns record name required")
+	}
+	if strings.HasSuffix(s.Name, ".") {
+		return fmt.Errorf("dns record `%s` must be relative to the domain zone", s.Name)
+	}
+	err := dnsrecord.TypeValidator(dnsrecord.Type(s.Type))
+	if err != nil {
+		return fmt.Errorf("dns record `%s` has invalid type `%s`. Use A, CNAME or TXT", s.Name, s.Type)
+	}
+	switch dnsrecord.Type(s.Type) {
+	case dnsrecord.TypeA:
+		ip, err := netip.ParseAddr(s.Value)
+		if err != nil || !ip.Is4() {
+			return fmt.Errorf("dns record `%s` needs an ipv4 value, got `%s`", s.Name, s.Value)
+		}
+	case dnsrecord.TypeCNAME:
+		if s.Value == "" || strings.ContainsAny(s.Value, " \t") {
+			return fmt.Errorf("dns record `%s` needs a device name or fqdn value, got `%s`", s.Name, s.Value)
+		}
+	}
+	return nil
+}
+
+func ruleString(r helpers.FirewallRule) string {
+	parts := []string{}
+	port := "any"
+	if r.Port != 0 {
+		port = fmt.Sprint(r.Port)
+	}
+	proto := r.Proto
+	if proto == "" {
+		proto = "any"
+	}
+	parts = append(parts, "port="+port, "proto="+proto)
+	if r.Host != "" {
+		parts = append(parts, "host="+r.Host)
+	}
+	if len(r.Groups) > 0 {
+		parts = append(parts, "groups="+strings.Join(r.Groups, ","))
+	}
+	if r.Cidr != "" {
+		parts = append(parts, "cidr="+r.Cidr)
+	}
+	return strings.Join(parts, " ")
+}
+
+// Formats a plan value. Empty lists and strings are shown as such.
+func show(v any) string {
+	switch v := v.(type) {
+	case string:
+		return fmt.Sprintf("%q", v)
+	case []string:
+		return "[" + strings.Join(v, ", ") + "]"
+	}
+	return fmt.Sprint(v)
+}
+
+func deref[T any](v *T) T {
+	if v == nil {
+		var zero T
+		return zero
+	}
+	return *v
+}
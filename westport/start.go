@@ -4,6 +4,7 @@ import (
 	"context"
 	"crypto/tls"
 	"errors"
+	"fmt"
 	"net"
 	"net/http"
 	"time"
@@ -14,9 +15,13 @@ import (
 	"github.com/99designs/gqlgen/graphql/handler/extension"
 	"github.com/99designs/gqlgen/graphql/handler/lru"
 	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/sirupsen/logrus"
 	"github.com/sprisa/west"
 	"github.com/sprisa/west/config"
+	"github.com/sprisa/west/util/metrics"
+	"github.com/sprisa/west/util/service"
 	"github.com/sprisa/west/westport/acme"
+	"github.com/sprisa/west/westport/audit"
 	"github.com/sprisa/west/westport/db"
 	"github.com/sprisa/west/westport/db/ent"
 	"github.com/sprisa/west/westport/db/migrate"
@@ -42,6 +47,11 @@ var StartCommand = &cli.Command{
 			Name:  "disable-tun",
 			Usage: "Disabled TUN network binding",
 		},
+		&cli.DurationFlag{
+			Name:  "audit-retention",
+			Value: 365 * 24 * time.Hour,
+			Usage: "Delete audit events older than this. 0 keeps them forever.",
+		},
 	},
 	Action: func(ctx context.Context, c *cli.Command) error {
 		return startWestPort(ctx, c)
@@ -49,9 +59,6 @@ var StartCommand = &cli.Command{
 }
 
 func startWestPort(ctx context.Context, c *cli.Command) error {
-	privateDns := c.Bool("private-dns")
-	disableTun := c.Bool("disable-tun")
-
 	err := readEncryptionPassword()
 	if err != nil {
 		return err
@@ -67,6 +74,35 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 		return errutil.WrapErr(err, "error migrating db")
 	}
 
+	return Serve(ctx, client, &ServeOpts{
+		PrivateDNS:     c.Bool("private-dns"),
+		DisableTun:     c.Bool("disable-tun"),
+		AuditRetention: c.Duration("audit-retention"),
+	})
+}
+
+type ServeOpts struct {
+	// DNS server only listens on the overlay
+	PrivateDNS bool
+	DisableTun bool
+	// Public DNS listen address. Defaults to 0.0.0.0:53.
+	DNSAddr string
+	// Serve the API over HTTP only, skipping Let's Encrypt
+	DisableHTTPS bool
+	// Nebula logger. Defaults to the logrus standard logger.
+	Log *logrus.Logger
+	// Audit events older than this are deleted daily. 0 keeps them forever.
+	AuditRetention time.Duration
+}
+
+// Runs the API, DNS and Nebula lighthouse from the settings in client until
+// ctx is done. Requires helpers.EncryptionKey to be set.
+func Serve(ctx context.Context, client *ent.Client, opts *ServeOpts) error {
+	// Background work, e.g. renewing TLS certs, is not done by whoever ran start
+	ctx = audit.WithActor(ctx, audit.System)
+	privateDns := opts.PrivateDNS
+	disableTun := opts.DisableTun
+
 	settings, err := client.Settings.Query().Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -82,22 +118,36 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 
 	group, ctx := errgroup.WithContext(ctx)
 
+	if opts.AuditRetention > 0 {
+		group.Go(func() error {
+			pruneAuditLog(ctx, client, opts.AuditRetention)
+			return nil
+		})
+	}
+
 	// Start Graphql API Server
 	handler := NewGQLServer(gql.NewSchema(client), client)
 	mux := http.NewServeMux()
 	mux.Handle("/.well-known/acme-challenge/", httpProvider)
+	mux.Handle("/metrics", metrics.Handler())
+	health := newHealth(client)
+	mux.HandleFunc("/healthz", health.liveness)
+	mux.HandleFunc("/readyz", health.readiness)
 	mux.Handle(
 		"/api",
-		handler,
+		withAPIActor(client, handler),
 	)
-	server := &http.Server{Addr: ":80", Handler: mux}
+	// REST API for ops tooling
+	mux.Handle("/v1/", withAPIActor(client, newRESTHandler(client)))
+	server := &http.Server{Addr: fmt.Sprintf(":%d", settings.HTTPPort), Handler: mux}
 	var httpsServer *http.Server
-	if settings.DomainZone != "" {
+	if settings.DomainZone != "" && !opts.DisableHTTPS {
 		httpsServer = &http.Server{
-			Addr:    ":443",
+			Addr:    fmt.Sprintf(":%d", settings.HTTPSPort),
 			Handler: mux,
 		}
 	}
+	health.setTLSEnabled(httpsServer != nil)
 	// HTTP
 	group.Go(func() error {
 		l.Log.Info().
@@ -123,6 +173,9 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 			}
 
 			httpsServer.TLSConfig = tlsConfig
+			if cert.Leaf != nil {
+				health.setTLSExpiry(cert.Leaf.NotAfter)
+			}
 
 			l.Log.Info().
 				Str("addr", httpsServer.Addr).
@@ -142,10 +195,10 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 		l.Log.Info().Msg("Shutting down gql server")
 		ctx, cancel := context.WithTimeout(context.Background(), time.Second*15)
 		defer cancel()
-		err := errors.Join(
-			server.Shutdown(ctx),
-			httpsServer.Shutdown(ctx),
-		)
+		err := server.Shutdown(ctx)
+		if httpsServer != nil {
+			err = errors.Join(err, httpsServer.Shutdown(ctx))
+		}
 		if err != nil && errors.Is(err, http.ErrServerClosed) == false {
 			l.Log.Err(err).Msg("gql server shutdown")
 		}
@@ -153,17 +206,26 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 
 	// Depends on Nebula interface
 	var onNebulaStart = func(ctrl *west.Control) {
+		health.setNebula(true)
 		// Start Compass DNS
 		group.Go(func() error {
 			addr := "0.0.0.0:53"
+			if opts.DNSAddr != "" {
+				addr = opts.DNSAddr
+			}
 			if privateDns {
 				if disableTun {
 					return errors.New("private dns cannot be used with tun disabled")
 				}
 				addr = net.JoinHostPort(settings.PortOverlayIP.ToIpAddr().String(), "53")
 			}
+			health.setDNS(addr, settings.DomainZone)
 			return dns.StartCompassDNSServer(ctx, addr, client, settings, dnsProvider)
 		})
+		group.Go(func() error {
+			trackPresence(ctx, client, ctrl)
+			return nil
+		})
 	}
 
 	// Start Nebula
@@ -173,9 +235,27 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 			cipher = config.CipherChaChaPoly
 		}
 
-		opts := &west.ServerOpts{
+		logging := config.Logging{}
+		if opts.Log != nil {
+			// Nebula resets the logger level from its config
+			logging.Level = opts.Log.GetLevel().String()
+		}
+		nebulaOpts := &west.ServerOpts{
+			Log:     opts.Log,
 			OnStart: onNebulaStart,
+			// Type=notify services are up once nebula is. Lighthouses are
+			// ready right after starting.
+			OnReady: func(ctrl *west.Control) {
+				err := service.NotifyReady()
+				if err != nil {
+					l.Log.Err(err).Msg("error notifying systemd")
+				}
+			},
+			OnShutdown: func() {
+				health.setNebula(false)
+			},
 			Config: &config.Config{
+				Logging: logging,
 				Pki: config.Pki{
 					Ca:   string(settings.CaCrt),
 					Cert: string(settings.LighthouseCrt),
@@ -184,12 +264,15 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 				Lighthouse: config.Lighthouse{
 					AmLighthouse: true,
 				},
+				Relay: config.Relay{
+					AmRelay: settings.PortRelay,
+				},
 				Tun: config.Tun{
 					Disabled: disableTun,
 				},
 				Listen: config.Listen{
 					Host: "::",
-					Port: 4242,
+					Port: settings.NebulaPort,
 				},
 				PreferredRanges: config.DefaultPreferredRanges,
 				Cipher:          cipher,
@@ -211,7 +294,7 @@ func startWestPort(ctx context.Context, c *cli.Command) error {
 				},
 			},
 		}
-		srv, err := west.NewServer(opts)
+		srv, err := west.NewServer(nebulaOpts)
 		if err != nil {
 			return errutil.WrapErr(err, "error creating nebula server")
 		}
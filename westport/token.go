@@ -0,0 +1,291 @@
+package westport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/sprisa/west/util/auth"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/ent/device"
+	"github.com/sprisa/west/westport/db/ent/revokedtoken"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/errutil"
+	"github.com/urfave/cli/v3"
+)
+
+var TokenCommand = &cli.Command{
+	Name:      "token",
+	Usage:     "Issue a new token for a device or revoke its current one",
+	UsageText: "west port token issue <device>",
+	Commands: []*cli.Command{
+		TokenIssueCommand,
+		TokenRevokeCommand,
+	},
+	Action: func(ctx context.Context, cmd *cli.Command) error {
+		return cli.ShowSubcommandHelp(cmd)
+	},
+}
+
+var TokenIssueCommand = &cli.Command{
+	Name:      "issue",
+	Usage:     "Print a new token for a device. Its previous token is revoked.",
+	UsageText: "west port token issue <device> [--expires 8760h] [--once]",
+	Flags: []cli.Flag{
+		&cli.DurationFlag{
+			Name:      "expires",
+			Value:     auth.DeviceTokenExpiry,
+			Usage:     "Time until the token expires",
+			Validator: validateExpires,
+		},
+		&cli.BoolFlag{
+			Name:  "once",
+			Usage: "Token can only be used once. The device is given a new token on first provision.",
+		},
+	},
+	Action: func(ctx context.Context, c *cli.Command) error {
+		name := c.Args().First()
+		if name == "" {
+			return errors.New("device name required")
+		}
+		opts := &IssueTokenOpts{
+			Expires:   c.Duration("expires"),
+			SingleUse: c.Bool("once"),
+		}
+		remote, err := remoteFor(c)
+		if err != nil {
+			return err
+		}
+		if remote != nil {
+			token, err := remote.IssueToken(ctx, name, opts)
+			if err != nil {
+				return err
+			}
+			println(token)
+			return nil
+		}
+
+		err = readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		settings, err := client.Settings.Query().Only(ctx)
+		if err != nil {
+			if ent.IsNotFound(err) {
+				return errors.New("error finding settings. Trying installing first.")
+			}
+			return errutil.WrapErr(err, "error reading settings. Is the password correct?")
+		}
+
+		token, err := IssueToken(ctx, client, settings, name, opts)
+		if err != nil {
+			return err
+		}
+
+		println(token)
+		return nil
+	},
+}
+
+var TokenRevokeCommand = &cli.Command{
+	Name:      "revoke",
+	Usage:     "Stop a device's token from provisioning until a new one is issued",
+	UsageText: "west port token revoke <device>",
+	Action: func(ctx context.Context, c *cli.Command) error {
+		name := c.Args().First()
+		if name == "" {
+			return errors.New("device name required")
+		}
+		remote, err := remoteFor(c)
+		if err != nil {
+			return err
+		}
+		if remote != nil {
+			return remote.RevokeToken(ctx, name)
+		}
+
+		err = readEncryptionPassword()
+		if err != nil {
+			return err
+		}
+		client, err := db.OpenDB()
+		if err != nil {
+			return errutil.WrapErr(err, "error opening db")
+		}
+		defer client.Close()
+		err = migrate.MigrateClient(ctx, client)
+		if err != nil {
+			return errutil.WrapErr(err, "error migrating db")
+		}
+		return RevokeToken(ctx, client, name)
+	},
+}
+
+type IssueTokenOpts struct {
+	// Token lifetime. Defaults to auth.DeviceTokenExpiry.
+	Expires time.Duration
+	// Token is exchanged for a new one on first provision
+	SingleUse bool
+}
+
+// Replaces the token of the device named name and returns the new one. The
+// previous token is revoked. Requires helpers.EncryptionKey to be set.
+func IssueToken(ctx context.Context, client *ent.Client, settings *ent.Settings, name string, opts *IssueTokenOpts) (string, error) {
+	tx, err := client.Tx(ctx)
+	if err != nil {
+		return "", err
+	}
+	defer tx.Rollback()
+
+	dvc, err := findDevice(ctx, tx.Client(), name)
+	if err != nil {
+		return "", err
+	}
+	nebulaIp := netip.PrefixFrom(dvc.IP.ToIpAddr(), settings.Cidr.Bits())
+	token, claims, err := signDeviceToken(settings, nebulaIp, opts.Expires)
+	if err != nil {
+		return "", err
+	}
+	// Tokens from before token IDs can't be revoked, but stop matching the
+	// device once replaced
+	if old := currentClaims(dvc); old.ID != "" {
+		err = revoke(ctx, tx.Client(), dvc, old)
+		if err != nil && !errors.Is(err, errAlreadyRevoked) {
+			return "", err
+		}
+	}
+	err = tx.Device.UpdateOne(dvc).
+		SetToken(helpers.EncryptedBytes(token)).
+		SetSingleUseToken(opts.SingleUse).
+		Exec(ctx)
+	if err != nil {
+		return "", errutil.WrapErr(err, "error saving token")
+	}
+	err = audit.Record(ctx, tx.Client(), &audit.Event{
+		Action:     audit.TokenIssue,
+		DeviceID:   dvc.ID,
+		DeviceName: dvc.Name,
+		Details: map[string]string{
+			"jti":     claims.ID,
+			"expires": claims.ExpiresAt.UTC().Format(time.RFC3339),
+			"once":    strconv.FormatBool(opts.SingleUse),
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	err = tx.Commit()
+	if err != nil {
+		return "", errutil.WrapErr(err, "error saving token")
+	}
+	return token, nil
+}
+
+// Revokes the current token of the device named name. Requires
+// helpers.EncryptionKey to be set.
+func RevokeToken(ctx context.Context, client *ent.Client, name string) error {
+	dvc, err := findDevice(ctx, client, name)
+	if err != nil {
+		return err
+	}
+	claims := currentClaims(dvc)
+	if claims.ID == "" {
+		return fmt.Errorf("token of `%s` has no ID and can't be revoked. Replace it with `west port token issue %s`", name, name)
+	}
+	err = revoke(ctx, client, dvc, claims)
+	if err != nil {
+		if errors.Is(err, errAlreadyRevoked) {
+			return fmt.Errorf("token of `%s` is already revoked", name)
+		}
+		return err
+	}
+	return audit.Record(ctx, client, &audit.Event{
+		Action:     audit.TokenRevoke,
+		DeviceID:   dvc.ID,
+		DeviceName: dvc.Name,
+		Details:    map[string]string{"jti": claims.ID},
+	})
+}
+
+func findDevice(ctx context.Context, client *ent.Client, name string) (*ent.Device, error) {
+	dvc, err := client.Device.Query().
+		Where(device.Name(name)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, fmt.Errorf("device `%s` not found", name)
+		}
+		return nil, errutil.WrapErr(err, "error finding device. Is the password correct?")
+	}
+	return dvc, nil
+}
+
+// Claims of the device's current token. ID is empty for tokens issued before
+// token IDs.
+func currentClaims(dvc *ent.Device) *auth.TokenClaims {
+	claims := &auth.TokenClaims{}
+	// Signed by this port and only read for its ID
+	_, _, err := jwt.NewParser().ParseUnverified(dvc.Token.String(), claims)
+	if err != nil {
+		return &auth.TokenClaims{}
+	}
+	return claims
+}
+
+var errAlreadyRevoked = errors.New("token already revoked")
+
+// Records claims as revoked.
+func revoke(ctx context.Context, client *ent.Client, dvc *ent.Device, claims *auth.TokenClaims) error {
+	create := client.RevokedToken.Create().
+		SetJti(claims.ID).
+		SetDeviceID(dvc.ID).
+		SetDeviceName(dvc.Name).
+		SetExpiresTime(time.Now().Add(auth.DeviceTokenExpiry))
+	if claims.ExpiresAt != nil {
+		create.SetExpiresTime(claims.ExpiresAt.Time)
+	}
+	return saveRevocation(ctx, client, create)
+}
+
+// Saves the revocation in create and prunes revocations of expired tokens,
+// which fail to verify anyway.
+func saveRevocation(ctx context.Context, client *ent.Client, create *ent.RevokedTokenCreate) error {
+	jti, _ := create.Mutation().Jti()
+	// Checked up front, a failed insert aborts postgres transactions
+	revoked, err := client.RevokedToken.Query().
+		Where(revokedtoken.Jti(jti)).
+		Exist(ctx)
+	if err != nil {
+		return errutil.WrapErr(err, "error checking revoked tokens")
+	}
+	if revoked {
+		return errAlreadyRevoked
+	}
+	_, err = client.RevokedToken.Delete().
+		Where(revokedtoken.ExpiresTimeLT(time.Now())).
+		Exec(ctx)
+	if err != nil {
+		return errutil.WrapErr(err, "error pruning revoked tokens")
+	}
+	err = create.Exec(ctx)
+	if err != nil {
+		return errutil.WrapErr(err, "error revoking token")
+	}
+	return nil
+}
@@ -0,0 +1,396 @@
+// Package westtest runs a west port and west devices in-process for end to
+// end tests. Everything listens on loopback and no device needs a tun, so
+// tests need no root.
+//
+// The port encrypts its database with the global helpers.EncryptionKey, so
+// only one Network should run per test binary at a time.
+//
+// Networks can't run under the race detector. Every nebula instance writes
+// the package global noiseEndianness on start while running ones read it,
+// and nebula's disabled tun clears its reader on close without a lock.
+package westtest
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/miekg/dns"
+	"github.com/sirupsen/logrus"
+	"github.com/sprisa/west"
+	"github.com/sprisa/west/config"
+	"github.com/sprisa/west/util/ipconv"
+	"github.com/sprisa/west/util/pki"
+	"github.com/sprisa/west/west/gql"
+	"github.com/sprisa/west/westport"
+	"github.com/sprisa/west/westport/db"
+	"github.com/sprisa/west/westport/db/ent"
+	"github.com/sprisa/west/westport/db/helpers"
+	"github.com/sprisa/west/westport/db/migrate"
+	"github.com/sprisa/x/netutil"
+)
+
+const (
+	Password   = "westtest-password"
+	DomainZone = "net.test"
+	Cidr       = "10.10.10.1/24"
+	// How long helpers wait for the mesh before failing the test
+	Timeout = 30 * time.Second
+)
+
+type Options struct {
+	// Devices to add and start with the network. More can be added with
+	// Network.AddDevice.
+	Devices int
+	// Use an in-memory database instead of one in a temp dir
+	InMemory bool
+	// Give devices a userspace network stack. Devices are reachable with
+	// Device.Server.Dial. Otherwise devices run with the tun disabled.
+	Userspace bool
+	// Nebula logger for the port and devices. Defaults to warnings only.
+	Log *logrus.Logger
+}
+
+type Network struct {
+	Port    *Port
+	Devices []*Device
+	opts    Options
+}
+
+type Port struct {
+	Client   *ent.Client
+	Settings *ent.Settings
+	// Graphql API url
+	Endpoint string
+	// Public DNS server address
+	DNSAddr string
+	// Nebula listen address
+	NebulaAddr string
+	// Overlay IP
+	IP netip.Addr
+}
+
+type Device struct {
+	Name      string
+	IP        netip.Addr
+	Token     string
+	Provision *gql.DeviceProvision
+	Server    *west.Server
+}
+
+var memoryDBs atomic.Int32
+
+// Starts a port and opts.Devices devices. Everything is stopped when the
+// test finishes.
+func New(t testing.TB, opts Options) *Network {
+	t.Helper()
+	if opts.Log == nil {
+		opts.Log = logrus.New()
+		opts.Log.SetLevel(logrus.WarnLevel)
+	}
+	n := &Network{opts: opts}
+	n.Port = startPort(t, &opts)
+	for i := range opts.Devices {
+		n.AddDevice(t, fmt.Sprintf("device-%d", i+1))
+	}
+	return n
+}
+
+func startPort(t testing.TB, opts *Options) *Port {
+	t.Helper()
+	ctx := context.Background()
+	copy(helpers.EncryptionKey[:], Password)
+
+	dsn := fmt.Sprintf("file:%s?mode=rwc&cache=shared&_fk=1", filepath.Join(t.TempDir(), db.DBFilePath))
+	if opts.InMemory {
+		dsn = fmt.Sprintf("file:westtest-%d?mode=memory&cache=shared&_fk=1", memoryDBs.Add(1))
+	}
+	client, err := db.Open(dsn)
+	if err != nil {
+		t.Fatalf("error opening db: %s", err)
+	}
+	err = migrate.MigrateClient(ctx, client)
+	if err != nil {
+		t.Fatalf("error migrating db: %s", err)
+	}
+
+	ca, caKey, err := pki.NewCA("westtest", time.Hour)
+	if err != nil {
+		t.Fatalf("error creating ca: %s", err)
+	}
+	lhCert, err := pki.SignCert(&pki.SignCertOptions{
+		CaCrt: ca,
+		CaKey: caKey,
+		Name:  "west-port-1",
+		Ip:    Cidr,
+	})
+	if err != nil {
+		t.Fatalf("error signing port cert: %s", err)
+	}
+	ipCidr, err := helpers.NewIpCidr(Cidr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	overlayIp, err := ipconv.FromIPAddr(ipCidr.Addr())
+	if err != nil {
+		t.Fatal(err)
+	}
+	httpPort := freePort(t)
+	nebulaPort := freePort(t)
+	settings, err := client.Settings.Create().
+		SetCaCrt(ca).
+		SetCaKey(caKey).
+		SetLighthouseCrt(lhCert.Cert).
+		SetLighthouseKey(lhCert.Key).
+		SetCidr(ipCidr).
+		SetPortOverlayIP(overlayIp).
+		SetDomainZone(DomainZone).
+		SetPortRelay(true).
+		SetPublicHost("127.0.0.1").
+		SetHTTPPort(httpPort).
+		SetNebulaPort(nebulaPort).
+		Save(ctx)
+	if err != nil {
+		t.Fatalf("error saving settings: %s", err)
+	}
+
+	dnsAddr := net.JoinHostPort("127.0.0.1", strconv.Itoa(freePort(t)))
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan error, 1)
+	go func() {
+		done <- westport.Serve(ctx, client, &westport.ServeOpts{
+			DisableTun:   true,
+			DisableHTTPS: true,
+			DNSAddr:      dnsAddr,
+			Log:          opts.Log,
+		})
+	}()
+	t.Cleanup(func() {
+		cancel()
+		err := <-done
+		if err != nil {
+			t.Errorf("west port error: %s", err)
+		}
+		client.Close()
+	})
+
+	apiAddr := net.JoinHostPort("127.0.0.1", strconv.Itoa(httpPort))
+	waitFor(t, "west port to be ready", func() bool {
+		select {
+		case err := <-done:
+			t.Fatalf("west port exited: %s", err)
+		default:
+		}
+		res, err := http.Get("http://" + apiAddr + "/readyz")
+		if err != nil {
+			return false
+		}
+		res.Body.Close()
+		return res.StatusCode == http.StatusOK
+	})
+
+	return &Port{
+		Client:     client,
+		Settings:   settings,
+		Endpoint:   "http://" + apiAddr + "/api",
+		DNSAddr:    dnsAddr,
+		NebulaAddr: net.JoinHostPort("127.0.0.1", strconv.Itoa(nebulaPort)),
+		IP:         ipCidr.Addr(),
+	}
+}
+
+// Registers, provisions and starts a device named name with the next free IP.
+// Waits until the device has a tunnel to the port.
+func (n *Network) AddDevice(t testing.TB, name string) *Device {
+	t.Helper()
+	ctx := context.Background()
+	port := n.Port
+
+	// Port is .1, devices follow in order
+	ip := port.IP
+	for range len(n.Devices) + 1 {
+		ip = ip.Next()
+	}
+	token, err := westport.AddDevice(ctx, port.Client, port.Settings, &westport.AddDeviceOpts{
+		Name: name,
+		IP:   ip,
+	})
+	if err != nil {
+		t.Fatalf("error adding device `%s`: %s", name, err)
+	}
+
+	// Provision against the loopback API rather than the token endpoint
+	pub, key := pki.NewKeypair()
+	data, err := gql.ProvisionDevicePublicKey(ctx, graphql.NewClient(port.Endpoint, http.DefaultClient), gql.ProvisionDevicePublicKeyInput{
+		Token:      token,
+		Public_key: string(pub),
+		Client: gql.ClientInfoInput{
+			Version: west.Build,
+			Os:      runtime.GOOS,
+			Arch:    runtime.GOARCH,
+		},
+	})
+	if err != nil {
+		t.Fatalf("error provisioning device `%s`: %s", name, err)
+	}
+	dvc := data.GetProvision_device_public_key().DeviceProvision
+	dvc.Key = string(key)
+
+	portIP := port.IP.String()
+	srv, err := west.NewServer(&west.ServerOpts{
+		Log:       n.opts.Log,
+		Userspace: n.opts.Userspace,
+		Config: &config.Config{
+			Pki: config.Pki{
+				Ca:   dvc.Ca,
+				Cert: dvc.Cert,
+				Key:  dvc.Key,
+			},
+			StaticHostMap: config.StaticHostMap{
+				portIP: []string{port.NebulaAddr},
+			},
+			Lighthouse: config.Lighthouse{
+				Hosts: []string{portIP},
+			},
+			Relay: config.Relay{
+				Relays: dvc.Relays,
+			},
+			Tun: config.Tun{
+				Disabled: !n.opts.Userspace,
+			},
+			Listen: config.Listen{
+				Host: "127.0.0.1",
+				Port: freePort(t),
+			},
+			Cipher: config.Cipher(dvc.NetworkCipher),
+			// Nebula resets the logger level from its config
+			Logging: config.Logging{
+				Level: n.opts.Log.GetLevel().String(),
+			},
+			Firewall: config.Firewall{
+				Inbound: []config.FirewallRule{
+					{
+						Port:  config.PortAny,
+						Proto: config.ProtoAny,
+						Host:  config.HostAny,
+					},
+				},
+				Outbound: []config.FirewallRule{
+					{
+						Port:  config.PortAny,
+						Proto: config.ProtoAny,
+						Host:  config.HostAny,
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatalf("error creating device `%s`: %s", name, err)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		srv.Listen(ctx)
+	}()
+	t.Cleanup(func() {
+		cancel()
+		<-done
+	})
+
+	select {
+	case <-srv.Ready():
+	case <-time.After(Timeout):
+		t.Fatalf("device `%s` never reached west port", name)
+	}
+
+	d := &Device{
+		Name:      name,
+		IP:        ip,
+		Token:     token,
+		Provision: &dvc,
+		Server:    srv,
+	}
+	n.Devices = append(n.Devices, d)
+	return d
+}
+
+// Handshakes with other and waits for the tunnel.
+func (d *Device) Handshake(t testing.TB, other *Device) {
+	t.Helper()
+	ctrl := d.Server.Ctrl
+	ctrl.CreateTunnel(other.IP)
+	waitFor(t, fmt.Sprintf("tunnel from `%s` to `%s`", d.Name, other.Name), func() bool {
+		return ctrl.GetHostInfoByVpnIp(other.IP, false) != nil
+	})
+}
+
+// Looks up the A record for a device or DNS record name with the port's DNS
+// server. CNAME answers are followed.
+func (p *Port) Resolve(t testing.TB, name string) netip.Addr {
+	t.Helper()
+	msg := new(dns.Msg)
+	msg.SetQuestion(dns.Fqdn(name+"."+p.Settings.DomainZone), dns.TypeA)
+	var addr netip.Addr
+	waitFor(t, fmt.Sprintf("dns answer for `%s`", name), func() bool {
+		res, err := dns.Exchange(msg, p.DNSAddr)
+		if err != nil {
+			return false
+		}
+		for _, rr := range res.Answer {
+			if a, ok := rr.(*dns.A); ok {
+				addr, ok = netip.AddrFromSlice(a.A.To4())
+				return ok
+			}
+		}
+		return false
+	})
+	return addr
+}
+
+func waitFor(t testing.TB, what string, check func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(Timeout)
+	for !check() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(50 * time.Millisecond)
+	}
+}
+
+var (
+	usedPortsMu sync.Mutex
+	usedPorts   = map[int]bool{}
+)
+
+// Free ports are released before they're used, so the kernel can hand the
+// same one out twice, and a stopped nebula server holds its port until its
+// read loops get another packet. Never reuse one within the process.
+func freePort(t testing.TB) int {
+	t.Helper()
+	usedPortsMu.Lock()
+	defer usedPortsMu.Unlock()
+	for {
+		port, err := netutil.GetFreePort()
+		if err != nil {
+			t.Fatalf("error finding free port: %s", err)
+		}
+		if !usedPorts[port] {
+			usedPorts[port] = true
+			return port
+		}
+	}
+}
@@ -0,0 +1,691 @@
+//go:build !race
+
+// Nebula races on package globals with several instances in one process,
+// see the package doc.
+
+package westtest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/netip"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Khan/genqlient/graphql"
+	"github.com/sprisa/west"
+	"github.com/sprisa/west/util/pki"
+	"github.com/sprisa/west/west/gql"
+	"github.com/sprisa/west/westport"
+	"github.com/sprisa/west/westport/audit"
+	"github.com/sprisa/west/westport/db/ent/device"
+)
+
+func TestMesh(t *testing.T) {
+	n := New(t, Options{Devices: 2})
+	a, b := n.Devices[0], n.Devices[1]
+
+	for _, d := range n.Devices {
+		ip := n.Port.Resolve(t, d.Name)
+		if ip != d.IP {
+			t.Fatalf("expected `%s` to resolve to %s, got %s", d.Name, d.IP, ip)
+		}
+	}
+
+	a.Handshake(t, b)
+	if b.Server.Ctrl.GetHostInfoByVpnIp(a.IP, false) == nil {
+		t.Fatalf("expected `%s` to have a tunnel to `%s`", b.Name, a.Name)
+	}
+
+	dvc, err := n.Port.Client.Device.Query().Where(device.Name(a.Name)).Only(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dvc.LastProvisionTime == nil || dvc.ClientVersion != west.Build || dvc.Os != runtime.GOOS {
+		t.Errorf("expected provisioning to record client info, got %+v", dvc)
+	}
+
+	body := get(t, n, "/metrics")
+	for _, metric := range []string{
+		`west_provision_requests_total{outcome="success"} 2`,
+		`west_dns_queries_total{rcode="NOERROR",type="A"}`,
+	} {
+		if !strings.Contains(body, metric) {
+			t.Errorf("expected metrics to contain `%s`", metric)
+		}
+	}
+}
+
+func TestReload(t *testing.T) {
+	n := New(t, Options{Devices: 2, InMemory: true})
+	a, b := n.Devices[0], n.Devices[1]
+	a.Handshake(t, b)
+
+	cfg := *a.Server.Config()
+	cfg.Relay.AmRelay = true
+	err := a.Server.Reload(&cfg)
+	if err != nil {
+		t.Fatalf("error reloading: %s", err)
+	}
+	if a.Server.Ctrl.GetHostInfoByVpnIp(b.IP, false) == nil {
+		t.Fatalf("expected the tunnel to `%s` to survive the reload", b.Name)
+	}
+	if !a.Server.Config().Relay.AmRelay {
+		t.Error("expected Config to return the reloaded config")
+	}
+
+	cfg.Listen.Port++
+	err = a.Server.Reload(&cfg)
+	if !errors.Is(err, west.ErrRestartRequired) {
+		t.Fatalf("expected a port change to require a restart, got %v", err)
+	}
+}
+
+func TestReady(t *testing.T) {
+	n := New(t, Options{InMemory: true})
+	body := get(t, n, "/readyz")
+	for _, check := range []string{
+		`"status":"ok"`,
+		`"dns":{"status":"ok"}`,
+		`"tls":{"status":"disabled"}`,
+	} {
+		if !strings.Contains(body, check) {
+			t.Errorf("expected readyz to contain `%s`, got %s", check, body)
+		}
+	}
+}
+
+func TestUserspace(t *testing.T) {
+	n := New(t, Options{Devices: 2, InMemory: true, Userspace: true})
+	n.Devices[0].Handshake(t, n.Devices[1])
+}
+
+func TestAudit(t *testing.T) {
+	n := New(t, Options{Devices: 1, InMemory: true})
+	query := `{ audit_events(device: "device-1") { action actor success } }`
+
+	_, body := post(t, n, "", query)
+	if !strings.Contains(body, "admin token required") {
+		t.Fatalf("expected audit events to require an admin token, got %s", body)
+	}
+	status, _ := post(t, n, "not-a-token", query)
+	if status != http.StatusUnauthorized {
+		t.Fatalf("expected invalid admin token to be unauthorized, got %d", status)
+	}
+
+	token, _, err := westport.IssueAdminToken("alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, body = post(t, n, token, query)
+	for _, event := range []string{
+		`{"action":"cert.issue","actor":"device:device-1","success":true}`,
+		`{"action":"device.provision","actor":"device:device-1","success":true}`,
+		`{"action":"device.create"`,
+	} {
+		if !strings.Contains(body, event) {
+			t.Errorf("expected audit events to contain `%s`, got %s", event, body)
+		}
+	}
+
+	// Check-ins with an unchanged cert stay out of the audit log
+	d := n.Devices[0]
+	pub, err := pki.PublicKeyFromPrivate([]byte(d.Provision.Key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = gql.ProvisionDevicePublicKey(context.Background(), graphql.NewClient(n.Port.Endpoint, http.DefaultClient), gql.ProvisionDevicePublicKeyInput{
+		Token:      d.Token,
+		Public_key: string(pub),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, body = post(t, n, token, query)
+	if count := strings.Count(body, `"cert.issue"`); count != 1 {
+		t.Errorf("expected 1 cert.issue event after a check-in, got %d: %s", count, body)
+	}
+}
+
+func TestEnrollment(t *testing.T) {
+	n := New(t, Options{InMemory: true})
+	ctx := context.Background()
+	port := n.Port
+
+	key, err := westport.CreateEnrollmentKey(ctx, port.Client, port.Settings, &westport.EnrollmentKeyOpts{
+		Name:    "web",
+		MaxUses: 2,
+		Groups:  []string{"web"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Enrollments that fail later on, here signing the cert, roll back the
+	// device and the key use
+	_, err = gql.ProvisionDevicePublicKey(ctx, graphql.NewClient(port.Endpoint, http.DefaultClient), gql.ProvisionDevicePublicKeyInput{
+		Token:      key,
+		Public_key: "not a key",
+		Client:     gql.ClientInfoInput{Hostname: "web"},
+	})
+	if err == nil {
+		t.Fatal("expected provisioning with an invalid public key to fail")
+	}
+	enrolled, err := port.Client.Device.Query().Where(device.Name("web")).Exist(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if enrolled {
+		t.Error("expected a failed enrollment to leave no device behind")
+	}
+
+	for _, name := range []string{"web", "web-2"} {
+		res, err := provision(port, key, "web.example.com")
+		if err != nil {
+			t.Fatalf("error enrolling `%s`: %s", name, err)
+		}
+		if res.Name != name || res.Access_token == key {
+			t.Errorf("expected device `%s` with its own token, got `%s`", name, res.Name)
+		}
+		dvc, err := port.Client.Device.Query().Where(device.Name(name)).Only(ctx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(dvc.Groups) != 1 || dvc.Groups[0] != "web" {
+			t.Errorf("expected `%s` to have the key's groups, got %v", name, dvc.Groups)
+		}
+	}
+	_, err = provision(port, key, "web")
+	if err == nil || !strings.Contains(err.Error(), "used up") {
+		t.Errorf("expected used up enrollment key to fail, got %v", err)
+	}
+
+	_, err = westport.AddDevice(ctx, port.Client, port.Settings, &westport.AddDeviceOpts{
+		Name:    "expired",
+		IP:      netip.MustParseAddr("10.10.10.49"),
+		Expires: -time.Hour,
+	})
+	if err == nil || !strings.Contains(err.Error(), "must be positive") {
+		t.Errorf("expected an already expired token to be refused, got %v", err)
+	}
+
+	token, err := westport.AddDevice(ctx, port.Client, port.Settings, &westport.AddDeviceOpts{
+		Name:      "once",
+		IP:        netip.MustParseAddr("10.10.10.50"),
+		SingleUse: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := provision(port, token, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Access_token == token {
+		t.Fatal("expected single use token to be exchanged")
+	}
+	_, err = provision(port, token, "")
+	if err == nil {
+		t.Error("expected single use token to be rejected after first use")
+	}
+	_, err = provision(port, res.Access_token, "")
+	if err != nil {
+		t.Errorf("expected exchanged token to provision, got %s", err)
+	}
+}
+
+func TestTokens(t *testing.T) {
+	n := New(t, Options{InMemory: true})
+	ctx := context.Background()
+	port := n.Port
+
+	leaked, err := westport.AddDevice(ctx, port.Client, port.Settings, &westport.AddDeviceOpts{
+		Name: "ci",
+		IP:   netip.MustParseAddr("10.10.10.2"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = westport.RevokeToken(ctx, port.Client, "ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = provision(port, leaked, "")
+	if err == nil || !strings.Contains(err.Error(), "token revoked") {
+		t.Errorf("expected revoked token to fail, got %v", err)
+	}
+	err = westport.RevokeToken(ctx, port.Client, "ci")
+	if err == nil || !strings.Contains(err.Error(), "already revoked") {
+		t.Errorf("expected second revoke to fail, got %v", err)
+	}
+
+	token, err := westport.IssueToken(ctx, port.Client, port.Settings, "ci", &westport.IssueTokenOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = provision(port, token, "")
+	if err != nil {
+		t.Errorf("expected issued token to provision, got %s", err)
+	}
+	_, err = provision(port, leaked, "")
+	if err == nil {
+		t.Error("expected replaced token to fail")
+	}
+	// Issuing again revokes the current token
+	_, err = westport.IssueToken(ctx, port.Client, port.Settings, "ci", &westport.IssueTokenOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = provision(port, token, "")
+	if err == nil {
+		t.Error("expected re-issued token to fail")
+	}
+}
+
+func TestApply(t *testing.T) {
+	n := New(t, Options{Devices: 1, InMemory: true})
+	ctx := context.Background()
+	port := n.Port
+
+	spec, err := westport.ParseSpec(strings.NewReader(`
+settings:
+  require_client_keys: true
+devices:
+  - name: device-1
+    ip: 10.10.10.2
+    groups: [web]
+  - name: db
+    ip: 10.10.10.20
+dns_records:
+  - name: grafana
+    type: CNAME
+    value: db
+  - name: printer
+    type: A
+    value: 192.168.1.10
+firewall:
+  inbound:
+    - proto: icmp
+      host: any
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := westport.PlanSpec(ctx, port.Client, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"+ device db (10.10.10.20)",
+		"~ device device-1\n    groups: [] -> [web]",
+		"+ dns_record grafana CNAME db",
+		"~ firewall\n    + inbound port=any proto=icmp host=any",
+		"Plan: 3 to add, 3 to change, 0 to delete.",
+	} {
+		if !strings.Contains(plan.String(), line) {
+			t.Errorf("expected plan to contain `%s`, got\n%s", line, plan)
+		}
+	}
+	res, err := westport.ApplyPlan(ctx, port.Client, plan)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Tokens) != 1 || res.Tokens[0].Name != "db" {
+		t.Errorf("expected a token for db, got %+v", res.Tokens)
+	}
+
+	plan, err = westport.PlanSpec(ctx, port.Client, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Changes) != 0 {
+		t.Errorf("expected no changes after apply, got\n%s", plan)
+	}
+	if ip := port.Resolve(t, "grafana"); ip != netip.MustParseAddr("10.10.10.20") {
+		t.Errorf("expected grafana to resolve to db, got %s", ip)
+	}
+	if ip := port.Resolve(t, "printer"); ip != netip.MustParseAddr("192.168.1.10") {
+		t.Errorf("expected printer to resolve to 192.168.1.10, got %s", ip)
+	}
+	prov, err := provision(port, n.Devices[0].Token, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(prov.Firewall_inbound) != 1 || prov.Firewall_inbound[0].Proto != "icmp" {
+		t.Errorf("expected devices to receive the firewall, got %+v", prov.Firewall_inbound)
+	}
+
+	spec.Devices = spec.Devices[1:]
+	spec.Devices[0].IP = "10.10.10.21"
+	_, err = westport.PlanSpec(ctx, port.Client, spec)
+	if err == nil || !strings.Contains(err.Error(), "can't change") {
+		t.Errorf("expected ip change to fail, got %v", err)
+	}
+	spec.Devices[0].IP = "10.10.10.20"
+	plan, err = westport.PlanSpec(ctx, port.Client, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(plan.String(), "- device device-1 (10.10.10.2)") {
+		t.Errorf("expected unlisted device to be deleted, got\n%s", plan)
+	}
+}
+
+func TestREST(t *testing.T) {
+	n := New(t, Options{Devices: 1, InMemory: true})
+
+	status, body := rest(t, n, "", http.MethodGet, "/v1/devices", "")
+	if status != http.StatusUnauthorized {
+		t.Fatalf("expected devices to require an admin token, got %d: %s", status, body)
+	}
+	token, _, err := westport.IssueAdminToken("alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	status, body = rest(t, n, token, http.MethodPost, "/v1/devices", `{"name":"db","ip":"10.10.10.20","groups":["data"]}`)
+	if status != http.StatusCreated {
+		t.Fatalf("expected device to be created, got %d: %s", status, body)
+	}
+	created := struct{ Token string }{}
+	json.Unmarshal([]byte(body), &created)
+	_, err = provision(n.Port, created.Token, "")
+	if err != nil {
+		t.Errorf("expected created device token to provision, got %v", err)
+	}
+	status, _ = rest(t, n, token, http.MethodPost, "/v1/devices", `{"name":"db","ip":"10.10.10.21"}`)
+	if status != http.StatusConflict {
+		t.Errorf("expected duplicate device to conflict, got %d", status)
+	}
+	status, _ = rest(t, n, token, http.MethodPost, "/v1/devices", `{"name":"far","ip":"10.20.0.1"}`)
+	if status != http.StatusBadRequest {
+		t.Errorf("expected device outside the network cidr to be a bad request, got %d", status)
+	}
+	status, body = rest(t, n, token, http.MethodPatch, "/v1/devices/db", `{"groups":["data","web"]}`)
+	if status != http.StatusOK || !strings.Contains(body, `"groups":["data","web"]`) {
+		t.Errorf("expected device groups to update, got %d: %s", status, body)
+	}
+	_, body = rest(t, n, token, http.MethodGet, "/v1/groups/web", "")
+	if !strings.Contains(body, `"devices":["db"]`) {
+		t.Errorf("expected web group to contain db, got %s", body)
+	}
+
+	status, body = rest(t, n, token, http.MethodPost, "/v1/dns-records", `{"name":"grafana","type":"CNAME","value":"db"}`)
+	if status != http.StatusCreated {
+		t.Fatalf("expected dns record to be created, got %d: %s", status, body)
+	}
+	if ip := n.Port.Resolve(t, "grafana"); ip != netip.MustParseAddr("10.10.10.20") {
+		t.Errorf("expected grafana to resolve to db, got %s", ip)
+	}
+	status, _ = rest(t, n, token, http.MethodPost, "/v1/dns-records", `{"name":"db","type":"A","value":"10.0.0.1"}`)
+	if status != http.StatusConflict {
+		t.Errorf("expected dns record named after a device to conflict, got %d", status)
+	}
+	status, _ = rest(t, n, token, http.MethodDelete, "/v1/dns-records/grafana", "")
+	if status != http.StatusNoContent {
+		t.Errorf("expected dns record delete, got %d", status)
+	}
+
+	status, _ = rest(t, n, token, http.MethodDelete, "/v1/tokens/db", "")
+	if status != http.StatusNoContent {
+		t.Errorf("expected token revoke, got %d", status)
+	}
+	_, body = rest(t, n, token, http.MethodGet, "/v1/tokens/db", "")
+	if !strings.Contains(body, `"revoked":true`) || strings.Contains(body, `"token"`) {
+		t.Errorf("expected revoked token metadata without the secret, got %s", body)
+	}
+	status, body = rest(t, n, token, http.MethodPost, "/v1/tokens", `{"device":"db","once":true}`)
+	if status != http.StatusCreated || !strings.Contains(body, `"revoked":false`) {
+		t.Errorf("expected a new token, got %d: %s", status, body)
+	}
+
+	status, _ = rest(t, n, token, http.MethodDelete, "/v1/devices/db", "")
+	if status != http.StatusNoContent {
+		t.Errorf("expected device delete, got %d", status)
+	}
+	status, _ = rest(t, n, token, http.MethodGet, "/v1/devices/db", "")
+	if status != http.StatusNotFound {
+		t.Errorf("expected deleted device to be not found, got %d", status)
+	}
+	// Only device-1's provisioning is left
+	history, err := n.Port.Client.DeviceProvision.Query().Count(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if history != 1 {
+		t.Errorf("expected the deleted device's provisioning history to be deleted, got %d rows", history)
+	}
+
+	err = westport.RevokeAdminToken(context.Background(), n.Port.Client, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, body = rest(t, n, token, http.MethodGet, "/v1/devices", "")
+	if status != http.StatusUnauthorized || !strings.Contains(body, "admin token revoked") {
+		t.Errorf("expected revoked admin token to be unauthorized, got %d: %s", status, body)
+	}
+	// By jti, found through the audit event of `west port admin-token`
+	bobToken, bobClaims, err := westport.IssueAdminToken("bob", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = audit.Record(context.Background(), n.Port.Client, &audit.Event{
+		Action: audit.AdminTokenIssue,
+		Details: map[string]string{
+			"name":    "bob",
+			"jti":     bobClaims.ID,
+			"expires": bobClaims.ExpiresAt.UTC().Format(time.RFC3339),
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = westport.RevokeAdminToken(context.Background(), n.Port.Client, bobClaims.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	status, _ = rest(t, n, bobToken, http.MethodGet, "/v1/devices", "")
+	if status != http.StatusUnauthorized {
+		t.Errorf("expected admin token revoked by jti to be unauthorized, got %d", status)
+	}
+	err = westport.RevokeAdminToken(context.Background(), n.Port.Client, "unknown")
+	if err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Errorf("expected revoking an unknown jti to fail, got %v", err)
+	}
+
+	doc := get(t, n, "/v1/openapi.json")
+	for _, want := range []string{`"/v1/dns-records/{name}"`, `"operationId":"createDevice"`, `"Device":{`} {
+		if !strings.Contains(doc, want) {
+			t.Errorf("expected openapi document to contain `%s`", want)
+		}
+	}
+}
+
+func TestRemote(t *testing.T) {
+	n := New(t, Options{Devices: 1, InMemory: true})
+	ctx := context.Background()
+	westport.ProfilePath = filepath.Join(t.TempDir(), "port-profile.json")
+	portURL := n.Port.Endpoint
+
+	err := westport.LoginCommand.Run(ctx, []string{"login", portURL, "--token", "not-a-token"})
+	if err == nil || !strings.Contains(err.Error(), "isn't https") {
+		t.Fatalf("expected login over http to need --insecure, got %v", err)
+	}
+	err = westport.LoginCommand.Run(ctx, []string{"login", portURL, "--token", "not-a-token", "--insecure"})
+	if err == nil || !strings.Contains(err.Error(), "invalid admin token") {
+		t.Fatalf("expected login with an invalid token to fail, got %v", err)
+	}
+	token, _, err := westport.IssueAdminToken("alice", time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = westport.LoginCommand.Run(ctx, []string{"login", portURL, "--token", token, "--insecure"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stat, err := os.Stat(westport.ProfilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stat.Mode().Perm() != 0600 {
+		t.Errorf("expected profile to only be readable by the user, got %s", stat.Mode())
+	}
+	profile, err := westport.LoadProfile()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if profile.URL != strings.TrimSuffix(portURL, "/api") {
+		t.Errorf("expected profile url to be the port's base url, got %s", profile.URL)
+	}
+
+	remote := profile.Remote()
+	devToken, err := remote.AddDevice(ctx, &westport.AddDeviceOpts{
+		Name:   "db",
+		IP:     netip.MustParseAddr("10.10.10.20"),
+		Groups: []string{"data"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = provision(n.Port, devToken, "")
+	if err != nil {
+		t.Errorf("expected remotely added device to provision, got %v", err)
+	}
+	dvcs, err := remote.Devices(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dvcs) != 2 || dvcs[0].Name != "db" || dvcs[0].IP.ToIpAddr() != netip.MustParseAddr("10.10.10.20") {
+		t.Errorf("expected devices db and device-1, got %+v", dvcs)
+	}
+
+	err = remote.RevokeToken(ctx, "db")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = remote.RevokeToken(ctx, "db")
+	if err == nil || !strings.Contains(err.Error(), "already revoked") {
+		t.Errorf("expected second revoke to fail, got %v", err)
+	}
+	_, err = provision(n.Port, devToken, "")
+	if err == nil {
+		t.Error("expected revoked token to be rejected")
+	}
+	devToken, err = remote.IssueToken(ctx, "db", &westport.IssueTokenOpts{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = provision(n.Port, devToken, "")
+	if err != nil {
+		t.Errorf("expected remotely issued token to provision, got %v", err)
+	}
+	_, err = remote.IssueToken(ctx, "missing", &westport.IssueTokenOpts{})
+	if err == nil || !strings.Contains(err.Error(), "device `missing` not found") {
+		t.Errorf("expected token for a missing device to fail, got %v", err)
+	}
+
+	// Commands without remote support don't fall back to a local database
+	err = westport.AuditCommand.Run(ctx, []string{"audit"})
+	if err == nil || !strings.Contains(err.Error(), "can't run against the remote west port") {
+		t.Errorf("expected audit to refuse to run while logged in, got %v", err)
+	}
+
+	err = westport.LogoutCommand.Run(ctx, []string{"logout"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	profile, err = westport.LoadProfile()
+	if err != nil || profile != nil {
+		t.Errorf("expected no profile after logout, got %+v %v", profile, err)
+	}
+}
+
+// Provisions token against the port's API with a fresh key pair.
+func provision(port *Port, token string, hostname string) (*gql.ProvisionDevicePublicKeyProvision_device_public_keyProvisionDeviceResponse, error) {
+	pub, _ := pki.NewKeypair()
+	data, err := gql.ProvisionDevicePublicKey(context.Background(), graphql.NewClient(port.Endpoint, http.DefaultClient), gql.ProvisionDevicePublicKeyInput{
+		Token:      token,
+		Public_key: string(pub),
+		Client:     gql.ClientInfoInput{Hostname: hostname},
+	})
+	if err != nil {
+		return nil, err
+	}
+	res := data.GetProvision_device_public_key()
+	return &res, nil
+}
+
+// Posts a graphql query to the port with an optional admin token.
+func post(t *testing.T, n *Network, token string, query string) (int, string) {
+	t.Helper()
+	reqBody, err := json.Marshal(map[string]string{"query": query})
+	if err != nil {
+		t.Fatal(err)
+	}
+	req, err := http.NewRequest(http.MethodPost, n.Port.Endpoint, bytes.NewReader(reqBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, string(body)
+}
+
+// Calls the port's REST API with an optional admin token and JSON body.
+func rest(t *testing.T, n *Network, token string, method string, path string, reqBody string) (int, string) {
+	t.Helper()
+	req, err := http.NewRequest(method, strings.TrimSuffix(n.Port.Endpoint, "/api")+path, strings.NewReader(reqBody))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return res.StatusCode, string(body)
+}
+
+// Fetches path from the port's HTTP API and fails unless it returns 200.
+func get(t *testing.T, n *Network, path string) string {
+	t.Helper()
+	res, err := http.Get(strings.TrimSuffix(n.Port.Endpoint, "/api") + path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected 200 from %s, got %s: %s", path, res.Status, body)
+	}
+	return string(body)
+}